@@ -0,0 +1,12 @@
+// Package api holds the machine-readable API definitions of the booking service.
+//
+// The gRPC stubs in gen/ are generated from proto/ with buf; run `go generate ./api` after
+// editing a .proto file.
+package api
+
+import _ "embed"
+
+//go:generate buf generate
+
+//go:embed openapi.json
+var OpenAPISpec []byte
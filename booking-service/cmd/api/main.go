@@ -1,26 +1,63 @@
 package main
 
 import (
-	"log"
+	"fmt"
+	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/config"
 	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/server"
 )
 
+const usage = `usage:
+  api [serve] [flags]                       run the HTTP and gRPC servers
+  api migrate up|down|status|redo [flags]   manage the database schema
+  api config [flags]                        print the effective configuration, secrets redacted
+
+Run "api -h" to list the configuration flags.`
+
 func main() {
-	log.Println("Starting booking service...")
+	command, args := splitCommand(os.Args[1:])
+
+	var migrateAction string
+	if command == "migrate" {
+		migrateAction, args = splitCommand(args)
+	}
 
-	cfg, err := config.Load()
+	cfg, err := config.Load(args)
 	if err != nil {
-		log.Printf("Error loading config: %v", err)
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
 	}
 
-	if err := server.Start(cfg); err != nil {
-		log.Printf("Server exited with error: %v", err)
-		os.Exit(1)
+	switch command {
+	case "", "serve":
+		if err := server.Start(cfg); err != nil {
+			slog.Error("server exited with error", slog.Any("error", err))
+			os.Exit(1)
+		}
+		slog.Info("server exited cleanly")
+	case "migrate":
+		if err := runMigrate(cfg, migrateAction, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "migration failed:", err)
+			os.Exit(1)
+		}
+	case "config":
+		if err := cfg.Redacted().WriteYAML(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
 	}
+}
 
-	log.Println("Server exited cleanly")
+// splitCommand separates a leading subcommand from the flags that follow it.
+func splitCommand(args []string) (string, []string) {
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		return args[0], args[1:]
+	}
+	return "", args
 }
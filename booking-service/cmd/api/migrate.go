@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/config"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/database"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/logging"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/server"
+	"github.com/yourusername/fitbook/booking-service/migrations"
+)
+
+const migrateUsage = "usage: api migrate up|down|status|redo [flags]"
+
+// runMigrate implements `api migrate <action>` against the configured database.
+func runMigrate(cfg *config.Config, action string, output io.Writer) error {
+	switch action {
+	case "up", "down", "status", "redo":
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	logger, err := logging.New(os.Stderr, cfg.Logging.Level, cfg.Logging.Format)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	db, err := server.OpenDatabase(ctx, cfg, logger)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	loaded, err := database.LoadMigrations(migrations.FS)
+	if err != nil {
+		return err
+	}
+	migrator := database.NewMigrator(db, loaded, logger)
+
+	switch action {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(output, "database is up to date")
+		}
+		for _, version := range applied {
+			fmt.Fprintf(output, "applied %04d\n", version)
+		}
+	case "down":
+		version, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(output, "reverted %04d\n", version)
+	case "redo":
+		version, err := migrator.Redo(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(output, "redid %04d\n", version)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		writer := tabwriter.NewWriter(output, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.UTC().Format("2006-01-02T15:04:05Z")
+			}
+			fmt.Fprintf(writer, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return writer.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// apiBackend calls the service's HTTP API with a bearer token. Forcing a status requires an
+// admin token; the other commands need a token allowed to act on the bookings concerned.
+type apiBackend struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func newAPIBackend(baseURL, token string) *apiBackend {
+	return &apiBackend{
+		baseURL: strings.TrimSuffix(baseURL, "/") + "/v1",
+		token:   token,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// apiError is an error response of the API.
+type apiError struct {
+	Status  int
+	Problem *dtos.ErrorDTO
+	Items   []dtos.BatchItemErrorDTO
+}
+
+func (err *apiError) Error() string {
+	if err.Problem == nil {
+		return fmt.Sprintf("API responded %d %s", err.Status, http.StatusText(err.Status))
+	}
+	var message strings.Builder
+	fmt.Fprintf(&message, "%s: %s", err.Problem.Code, err.Problem.Message)
+	for _, detail := range err.Problem.Details {
+		fmt.Fprintf(&message, "\n  %s", detail)
+	}
+	for _, field := range err.Problem.Fields {
+		fmt.Fprintf(&message, "\n  %s: %s", field.Field, field.Message)
+	}
+	for _, item := range err.Items {
+		fmt.Fprintf(&message, "\n  booking %d: %s: %s", item.Index, item.Code, item.Message)
+	}
+	return message.String()
+}
+
+func (client *apiBackend) List(ctx context.Context, filter listFilter) ([]*dtos.BookingDTO, error) {
+	request := map[string]string{
+		"user_id":    filter.UserID,
+		"gym_id":     filter.GymID,
+		"start_time": filter.From.Format(time.RFC3339),
+		"end_time":   filter.To.Format(time.RFC3339),
+	}
+	var bookings []*dtos.BookingDTO
+	err := client.do(ctx, http.MethodGet, "/bookings", request, &bookings)
+	return bookings, err
+}
+
+func (client *apiBackend) History(ctx context.Context, bookingID string) (*dtos.BookingHistoryDTO, error) {
+	var history dtos.BookingHistoryDTO
+	if err := client.do(ctx, http.MethodGet, "/bookings/"+url.PathEscape(bookingID)+"/history", nil, &history); err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+func (client *apiBackend) ForceStatus(ctx context.Context, bookingID string, status booking.BookingStatus, reason string) error {
+	request := dtos.ForceStatusDTO{Status: status.String(), Reason: reason}
+	return client.do(ctx, http.MethodPut, "/bookings/"+url.PathEscape(bookingID)+"/status", request, nil)
+}
+
+func (client *apiBackend) Cancel(ctx context.Context, bookingID, reason string) error {
+	path := "/bookings/" + url.PathEscape(bookingID)
+	if reason != "" {
+		path += "?" + url.Values{"reason": {reason}}.Encode()
+	}
+	return client.do(ctx, http.MethodDelete, path, nil, nil)
+}
+
+func (client *apiBackend) CreateBatch(ctx context.Context, bookings []*dtos.CreateBookingDTO) ([]*dtos.BookingDTO, error) {
+	var created []*dtos.BookingDTO
+	err := client.do(ctx, http.MethodPost, "/bookings:batch", dtos.BatchCreateBookingsDTO{Bookings: bookings}, &created)
+	return created, err
+}
+
+func (client *apiBackend) Close() error {
+	client.client.CloseIdleConnections()
+	return nil
+}
+
+// do sends body as JSON, if not nil, and decodes the data of a successful response into
+// data, if not nil.
+func (client *apiBackend) do(ctx context.Context, method, path string, body, data interface{}) error {
+	var requestBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		requestBody = bytes.NewReader(encoded)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, client.baseURL+path, requestBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if client.token != "" {
+		request.Header.Set("Authorization", "Bearer "+client.token)
+	}
+
+	response, err := client.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	var envelope struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+		Error   *dtos.ErrorDTO  `json:"error"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&envelope); err != nil {
+		return &apiError{Status: response.StatusCode}
+	}
+	if !envelope.Success {
+		apiErr := &apiError{Status: response.StatusCode, Problem: envelope.Error}
+		if envelope.Error != nil && envelope.Error.Code == "BATCH_REJECTED" {
+			_ = json.Unmarshal(envelope.Data, &apiErr.Items)
+		}
+		return apiErr
+	}
+	if data == nil || len(envelope.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(envelope.Data, data)
+}
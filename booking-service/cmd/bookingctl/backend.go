@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/config"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/database"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/events"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/logging"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/server"
+)
+
+// backend carries out bookingctl commands, either in process against the database or
+// through the HTTP API. Both go through the same command and query handlers, so the same
+// rules apply and the same events are published.
+type backend interface {
+	List(ctx context.Context, filter listFilter) ([]*dtos.BookingDTO, error)
+	History(ctx context.Context, bookingID string) (*dtos.BookingHistoryDTO, error)
+	ForceStatus(ctx context.Context, bookingID string, status booking.BookingStatus, reason string) error
+	Cancel(ctx context.Context, bookingID, reason string) error
+	CreateBatch(ctx context.Context, bookings []*dtos.CreateBookingDTO) ([]*dtos.BookingDTO, error)
+	Close() error
+}
+
+// listFilter selects the bookings of UserID, or else of GymID, that lie within From and To.
+type listFilter struct {
+	UserID string
+	GymID  string
+	From   time.Time
+	To     time.Time
+}
+
+// databaseBackend runs the command and query handlers in process. Calls carry no
+// principal, so they are not restricted by the authorization policy.
+type databaseBackend struct {
+	db      *sql.DB
+	list    *queries.ListBookingsHandler
+	history *queries.GetBookingHistoryHandler
+	force   *commands.ForceBookingStatusHandler
+	cancel  *commands.CancelBookingHandler
+	batch   *commands.CreateBookingsBatchHandler
+}
+
+func newDatabaseBackend(ctx context.Context, configFile string) (*databaseBackend, error) {
+	var args []string
+	if configFile != "" {
+		args = []string{"--config", configFile}
+	}
+	cfg, err := config.Load(args)
+	if err != nil {
+		return nil, err
+	}
+
+	logger, err := logging.New(os.Stderr, cfg.Logging.Level, cfg.Logging.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := server.OpenDatabase(ctx, cfg, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	repo := database.NewBookingRepository(db, logger)
+	publisher := events.NewEventPublisher(logger)
+	return &databaseBackend{
+		db:      db,
+		list:    queries.NewListBookingsHandler(repo),
+		history: queries.NewGetBookingHistoryHandler(repo),
+		force:   commands.NewForceBookingStatusHandler(repo, publisher, logger),
+		cancel:  commands.NewCancelBookingHandler(repo, publisher, logger, nil),
+		batch:   commands.NewCreateBookingsBatchHandler(repo, publisher, logger),
+	}, nil
+}
+
+func (client *databaseBackend) List(ctx context.Context, filter listFilter) ([]*dtos.BookingDTO, error) {
+	result, err := client.list.Handle(ctx, queries.ListBookingsQuery{
+		UserID:    filter.UserID,
+		GymID:     filter.GymID,
+		StartTime: filter.From,
+		EndTime:   filter.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Bookings, nil
+}
+
+func (client *databaseBackend) History(ctx context.Context, bookingID string) (*dtos.BookingHistoryDTO, error) {
+	result, err := client.history.Handle(ctx, queries.GetBookingHistoryQuery{BookingID: bookingID})
+	if err != nil {
+		return nil, err
+	}
+	return result.History, nil
+}
+
+func (client *databaseBackend) ForceStatus(ctx context.Context, bookingID string, status booking.BookingStatus, reason string) error {
+	return client.force.Handle(ctx, commands.ForceBookingStatusCommand{
+		BookingID: bookingID,
+		Status:    status,
+		Reason:    reason,
+	})
+}
+
+func (client *databaseBackend) Cancel(ctx context.Context, bookingID, reason string) error {
+	return client.cancel.Handle(ctx, commands.CancelBookingCommand{
+		BookingID: bookingID,
+		Reason:    reason,
+	})
+}
+
+func (client *databaseBackend) CreateBatch(ctx context.Context, bookings []*dtos.CreateBookingDTO) ([]*dtos.BookingDTO, error) {
+	result, err := client.batch.Handle(ctx, commands.CreateBookingsBatchCommand{
+		DTO: &dtos.BatchCreateBookingsDTO{Bookings: bookings},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Bookings, nil
+}
+
+func (client *databaseBackend) Close() error {
+	return client.db.Close()
+}
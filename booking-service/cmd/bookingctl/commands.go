@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// commandFunc runs a command once its flags are parsed; args are the remaining arguments.
+type commandFunc func(ctx context.Context, client backend, args []string, output io.Writer) error
+
+// subcommands maps each command name to a function that defines its flags.
+var subcommands = map[string]func(flags *flag.FlagSet) commandFunc{
+	"list":         listCommand,
+	"show":         showCommand,
+	"force-status": forceStatusCommand,
+	"cancel-gym":   cancelGymCommand,
+	"seed":         seedCommand,
+	"export":       exportCommand,
+}
+
+// batchSize is the most bookings POST /bookings:batch accepts at once.
+const batchSize = 100
+
+// listOptions are the flags shared by list and export.
+type listOptions struct {
+	userID string
+	gymID  string
+	from   timeValue
+	to     timeValue
+	status string
+}
+
+func addListFlags(flags *flag.FlagSet) *listOptions {
+	now := time.Now().UTC().Truncate(time.Minute)
+	options := &listOptions{
+		from: timeValue(now.AddDate(0, 0, -30)),
+		to:   timeValue(now.AddDate(0, 0, 30)),
+	}
+	flags.StringVar(&options.userID, "user", "", "list the bookings of this user")
+	flags.StringVar(&options.gymID, "gym", "", "list the bookings at this gym")
+	flags.Var(&options.from, "from", "earliest start time, RFC 3339 or YYYY-MM-DD")
+	flags.Var(&options.to, "to", "latest end time, RFC 3339 or YYYY-MM-DD")
+	flags.StringVar(&options.status, "status", "", "only bookings in this status")
+	return options
+}
+
+func (options *listOptions) bookings(ctx context.Context, client backend) ([]*dtos.BookingDTO, error) {
+	if (options.userID == "") == (options.gymID == "") {
+		return nil, errors.New("exactly one of --user and --gym is required")
+	}
+	if options.status != "" && !booking.BookingStatus(options.status).IsValid() {
+		return nil, fmt.Errorf("unknown status %q", options.status)
+	}
+
+	bookings, err := client.List(ctx, listFilter{
+		UserID: options.userID,
+		GymID:  options.gymID,
+		From:   options.from.Time(),
+		To:     options.to.Time(),
+	})
+	if err != nil || options.status == "" {
+		return bookings, err
+	}
+
+	var filtered []*dtos.BookingDTO
+	for _, bookingDTO := range bookings {
+		if bookingDTO.Status == options.status {
+			filtered = append(filtered, bookingDTO)
+		}
+	}
+	return filtered, nil
+}
+
+func listCommand(flags *flag.FlagSet) commandFunc {
+	options := addListFlags(flags)
+	format := flags.String("format", "table", "output format: table, csv or json")
+	return func(ctx context.Context, client backend, args []string, output io.Writer) error {
+		bookings, err := options.bookings(ctx, client)
+		if err != nil {
+			return err
+		}
+		return writeBookings(output, *format, bookings)
+	}
+}
+
+func exportCommand(flags *flag.FlagSet) commandFunc {
+	options := addListFlags(flags)
+	format := flags.String("format", "csv", "output format: csv or json")
+	path := flags.String("output", "", "file to write instead of stdout")
+	return func(ctx context.Context, client backend, args []string, output io.Writer) error {
+		if *format != "csv" && *format != "json" {
+			return fmt.Errorf("unknown export format %q", *format)
+		}
+		bookings, err := options.bookings(ctx, client)
+		if err != nil {
+			return err
+		}
+		if *path == "" {
+			return writeBookings(output, *format, bookings)
+		}
+
+		file, err := os.Create(*path)
+		if err != nil {
+			return err
+		}
+		if err := writeBookings(file, *format, bookings); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "exported %d bookings to %s\n", len(bookings), *path)
+		return nil
+	}
+}
+
+func showCommand(flags *flag.FlagSet) commandFunc {
+	format := flags.String("format", "table", "output format: table or json")
+	return func(ctx context.Context, client backend, args []string, output io.Writer) error {
+		if len(args) != 1 {
+			return errors.New("usage: bookingctl show <booking-id>")
+		}
+		history, err := client.History(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return writeHistory(output, *format, history)
+	}
+}
+
+func forceStatusCommand(flags *flag.FlagSet) commandFunc {
+	status := flags.String("status", "", "status to move the booking to (required)")
+	reason := flags.String("reason", "", "why the status is forced, kept in the booking's history (required)")
+	return func(ctx context.Context, client backend, args []string, output io.Writer) error {
+		if len(args) != 1 || *status == "" || *reason == "" {
+			return errors.New("usage: bookingctl force-status <booking-id> --status STATUS --reason REASON")
+		}
+		if err := client.ForceStatus(ctx, args[0], booking.BookingStatus(*status), *reason); err != nil {
+			return err
+		}
+		fmt.Fprintf(output, "booking %s is now %s\n", args[0], *status)
+		return nil
+	}
+}
+
+func cancelGymCommand(flags *flag.FlagSet) commandFunc {
+	gymID := flags.String("gym", "", "gym whose bookings are cancelled (required)")
+	var from, to timeValue
+	flags.Var(&from, "from", "start of the window, RFC 3339 or YYYY-MM-DD (required)")
+	flags.Var(&to, "to", "end of the window, RFC 3339 or YYYY-MM-DD (required)")
+	reason := flags.String("reason", "", "why the bookings are cancelled, kept in their history (required)")
+	dryRun := flags.Bool("dry-run", false, "list the bookings that would be cancelled without cancelling them")
+	return func(ctx context.Context, client backend, args []string, output io.Writer) error {
+		if *gymID == "" || from.Time().IsZero() || to.Time().IsZero() || *reason == "" {
+			return errors.New("usage: bookingctl cancel-gym --gym GYM --from TIME --to TIME --reason REASON [--dry-run]")
+		}
+		bookings, err := client.List(ctx, listFilter{GymID: *gymID, From: from.Time(), To: to.Time()})
+		if err != nil {
+			return err
+		}
+
+		var active []*dtos.BookingDTO
+		for _, bookingDTO := range bookings {
+			status := booking.BookingStatus(bookingDTO.Status)
+			if status == booking.StatusPending || status == booking.StatusConfirmed {
+				active = append(active, bookingDTO)
+			}
+		}
+		if *dryRun {
+			fmt.Fprintf(output, "would cancel %d bookings:\n", len(active))
+			return writeBookings(output, "table", active)
+		}
+
+		var failed int
+		for _, bookingDTO := range active {
+			if err := client.Cancel(ctx, bookingDTO.ID, *reason); err != nil {
+				failed++
+				fmt.Fprintf(output, "failed to cancel %s: %v\n", bookingDTO.ID, err)
+				continue
+			}
+			fmt.Fprintf(output, "cancelled %s\n", bookingDTO.ID)
+		}
+		fmt.Fprintf(output, "cancelled %d of %d bookings\n", len(active)-failed, len(active))
+		if failed > 0 {
+			return fmt.Errorf("%d bookings could not be cancelled", failed)
+		}
+		return nil
+	}
+}
+
+func seedCommand(flags *flag.FlagSet) commandFunc {
+	gymID := flags.String("gym", "", "gym to create the bookings at (required)")
+	users := flags.Int("users", 5, "number of demo users the bookings are spread over")
+	count := flags.Int("count", 20, "number of bookings to create")
+	tomorrow := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1).Add(8 * time.Hour)
+	start := timeValue(tomorrow)
+	flags.Var(&start, "start", "start of the first booking, RFC 3339 or YYYY-MM-DD")
+	duration := flags.Duration("duration", time.Hour, "length of each booking")
+	return func(ctx context.Context, client backend, args []string, output io.Writer) error {
+		if *gymID == "" || *users < 1 || *count < 1 || *duration <= 0 {
+			return errors.New("usage: bookingctl seed --gym GYM [--users N] [--count N] [--start TIME] [--duration D]")
+		}
+
+		// Bookings at a gym must not overlap, so the demo bookings follow one another.
+		requests := make([]*dtos.CreateBookingDTO, *count)
+		for i := range requests {
+			startTime := start.Time().Add(time.Duration(i) * *duration)
+			requests[i] = &dtos.CreateBookingDTO{
+				UserID:    fmt.Sprintf("demo-user-%d", i%*users+1),
+				GymID:     *gymID,
+				StartTime: startTime.Format(time.RFC3339),
+				EndTime:   startTime.Add(*duration).Format(time.RFC3339),
+			}
+		}
+
+		created := 0
+		for offset := 0; offset < len(requests); offset += batchSize {
+			batch := requests[offset:min(offset+batchSize, len(requests))]
+			bookings, err := client.CreateBatch(ctx, batch)
+			if err != nil {
+				return fmt.Errorf("created %d bookings, then: %w", created, describeBatchError(err, offset))
+			}
+			created += len(bookings)
+		}
+		fmt.Fprintf(output, "created %d bookings at gym %s\n", created, *gymID)
+		return nil
+	}
+}
+
+// describeBatchError lists why each item of a rejected batch starting at offset failed.
+func describeBatchError(err error, offset int) error {
+	var rejected *commands.BatchRejectedError
+	if !errors.As(err, &rejected) {
+		return err
+	}
+	message := rejected.Error()
+	for _, item := range rejected.Items {
+		message += fmt.Sprintf("\n  booking %d: %v", offset+item.Index, item.Err)
+	}
+	return errors.New(message)
+}
@@ -0,0 +1,109 @@
+// Command bookingctl inspects and repairs bookings, either directly against the database
+// through the service's repository and command handlers, or through the HTTP API.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+const usage = `usage: bookingctl [global flags] <command> [flags]
+
+commands:
+  list          list bookings of a user or gym, optionally filtered by status
+  show          show a booking and its status history
+  force-status  move a booking to any status, recording a reason
+  cancel-gym    cancel every active booking of a gym in a time window
+  seed          create demo bookings for a gym
+  export        export bookings as CSV or JSON
+
+global flags:
+  --api URL     use the HTTP API at URL (env BOOKINGCTL_API_URL) instead of the database
+  --token JWT   bearer token for --api (env BOOKINGCTL_TOKEN)
+  --config FILE service YAML config used to reach the database (env BOOKING_CONFIG_FILE)
+
+Without --api, the database is configured like the service: DB_URL, .env and the config file.
+Run "bookingctl <command> -h" for the flags of a command.`
+
+// errUsage reports a command-line mistake; the message has already been printed.
+var errUsage = errors.New("usage error")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	switch {
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, "bookingctl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, output, errOutput io.Writer) error {
+	globalFlags := flag.NewFlagSet("bookingctl", flag.ContinueOnError)
+	globalFlags.SetOutput(errOutput)
+	globalFlags.Usage = func() { fmt.Fprintln(errOutput, usage) }
+	apiURL := globalFlags.String("api", os.Getenv("BOOKINGCTL_API_URL"), "")
+	token := globalFlags.String("token", os.Getenv("BOOKINGCTL_TOKEN"), "")
+	configFile := globalFlags.String("config", "", "")
+	if err := globalFlags.Parse(args); err != nil {
+		return errUsage
+	}
+	if globalFlags.NArg() == 0 {
+		fmt.Fprintln(errOutput, usage)
+		return errUsage
+	}
+
+	command, ok := subcommands[globalFlags.Arg(0)]
+	if !ok {
+		fmt.Fprintf(errOutput, "unknown command %q\n\n%s\n", globalFlags.Arg(0), usage)
+		return errUsage
+	}
+
+	commandFlags := flag.NewFlagSet("bookingctl "+globalFlags.Arg(0), flag.ContinueOnError)
+	commandFlags.SetOutput(errOutput)
+	runCommand := command(commandFlags)
+	commandArgs, err := parseInterspersed(commandFlags, globalFlags.Args()[1:])
+	if err != nil {
+		return errUsage
+	}
+
+	var client backend
+	if *apiURL != "" {
+		client = newAPIBackend(*apiURL, *token)
+	} else {
+		client, err = newDatabaseBackend(ctx, *configFile)
+		if err != nil {
+			return err
+		}
+	}
+	defer client.Close()
+
+	return runCommand(ctx, client, commandArgs, output)
+}
+
+// parseInterspersed parses flags that may follow positional arguments, as in
+// "show <id> --format json", and returns the positional arguments.
+func parseInterspersed(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+)
+
+// timeValue is a flag holding an RFC 3339 time or a YYYY-MM-DD date, taken as midnight UTC.
+type timeValue time.Time
+
+func (value *timeValue) String() string {
+	if value == nil || time.Time(*value).IsZero() {
+		return ""
+	}
+	return time.Time(*value).Format(time.RFC3339)
+}
+
+func (value *timeValue) Set(raw string) error {
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		parsed, err = time.Parse(time.DateOnly, raw)
+	}
+	if err != nil {
+		return fmt.Errorf("want an RFC 3339 time or YYYY-MM-DD date, got %q", raw)
+	}
+	*value = timeValue(parsed)
+	return nil
+}
+
+func (value timeValue) Time() time.Time {
+	return time.Time(value)
+}
+
+var bookingColumns = []string{"id", "user_id", "gym_id", "start_time", "end_time", "duration", "status", "status_reason", "created_at", "updated_at"}
+
+func bookingRow(bookingDTO *dtos.BookingDTO) []string {
+	return []string{
+		bookingDTO.ID,
+		bookingDTO.UserID,
+		bookingDTO.GymID,
+		bookingDTO.StartTime,
+		bookingDTO.EndTime,
+		strconv.Itoa(bookingDTO.Duration),
+		bookingDTO.Status,
+		bookingDTO.StatusReason,
+		bookingDTO.CreatedAt,
+		bookingDTO.UpdatedAt,
+	}
+}
+
+func writeBookings(output io.Writer, format string, bookings []*dtos.BookingDTO) error {
+	switch format {
+	case "json":
+		return writeJSON(output, bookings)
+	case "csv":
+		writer := csv.NewWriter(output)
+		writer.Write(bookingColumns)
+		for _, bookingDTO := range bookings {
+			writer.Write(bookingRow(bookingDTO))
+		}
+		writer.Flush()
+		return writer.Error()
+	case "table":
+		writer := tabwriter.NewWriter(output, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "ID\tUSER\tGYM\tSTART\tEND\tSTATUS")
+		for _, bookingDTO := range bookings {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				bookingDTO.ID, bookingDTO.UserID, bookingDTO.GymID, bookingDTO.StartTime, bookingDTO.EndTime, bookingDTO.Status)
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeHistory(output io.Writer, format string, history *dtos.BookingHistoryDTO) error {
+	switch format {
+	case "json":
+		return writeJSON(output, history)
+	case "table":
+		writer := tabwriter.NewWriter(output, 0, 4, 2, ' ', 0)
+		for i, column := range bookingColumns {
+			fmt.Fprintf(writer, "%s:\t%s\n", column, bookingRow(history.Booking)[i])
+		}
+		fmt.Fprintln(writer)
+		fmt.Fprintln(writer, "CHANGED AT\tFROM\tTO\tREASON")
+		for _, change := range history.History {
+			from := change.FromStatus
+			if from == "" {
+				from = "-"
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", change.ChangedAt, from, change.ToStatus, change.Reason)
+		}
+		return writer.Flush()
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeJSON(output io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// Members act on their own bookings, staff act on bookings at the gyms they work at, and
+// admins may do everything.
+
+func AuthorizeCreate(ctx context.Context, userID, gymID string) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return principal.UserID == userID || principal.WorksAt(gymID)
+	})
+}
+
+func AuthorizeView(ctx context.Context, bookingRecord *booking.Booking) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return principal.UserID == bookingRecord.UserID || principal.WorksAt(bookingRecord.GymID)
+	})
+}
+
+func AuthorizeCancel(ctx context.Context, bookingRecord *booking.Booking) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return principal.UserID == bookingRecord.UserID || principal.WorksAt(bookingRecord.GymID)
+	})
+}
+
+// AuthorizeManage guards the staff-only transitions: confirm and complete.
+func AuthorizeManage(ctx context.Context, bookingRecord *booking.Booking) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return principal.WorksAt(bookingRecord.GymID)
+	})
+}
+
+// AuthorizeList allows members to list their own bookings and staff to list their gyms'.
+// userID takes precedence over gymID, mirroring ListBookingsHandler.
+func AuthorizeList(ctx context.Context, userID, gymID string) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		if userID != "" {
+			return principal.UserID == userID
+		}
+		return principal.WorksAt(gymID)
+	})
+}
+
+// AuthorizeWatch guards streaming booking events filtered by userID and gymID. Both filters
+// apply, so either one the caller may see suffices: members watch their own bookings and
+// staff their gyms'. Watching every booking is for admins only.
+func AuthorizeWatch(ctx context.Context, userID, gymID string) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return (userID != "" && principal.UserID == userID) || (gymID != "" && principal.WorksAt(gymID))
+	})
+}
+
+// AuthorizeViewUser guards a member's account data, such as their booking quota, which staff
+// may view to help them.
+func AuthorizeViewUser(ctx context.Context, userID string) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return principal.UserID == userID || principal.Role == RoleStaff
+	})
+}
+
+// AuthorizeManageGym guards changes to a gym's settings, such as its booking policy, which
+// its staff may make.
+func AuthorizeManageGym(ctx context.Context, gymID string) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return principal.WorksAt(gymID)
+	})
+}
+
+// AuthorizeManageTrainers guards changes to personal trainers and their time off, which any
+// staff member may make, since trainers work across gyms.
+func AuthorizeManageTrainers(ctx context.Context) error {
+	return authorize(ctx, func(principal *Principal) bool {
+		return principal.Role == RoleStaff
+	})
+}
+
+// AuthorizeForceStatus guards forcing a booking into any status, which only admins may do.
+func AuthorizeForceStatus(ctx context.Context) error {
+	return authorize(ctx, func(*Principal) bool {
+		return false
+	})
+}
+
+func authorize(ctx context.Context, allowed func(principal *Principal) bool) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.IsAdmin() {
+		return nil
+	}
+	if !allowed(principal) {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("not allowed to perform this action")
+)
+
+type Role string
+
+const (
+	RoleMember Role = "member"
+	RoleStaff  Role = "staff"
+	RoleAdmin  Role = "admin"
+)
+
+func (role Role) IsValid() bool {
+	switch role {
+	case RoleMember, RoleStaff, RoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Principal is the authenticated caller of a command or query.
+type Principal struct {
+	UserID string
+	Role   Role
+	// GymIDs are the gyms a staff member works at.
+	GymIDs []string
+}
+
+func (principal *Principal) IsAdmin() bool {
+	return principal.Role == RoleAdmin
+}
+
+func (principal *Principal) WorksAt(gymID string) bool {
+	return principal.Role == RoleStaff && slices.Contains(principal.GymIDs, gymID)
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller attached by the transport layer. Calls without a
+// principal come from trusted in-process callers or from a deployment with authentication
+// disabled, and are not restricted.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
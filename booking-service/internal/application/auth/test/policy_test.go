@@ -0,0 +1,129 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+)
+
+func TestBookingAuthorization(t *testing.T) {
+	repo := mocks.NewMockRepository()
+	publisher := mocks.NewMockEventPublisher()
+	getHandler := queries.NewGetBookingHandler(repo)
+	cancelHandler := commands.NewCancelBookingHandler(repo, publisher, mocks.NewLogger(), nil)
+	confirmHandler := commands.NewConfirmBookingHandler(repo, publisher, mocks.NewLogger())
+
+	member := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user1", Role: auth.RoleMember})
+	otherMember := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "user2", Role: auth.RoleMember})
+	staff := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "staff1", Role: auth.RoleStaff, GymIDs: []string{"gym1"}})
+	otherStaff := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "staff2", Role: auth.RoleStaff, GymIDs: []string{"gym2"}})
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin1", Role: auth.RoleAdmin})
+
+	newBooking := func(id string) {
+		now := time.Now()
+		record, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+		require.NoError(t, err)
+		record.ID = id
+		repo.AddBooking(record)
+	}
+	newBooking("booking1")
+
+	tests := []struct {
+		name    string
+		call    func() error
+		wantErr error
+	}{
+		{
+			name: "member views own booking",
+			call: func() error {
+				_, err := getHandler.Handle(member, queries.GetBookingQuery{BookingID: "booking1"})
+				return err
+			},
+		},
+		{
+			name: "member views someone else's booking",
+			call: func() error {
+				_, err := getHandler.Handle(otherMember, queries.GetBookingQuery{BookingID: "booking1"})
+				return err
+			},
+			wantErr: auth.ErrForbidden,
+		},
+		{
+			name: "staff views booking at their gym",
+			call: func() error {
+				_, err := getHandler.Handle(staff, queries.GetBookingQuery{BookingID: "booking1"})
+				return err
+			},
+		},
+		{
+			name: "member confirms own booking",
+			call: func() error {
+				return confirmHandler.Handle(member, commands.ConfirmBookingCommand{BookingID: "booking1"})
+			},
+			wantErr: auth.ErrForbidden,
+		},
+		{
+			name: "staff of another gym confirms",
+			call: func() error {
+				return confirmHandler.Handle(otherStaff, commands.ConfirmBookingCommand{BookingID: "booking1"})
+			},
+			wantErr: auth.ErrForbidden,
+		},
+		{
+			name: "staff confirms booking at their gym",
+			call: func() error {
+				return confirmHandler.Handle(staff, commands.ConfirmBookingCommand{BookingID: "booking1"})
+			},
+		},
+		{
+			name: "member cancels someone else's booking",
+			call: func() error {
+				return cancelHandler.Handle(otherMember, commands.CancelBookingCommand{BookingID: "booking1"})
+			},
+			wantErr: auth.ErrForbidden,
+		},
+		{
+			name: "admin cancels any booking",
+			call: func() error {
+				return cancelHandler.Handle(admin, commands.CancelBookingCommand{BookingID: "booking1"})
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("list and create", func(t *testing.T) {
+		assert.NoError(t, auth.AuthorizeList(member, "user1", ""))
+		assert.ErrorIs(t, auth.AuthorizeList(member, "", "gym1"), auth.ErrForbidden)
+		assert.NoError(t, auth.AuthorizeList(staff, "", "gym1"))
+		assert.ErrorIs(t, auth.AuthorizeList(otherStaff, "", "gym1"), auth.ErrForbidden)
+
+		assert.NoError(t, auth.AuthorizeWatch(member, "user1", "gym2"))
+		assert.ErrorIs(t, auth.AuthorizeWatch(member, "", ""), auth.ErrForbidden)
+		assert.NoError(t, auth.AuthorizeWatch(staff, "user2", "gym1"), "the gym filter suffices")
+		assert.ErrorIs(t, auth.AuthorizeWatch(otherStaff, "user2", "gym1"), auth.ErrForbidden)
+		assert.ErrorIs(t, auth.AuthorizeWatch(staff, "", ""), auth.ErrForbidden, "only admins watch every booking")
+		assert.NoError(t, auth.AuthorizeWatch(admin, "", ""))
+
+		assert.NoError(t, auth.AuthorizeCreate(member, "user1", "gym1"))
+		assert.ErrorIs(t, auth.AuthorizeCreate(member, "user2", "gym1"), auth.ErrForbidden)
+		assert.NoError(t, auth.AuthorizeCreate(context.Background(), "anyone", "gym1"))
+	})
+}
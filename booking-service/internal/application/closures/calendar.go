@@ -0,0 +1,212 @@
+package closures
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+// MaxImportedClosures bounds the events one calendar import may hold.
+const MaxImportedClosures = 500
+
+// ParseCalendar reads the events of an iCalendar (.ics) file as closures of the gym. Dates
+// and floating times are taken in location, the gym's time zone. An event without DTEND
+// lasts its DTSTART day. Only yearly recurrence is supported, as RRULE:FREQ=YEARLY with
+// at most a BYMONTH and BYMONTHDAY matching DTSTART. Cancelled events are skipped.
+func ParseCalendar(data string, gymID string, location *time.Location) ([]*closure.Closure, error) {
+	var closures []*closure.Closure
+	var event *calendarEvent
+	for number, line := range unfold(data) {
+		if line == "" {
+			continue
+		}
+		name, params, value, ok := splitProperty(line)
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d is not an iCalendar property", closure.ErrInvalidClosure, number+1)
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = &calendarEvent{line: number + 1}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if event == nil {
+				return nil, fmt.Errorf("%w: line %d ends an event that was not begun", closure.ErrInvalidClosure, number+1)
+			}
+			if !event.cancelled {
+				closureRecord, err := event.closure(gymID, location)
+				if err != nil {
+					return nil, err
+				}
+				closures = append(closures, closureRecord)
+				if len(closures) > MaxImportedClosures {
+					return nil, fmt.Errorf("%w: a calendar may hold at most %d events", closure.ErrInvalidClosure, MaxImportedClosures)
+				}
+			}
+			event = nil
+		case event != nil:
+			if err := event.set(name, params, value, location); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", closure.ErrInvalidClosure, number+1, err)
+			}
+		}
+	}
+
+	if event != nil {
+		return nil, fmt.Errorf("%w: the event begun on line %d is not ended", closure.ErrInvalidClosure, event.line)
+	}
+	if len(closures) == 0 {
+		return nil, fmt.Errorf("%w: the calendar holds no events", closure.ErrInvalidClosure)
+	}
+	return closures, nil
+}
+
+// calendarEvent collects the properties of one VEVENT.
+type calendarEvent struct {
+	line      int
+	start     time.Time
+	end       time.Time
+	allDay    bool
+	summary   string
+	yearly    bool
+	byMonth   string
+	byDay     string
+	cancelled bool
+}
+
+func (event *calendarEvent) set(name string, params map[string]string, value string, location *time.Location) error {
+	var err error
+	switch name {
+	case "DTSTART":
+		event.start, event.allDay, err = parseCalendarTime(params, value, location)
+	case "DTEND":
+		event.end, _, err = parseCalendarTime(params, value, location)
+	case "SUMMARY":
+		event.summary = unescapeText(value)
+	case "STATUS":
+		event.cancelled = strings.EqualFold(value, "CANCELLED")
+	case "RRULE":
+		err = event.setRecurrence(value)
+	case "RDATE", "EXDATE":
+		err = fmt.Errorf("%s is not supported", name)
+	}
+	return err
+}
+
+func (event *calendarEvent) setRecurrence(rule string) error {
+	for _, part := range strings.Split(rule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			if !strings.EqualFold(value, "YEARLY") {
+				return fmt.Errorf("only yearly recurrence is supported, not %s", value)
+			}
+			event.yearly = true
+		case "INTERVAL":
+			if value != "1" {
+				return fmt.Errorf("only yearly recurrence is supported, not every %s years", value)
+			}
+		case "BYMONTH":
+			event.byMonth = value
+		case "BYMONTHDAY":
+			event.byDay = value
+		default:
+			return fmt.Errorf("recurrence rule part %s is not supported", key)
+		}
+	}
+	if !event.yearly {
+		return fmt.Errorf("recurrence rule has no FREQ")
+	}
+	return nil
+}
+
+func (event *calendarEvent) closure(gymID string, location *time.Location) (*closure.Closure, error) {
+	if event.start.IsZero() {
+		return nil, fmt.Errorf("%w: the event begun on line %d has no DTSTART", closure.ErrInvalidClosure, event.line)
+	}
+	end := event.end
+	if end.IsZero() {
+		if !event.allDay {
+			return nil, fmt.Errorf("%w: the event begun on line %d has no DTEND", closure.ErrInvalidClosure, event.line)
+		}
+		end = event.start.AddDate(0, 0, 1)
+	}
+	if event.yearly {
+		local := event.start.In(location)
+		if (event.byMonth != "" && event.byMonth != fmt.Sprint(int(local.Month()))) ||
+			(event.byDay != "" && event.byDay != fmt.Sprint(local.Day())) {
+			return nil, fmt.Errorf("%w: the event begun on line %d recurs on other days than its DTSTART", closure.ErrInvalidClosure, event.line)
+		}
+	}
+
+	closureRecord, err := closure.NewClosure(gymID, event.start, end, event.summary, event.yearly)
+	if err != nil {
+		return nil, fmt.Errorf("%w (the event begun on line %d)", err, event.line)
+	}
+	return closureRecord, nil
+}
+
+// parseCalendarTime reads a DATE or DATE-TIME value. It reports whether the value is a
+// date, which starts at midnight in location.
+func parseCalendarTime(params map[string]string, value string, location *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		date, err := time.ParseInLocation("20060102", value, location)
+		return date, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		dateTime, err := time.Parse("20060102T150405Z", value)
+		return dateTime, false, err
+	}
+	if zone, ok := params["TZID"]; ok {
+		zoneLocation, err := time.LoadLocation(zone)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown time zone %q", zone)
+		}
+		location = zoneLocation
+	}
+	dateTime, err := time.ParseInLocation("20060102T150405", value, location)
+	return dateTime, false, err
+}
+
+// unfold joins the continuation lines of data, which start with a space or tab, to the
+// lines they continue.
+func unfold(data string) []string {
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	unfolded := make([]string, 0, len(lines))
+	// last is the index of the line continuation lines join, past the empty lines that
+	// stand in for earlier continuations.
+	last := -1
+	for _, line := range lines {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && last >= 0 {
+			unfolded[last] += strings.TrimRight(line[1:], "\r")
+			// Keep the line numbers of the lines after it.
+			unfolded = append(unfolded, "")
+			continue
+		}
+		last = len(unfolded)
+		unfolded = append(unfolded, strings.TrimRight(line, "\r"))
+	}
+	return unfolded
+}
+
+// splitProperty splits a content line such as DTSTART;TZID=Europe/Berlin:20261225T080000
+// into its upper-cased name, parameters and value.
+func splitProperty(line string) (string, map[string]string, string, bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", nil, "", false
+	}
+	parts := strings.Split(head, ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, param := range parts[1:] {
+		key, paramValue, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(paramValue, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, value, true
+}
+
+var textEscapes = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, " ", `\N`, " ")
+
+func unescapeText(value string) string {
+	return textEscapes.Replace(value)
+}
@@ -0,0 +1,116 @@
+// Package closures keeps bookings out of the periods gyms are closed, and finds the
+// bookings a new closure would fall on.
+package closures
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+// CancelReason is recorded on the bookings cancelled for a closure, followed by the
+// closure's reason when it has one.
+const CancelReason = "gym closed"
+
+// Checker evaluates bookings against the closures of their gym. Recurring closures are
+// placed in the time zone of the gym's policy.
+type Checker struct {
+	closures closure.Repository
+	bookings booking.Repository
+	policies *policies.Checker
+	now      func() time.Time
+}
+
+func NewChecker(closures closure.Repository, bookings booking.Repository, policies *policies.Checker) *Checker {
+	return &Checker{
+		closures: closures,
+		bookings: bookings,
+		policies: policies,
+		now:      time.Now,
+	}
+}
+
+// Conflict is an upcoming booking that falls on a closure.
+type Conflict struct {
+	Booking *booking.Booking
+	Closure *closure.Closure
+}
+
+// Check rejects bookings that overlap a closure of their gym.
+// It implements commands.BookingCheck.
+func (checker *Checker) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	closures, err := checker.closures.ListByGymID(ctx, candidate.GymID)
+	if err != nil || len(closures) == 0 {
+		return nil, err
+	}
+	location, err := checker.Location(ctx, candidate.GymID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, closureRecord := range closures {
+		if closureRecord.Overlaps(candidate.StartTime, candidate.EndTime, location) {
+			if closureRecord.Reason == "" {
+				return closure.ErrGymClosed, nil
+			}
+			return fmt.Errorf("%w: %s", closure.ErrGymClosed, closureRecord.Reason), nil
+		}
+	}
+	return nil, nil
+}
+
+// Location returns the time zone of the gym.
+func (checker *Checker) Location(ctx context.Context, gymID string) (*time.Location, error) {
+	gymPolicy, err := checker.policies.Policy(ctx, gymID)
+	if err != nil {
+		return nil, err
+	}
+	return gymPolicy.Location(), nil
+}
+
+// Conflicts returns the gym's pending and confirmed bookings that have not ended and
+// overlap any of closures, each with the first closure it falls on, earliest first.
+func (checker *Checker) Conflicts(ctx context.Context, gymID string, closures []*closure.Closure, location *time.Location) ([]Conflict, error) {
+	now := checker.now()
+	// Only recurring closures reach past the last end time.
+	var until time.Time
+	for _, closureRecord := range closures {
+		if closureRecord.Recurring {
+			until = time.Time{}
+			break
+		}
+		if closureRecord.EndTime.After(until) {
+			until = closureRecord.EndTime
+		}
+	}
+	if !until.IsZero() && !until.After(now) {
+		return nil, nil
+	}
+
+	upcoming, err := checker.bookings.ListActiveByGymID(ctx, gymID, now, until)
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []Conflict
+	for _, bookingRecord := range upcoming {
+		for _, closureRecord := range closures {
+			if closureRecord.Overlaps(bookingRecord.StartTime, bookingRecord.EndTime, location) {
+				conflicts = append(conflicts, Conflict{Booking: bookingRecord, Closure: closureRecord})
+				break
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// CancelReasonFor returns the reason recorded on bookings cancelled for closureRecord.
+func CancelReasonFor(closureRecord *closure.Closure) string {
+	if closureRecord.Reason == "" {
+		return CancelReason
+	}
+	return CancelReason + ": " + closureRecord.Reason
+}
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/closures"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+func calendar(lines ...string) string {
+	return strings.Join(append(append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...), "END:VCALENDAR"), "\r\n")
+}
+
+func TestParseCalendar(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	parsed, err := closures.ParseCalendar(calendar(
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20301225",
+		"RRULE:FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25",
+		"SUMMARY:Christmas Day\\, closed",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20300601T060000Z",
+		"DTEND:20300601T100000Z",
+		"SUMMARY:Floor maintenance in the main hall and the two",
+		"  studios",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=America/New_York:20300704T080000",
+		"DTEND;TZID=America/New_York:20300704T120000",
+		"SUMMARY:Independence",
+		"  Day\\, closed in t",
+		"\the morning",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20300101",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+	), "gym1", berlin)
+	require.NoError(t, err)
+	require.Len(t, parsed, 3, "cancelled events are skipped")
+
+	assert.Equal(t, "gym1", parsed[0].GymID)
+	assert.Equal(t, "Christmas Day, closed", parsed[0].Reason)
+	assert.True(t, parsed[0].Recurring)
+	assert.True(t, parsed[0].StartTime.Equal(time.Date(2030, time.December, 25, 0, 0, 0, 0, berlin)), "dates start at midnight in the gym's time zone")
+	assert.True(t, parsed[0].EndTime.Equal(time.Date(2030, time.December, 26, 0, 0, 0, 0, berlin)), "all-day events without DTEND last a day")
+
+	assert.Equal(t, "Floor maintenance in the main hall and the two studios", parsed[1].Reason)
+	assert.False(t, parsed[1].Recurring)
+	assert.True(t, parsed[1].StartTime.Equal(time.Date(2030, time.June, 1, 6, 0, 0, 0, time.UTC)))
+	assert.True(t, parsed[1].EndTime.Equal(time.Date(2030, time.June, 1, 10, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, "Independence Day, closed in the morning", parsed[2].Reason, "lines may be folded more than once")
+	assert.True(t, parsed[2].StartTime.Equal(time.Date(2030, time.July, 4, 12, 0, 0, 0, time.UTC)), "TZID is honoured")
+}
+
+func TestParseCalendarRejects(t *testing.T) {
+	event := func(lines ...string) string {
+		return calendar(append(append([]string{"BEGIN:VEVENT"}, lines...), "END:VEVENT")...)
+	}
+
+	for name, data := range map[string]string{
+		"no events":         calendar(),
+		"not a calendar":    "closed on Sundays",
+		"weekly recurrence": event("DTSTART;VALUE=DATE:20301225", "RRULE:FREQ=WEEKLY"),
+		"every two years":   event("DTSTART;VALUE=DATE:20301225", "RRULE:FREQ=YEARLY;INTERVAL=2"),
+		"other day":         event("DTSTART;VALUE=DATE:20301225", "RRULE:FREQ=YEARLY;BYMONTHDAY=26"),
+		"no start":          event("SUMMARY:Closed"),
+		"no end":            event("DTSTART:20300601T060000Z"),
+		"end before start":  event("DTSTART:20300601T060000Z", "DTEND:20300601T050000Z"),
+		"bad date":          event("DTSTART:2030-06-01"),
+		"unknown zone":      event("DTSTART;TZID=Mars/Olympus:20300601T060000", "DTEND:20300601T100000Z"),
+		"unterminated":      calendar("BEGIN:VEVENT", "DTSTART;VALUE=DATE:20301225"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := closures.ParseCalendar(data, "gym1", time.UTC)
+			assert.ErrorIs(t, err, closure.ErrInvalidClosure)
+		})
+	}
+}
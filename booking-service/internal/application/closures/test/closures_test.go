@@ -0,0 +1,222 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/closures"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+	closuremocks "github.com/yourusername/fitbook/booking-service/internal/domain/closure/test/mocks"
+	policymocks "github.com/yourusername/fitbook/booking-service/internal/domain/policy/test/mocks"
+)
+
+// bookingAt returns a booking for userID at gymID from start for an hour.
+func bookingAt(userID, gymID string, start time.Time) commands.CreateBookingCommand {
+	return commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+		UserID:    userID,
+		GymID:     gymID,
+		StartTime: start.Format(time.RFC3339),
+		EndTime:   start.Add(time.Hour).Format(time.RFC3339),
+	}}
+}
+
+func TestClosures(t *testing.T) {
+	ctx := context.Background()
+	bookingRepo := mocks.NewMockRepository()
+	closureRepo := closuremocks.NewMockRepository()
+	publisher := mocks.NewMockEventPublisher()
+	checker := closures.NewChecker(closureRepo, bookingRepo, policies.NewChecker(policymocks.NewMockRepository(), bookingRepo))
+	book := commands.NewCreateBookingHandler(bookingRepo, publisher, mocks.NewLogger(), checker)
+	closeGym := commands.NewCreateClosureHandler(closureRepo, checker, bookingRepo, publisher, mocks.NewLogger())
+
+	// closeMorning closes gym1 from 08:00 to 12:00 tomorrow.
+	closeMorning := func(cancel, dryRun bool) (*commands.CreateClosuresResult, error) {
+		return closeGym.Handle(ctx, commands.CreateClosureCommand{GymID: "gym1", DTO: &dtos.CreateClosureDTO{
+			StartTime:      mocks.Tomorrow(8).Format(time.RFC3339),
+			EndTime:        mocks.Tomorrow(12).Format(time.RFC3339),
+			Reason:         "Floor maintenance",
+			CancelBookings: cancel,
+			DryRun:         dryRun,
+		}})
+	}
+
+	inside, err := book.Handle(ctx, bookingAt("user1", "gym1", mocks.Tomorrow(9)))
+	require.NoError(t, err)
+	outside, err := book.Handle(ctx, bookingAt("user2", "gym1", mocks.Tomorrow(14)))
+	require.NoError(t, err)
+	publisher.Clear()
+
+	tests := []struct {
+		name    string
+		call    func(t *testing.T) error
+		wantErr error
+	}{
+		{
+			name: "closing over a booking",
+			call: func(t *testing.T) error {
+				_, err := closeMorning(false, false)
+				return err
+			},
+			wantErr: closure.ErrClosureHasBookings,
+		},
+		{
+			name: "dry run",
+			call: func(t *testing.T) error {
+				preview, err := closeMorning(false, true)
+				require.NoError(t, err)
+				assert.True(t, preview.Result.DryRun)
+				require.Len(t, preview.Result.AffectedBookings, 1)
+				assert.Equal(t, inside.Booking.ID, preview.Result.AffectedBookings[0].ID)
+				assert.Empty(t, preview.Result.Closures[0].ID)
+
+				stored, err := closureRepo.ListByGymID(ctx, "gym1")
+				require.NoError(t, err)
+				assert.Empty(t, stored, "a dry run stores nothing")
+				assert.Empty(t, publisher.GetEvents())
+				return nil
+			},
+		},
+		{
+			name: "closing and cancelling the booking",
+			call: func(t *testing.T) error {
+				result, err := closeMorning(true, false)
+				require.NoError(t, err)
+				require.Len(t, result.Result.AffectedBookings, 1)
+				assert.NotEmpty(t, result.Result.Closures[0].ID)
+
+				cancelled, err := bookingRepo.GetByID(ctx, inside.Booking.ID)
+				require.NoError(t, err)
+				assert.Equal(t, booking.StatusCancelled, cancelled.Status)
+				assert.Equal(t, "gym closed: Floor maintenance", cancelled.StatusReason)
+				kept, err := bookingRepo.GetByID(ctx, outside.Booking.ID)
+				require.NoError(t, err)
+				assert.Equal(t, booking.StatusPending, kept.Status)
+
+				events := publisher.GetEvents()
+				require.Len(t, events, 2)
+				assert.Equal(t, "booking.cancelled", events[0].EventName())
+				created, ok := events[1].(closure.ClosureCreatedEvent)
+				require.True(t, ok)
+				assert.Equal(t, 1, created.CancelledBookings)
+				return nil
+			},
+		},
+		{
+			name: "booking into the closure",
+			call: func(t *testing.T) error {
+				_, err := book.Handle(ctx, bookingAt("user1", "gym1", mocks.Tomorrow(11)))
+				assert.ErrorContains(t, err, "Floor maintenance")
+				return err
+			},
+			wantErr: closure.ErrGymClosed,
+		},
+		{
+			name: "booking as the closure ends",
+			call: func(t *testing.T) error {
+				_, err := book.Handle(ctx, bookingAt("user1", "gym1", mocks.Tomorrow(12)))
+				return err
+			},
+		},
+		{
+			name: "booking another gym during the closure",
+			call: func(t *testing.T) error {
+				_, err := book.Handle(ctx, bookingAt("user1", "gym2", mocks.Tomorrow(9)))
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(t)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestImportClosures(t *testing.T) {
+	ctx := context.Background()
+	bookingRepo := mocks.NewMockRepository()
+	closureRepo := closuremocks.NewMockRepository()
+	publisher := mocks.NewMockEventPublisher()
+	checker := closures.NewChecker(closureRepo, bookingRepo, policies.NewChecker(policymocks.NewMockRepository(), bookingRepo))
+	book := commands.NewCreateBookingHandler(bookingRepo, publisher, mocks.NewLogger(), checker)
+	importICS := commands.NewImportClosuresHandler(checker, commands.NewCreateClosureHandler(closureRepo, checker, bookingRepo, publisher, mocks.NewLogger()))
+
+	_, err := book.Handle(ctx, bookingAt("user1", "gym1", mocks.Tomorrow(9)))
+	require.NoError(t, err)
+	data := calendar(
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:"+mocks.Tomorrow(0).Format("20060102"),
+		"RRULE:FREQ=YEARLY",
+		"SUMMARY:Founders' Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:"+mocks.Tomorrow(0).AddDate(0, 0, 7).Format("20060102"),
+		"SUMMARY:Deep clean",
+		"END:VEVENT",
+	)
+
+	tests := []struct {
+		name    string
+		call    func(t *testing.T) error
+		wantErr error
+	}{
+		{
+			name: "importing over a booking",
+			call: func(t *testing.T) error {
+				_, err := importICS.Handle(ctx, commands.ImportClosuresCommand{GymID: "gym1", DTO: &dtos.ImportClosuresDTO{Calendar: data}})
+				return err
+			},
+			wantErr: closure.ErrClosureHasBookings,
+		},
+		{
+			name: "importing and cancelling the booking",
+			call: func(t *testing.T) error {
+				result, err := importICS.Handle(ctx, commands.ImportClosuresCommand{GymID: "gym1", DTO: &dtos.ImportClosuresDTO{
+					Calendar:       data,
+					CancelBookings: true,
+				}})
+				require.NoError(t, err)
+				assert.Len(t, result.Result.Closures, 2)
+				assert.Len(t, result.Result.AffectedBookings, 1)
+
+				stored, err := closureRepo.ListByGymID(ctx, "gym1")
+				require.NoError(t, err)
+				assert.Len(t, stored, 2)
+				return nil
+			},
+		},
+		{
+			name: "booking when a yearly closure recurs",
+			call: func(t *testing.T) error {
+				_, err := book.Handle(ctx, bookingAt("user1", "gym1", mocks.Tomorrow(9).AddDate(1, 0, 0)))
+				return err
+			},
+			wantErr: closure.ErrGymClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(t)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+// AddTrainerTimeOffCommand blocks out time a trainer takes no appointments in. It is
+// refused while the trainer has appointments during that time; those have to be cancelled
+// or moved first.
+type AddTrainerTimeOffCommand struct {
+	TrainerID string
+	DTO       *dtos.AddTimeOffDTO
+}
+
+type AddTrainerTimeOffResult struct {
+	TimeOff *dtos.TimeOffDTO
+}
+
+type AddTrainerTimeOffHandler struct {
+	repo     trainer.Repository
+	bookings booking.Repository
+	logger   *slog.Logger
+}
+
+func NewAddTrainerTimeOffHandler(repo trainer.Repository, bookings booking.Repository, logger *slog.Logger) *AddTrainerTimeOffHandler {
+	return &AddTrainerTimeOffHandler{
+		repo:     repo,
+		bookings: bookings,
+		logger:   logger,
+	}
+}
+
+func (handler *AddTrainerTimeOffHandler) Handle(ctx context.Context, cmd AddTrainerTimeOffCommand) (_ *AddTrainerTimeOffResult, err error) {
+	ctx, span := tracer.Start(ctx, "AddTrainerTimeOffHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateTrainerID(cmd.TrainerID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageTrainers(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := handler.repo.GetByID(ctx, cmd.TrainerID); err != nil {
+		return nil, err
+	}
+
+	timeOff, err := cmd.DTO.ToDomain(cmd.TrainerID)
+	if errors.Is(err, trainer.ErrInvalidTimeOff) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+
+	booked, err := handler.bookings.ListActiveByTrainerID(ctx, cmd.TrainerID, timeOff.StartTime, timeOff.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if len(booked) > 0 {
+		return nil, fmt.Errorf("%w: %d appointments fall within the time off", trainer.ErrTrainerBooked, len(booked))
+	}
+
+	timeOff.ID = uuid.New().String()
+	if err := handler.repo.AddTimeOff(ctx, timeOff); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "trainer time off added",
+		slog.String("trainer_id", timeOff.TrainerID),
+		slog.String("time_off_id", timeOff.ID),
+	)
+
+	return &AddTrainerTimeOffResult{
+		TimeOff: dtos.FromTimeOff(timeOff),
+	}, nil
+}
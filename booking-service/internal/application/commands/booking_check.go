@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// BookingCheck vets a new booking before it is stored, on top of the overlap check every
+// booking gets. pending are the bookings accepted before candidate in the same request,
+// which are not stored yet. A reason to refuse candidate is returned as rejection; err is
+// reserved for failures to decide, which abort the request.
+type BookingCheck interface {
+	Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (rejection error, err error)
+}
+
+// runChecks returns the first rejection or failure of checks.
+func runChecks(ctx context.Context, checks []BookingCheck, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	for _, check := range checks {
+		rejection, err := check.Check(ctx, candidate, pending)
+		if rejection != nil || err != nil {
+			return rejection, err
+		}
+	}
+	return nil, nil
+}
+
+// vetBooking rejects candidate when it overlaps a pending or confirmed booking of its gym,
+// stored or among pending, other than the one it replaces, and otherwise runs checks on it.
+// Create, batch create and reschedule all vet bookings this way.
+func vetBooking(ctx context.Context, repo booking.Repository, checks []BookingCheck, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	existing, err := repo.ListActiveByGymID(ctx, candidate.GymID, candidate.StartTime, candidate.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range append(existing, pending...) {
+		if other.ID != candidate.ID && candidate.OverlapsWith(other) {
+			return booking.ErrOverlappingBooking, nil
+		}
+	}
+	return runChecks(ctx, checks, candidate, pending)
+}
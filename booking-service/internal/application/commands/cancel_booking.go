@@ -2,28 +2,43 @@ package commands
 
 import (
 	"context"
+	"log/slog"
 
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/strikes"
 	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
 )
 
 type CancelBookingCommand struct {
 	BookingID string `json:"booking_id" validate:"required"`
+	// Reason is optional and is recorded in the booking's history.
+	Reason string `json:"reason,omitempty"`
 }
 
 type CancelBookingHandler struct {
 	repo      booking.Repository
 	publisher booking.EventPublisher
+	logger    *slog.Logger
+	strikes   *strikes.Tracker
 }
 
-func NewCancelBookingHandler(repo booking.Repository, publisher booking.EventPublisher) *CancelBookingHandler {
+// NewCancelBookingHandler flags late cancellations and records a strike for them through
+// strikes. A nil strikes lets members cancel at any time.
+func NewCancelBookingHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger, strikes *strikes.Tracker) *CancelBookingHandler {
 	return &CancelBookingHandler{
 		repo:      repo,
 		publisher: publisher,
+		logger:    logger,
+		strikes:   strikes,
 	}
 }
 
-func (handler *CancelBookingHandler) Handle(ctx context.Context, cmd CancelBookingCommand) error {
+func (handler *CancelBookingHandler) Handle(ctx context.Context, cmd CancelBookingCommand) (err error) {
+	ctx, span := tracer.Start(ctx, "CancelBookingHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
 	if err := validator.ValidateBookingID(cmd.BookingID); err != nil {
 		return err
 	}
@@ -33,14 +48,45 @@ func (handler *CancelBookingHandler) Handle(ctx context.Context, cmd CancelBooki
 		return err
 	}
 
-	if err := bookingRecord.Cancel(); err != nil {
+	if err := auth.AuthorizeCancel(ctx, bookingRecord); err != nil {
+		return err
+	}
+
+	late := false
+	if handler.strikes != nil {
+		if late, err = handler.strikes.IsLateCancellation(ctx, bookingRecord); err != nil {
+			return err
+		}
+	}
+
+	if err := bookingRecord.CancelWithReason(cmd.Reason); err != nil {
 		return err
 	}
+	bookingRecord.CancelledLate = late
 
 	if err := handler.repo.Update(ctx, bookingRecord); err != nil {
 		return err
 	}
 
+	if late {
+		// The cancellation stands even if its strike is lost.
+		if err := handler.strikes.Record(ctx, bookingRecord, strike.ReasonLateCancellation); err != nil {
+			handler.logger.ErrorContext(ctx, "failed to record strike",
+				slog.String("booking_id", bookingRecord.ID),
+				slog.String("reason", string(strike.ReasonLateCancellation)),
+				slog.Any("error", err),
+			)
+		}
+	}
+
 	event := booking.NewBookingEvent(bookingRecord, "cancelled")
-	return handler.publisher.Publish(event)
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", bookingRecord.ID),
+			slog.Any("error", err),
+		)
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+// DefaultSessionCancelReason is recorded on enrollments of a session cancelled without a
+// reason.
+const DefaultSessionCancelReason = "class session cancelled"
+
+// CancelClassSessionCommand calls a session off and cancels every enrollment in it.
+type CancelClassSessionCommand struct {
+	SessionID string
+	// Reason is optional and is recorded on the session and in each enrollment's history.
+	Reason string
+}
+
+type CancelClassSessionResult struct {
+	Cancelled *dtos.CancelledClassSessionDTO
+}
+
+type CancelClassSessionHandler struct {
+	sessions    session.Repository
+	bookings    booking.Repository
+	enrollments *sessions.EnrollmentCheck
+	publisher   booking.EventPublisher
+	logger      *slog.Logger
+}
+
+func NewCancelClassSessionHandler(
+	sessions session.Repository,
+	bookings booking.Repository,
+	enrollments *sessions.EnrollmentCheck,
+	publisher booking.EventPublisher,
+	logger *slog.Logger,
+) *CancelClassSessionHandler {
+	return &CancelClassSessionHandler{
+		sessions:    sessions,
+		bookings:    bookings,
+		enrollments: enrollments,
+		publisher:   publisher,
+		logger:      logger,
+	}
+}
+
+// Handle cancels the enrollments before the session, so that a request failing halfway can
+// be repeated: enrollments cancelled already are skipped.
+func (handler *CancelClassSessionHandler) Handle(ctx context.Context, cmd CancelClassSessionCommand) (_ *CancelClassSessionResult, err error) {
+	ctx, span := tracer.Start(ctx, "CancelClassSessionHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateSessionID(cmd.SessionID); err != nil {
+		return nil, err
+	}
+
+	classSession, err := handler.sessions.GetByID(ctx, cmd.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, classSession.GymID); err != nil {
+		return nil, err
+	}
+	if classSession.Status == session.StatusCancelled {
+		return nil, session.ErrSessionCancelled
+	}
+
+	reason := cmd.Reason
+	if reason == "" {
+		reason = DefaultSessionCancelReason
+	}
+
+	enrolled, err := handler.enrollments.Enrolled(ctx, classSession.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, enrollment := range enrolled {
+		if err := enrollment.CancelWithReason(reason); err != nil {
+			return nil, err
+		}
+		if err := handler.bookings.Update(ctx, enrollment); err != nil {
+			return nil, err
+		}
+		handler.publish(ctx, booking.NewBookingEvent(enrollment, "cancelled"), enrollment.ID)
+	}
+
+	if err := classSession.Cancel(cmd.Reason); err != nil {
+		return nil, err
+	}
+	if err := handler.sessions.Update(ctx, classSession); err != nil {
+		return nil, err
+	}
+	handler.publish(ctx, session.NewClassSessionCancelledEvent(classSession, len(enrolled)), "")
+
+	handler.logger.InfoContext(ctx, "class session cancelled",
+		slog.String("session_id", classSession.ID),
+		slog.Int("cancelled_enrollments", len(enrolled)),
+	)
+
+	return &CancelClassSessionResult{
+		Cancelled: &dtos.CancelledClassSessionDTO{
+			Session:              dtos.FromClassSession(classSession, 0),
+			CancelledEnrollments: len(enrolled),
+		},
+	}, nil
+}
+
+// publish logs instead of failing: the cancellations are stored already.
+func (handler *CancelClassSessionHandler) publish(ctx context.Context, event booking.Event, bookingID string) {
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", bookingID),
+			slog.Any("error", err),
+		)
+	}
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/strikes"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+)
+
+// ClearStrikesCommand forgives a member's strikes at a gym, which lifts any suspension
+// they earned there.
+type ClearStrikesCommand struct {
+	UserID string
+	GymID  string
+}
+
+type ClearStrikesResult struct {
+	Cleared *dtos.ClearedStrikesDTO
+}
+
+type ClearStrikesHandler struct {
+	tracker *strikes.Tracker
+	logger  *slog.Logger
+}
+
+func NewClearStrikesHandler(tracker *strikes.Tracker, logger *slog.Logger) *ClearStrikesHandler {
+	return &ClearStrikesHandler{
+		tracker: tracker,
+		logger:  logger,
+	}
+}
+
+func (handler *ClearStrikesHandler) Handle(ctx context.Context, cmd ClearStrikesCommand) (_ *ClearStrikesResult, err error) {
+	ctx, span := tracer.Start(ctx, "ClearStrikesHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateUserID(cmd.UserID); err != nil {
+		return nil, err
+	}
+	if err := validator.ValidateGymID(cmd.GymID); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, cmd.GymID); err != nil {
+		return nil, err
+	}
+
+	clearedBy := ""
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		clearedBy = principal.UserID
+	}
+	cleared, err := handler.tracker.Clear(ctx, cmd.UserID, cmd.GymID, clearedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "member strikes cleared",
+		slog.String("user_id", cmd.UserID),
+		slog.String("gym_id", cmd.GymID),
+		slog.Int("cleared", cleared),
+	)
+
+	return &ClearStrikesResult{
+		Cleared: &dtos.ClearedStrikesDTO{UserID: cmd.UserID, GymID: cmd.GymID, Cleared: cleared},
+	}, nil
+}
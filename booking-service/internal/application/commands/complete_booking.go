@@ -2,7 +2,9 @@ package commands
 
 import (
 	"context"
+	"log/slog"
 
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
 	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
 )
@@ -14,16 +16,21 @@ type CompleteBookingCommand struct {
 type CompleteBookingHandler struct {
 	repo      booking.Repository
 	publisher booking.EventPublisher
+	logger    *slog.Logger
 }
 
-func NewCompleteBookingHandler(repo booking.Repository, publisher booking.EventPublisher) *CompleteBookingHandler {
+func NewCompleteBookingHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger) *CompleteBookingHandler {
 	return &CompleteBookingHandler{
 		repo:      repo,
 		publisher: publisher,
+		logger:    logger,
 	}
 }
 
-func (handler *CompleteBookingHandler) Handle(ctx context.Context, cmd CompleteBookingCommand) error {
+func (handler *CompleteBookingHandler) Handle(ctx context.Context, cmd CompleteBookingCommand) (err error) {
+	ctx, span := tracer.Start(ctx, "CompleteBookingHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
 	if err := validator.ValidateBookingID(cmd.BookingID); err != nil {
 		return err
 	}
@@ -33,6 +40,10 @@ func (handler *CompleteBookingHandler) Handle(ctx context.Context, cmd CompleteB
 		return err
 	}
 
+	if err := auth.AuthorizeManage(ctx, bookingRecord); err != nil {
+		return err
+	}
+
 	if err := bookingRecord.Complete(); err != nil {
 		return err
 	}
@@ -42,5 +53,13 @@ func (handler *CompleteBookingHandler) Handle(ctx context.Context, cmd CompleteB
 	}
 
 	event := booking.NewBookingEvent(bookingRecord, "completed")
-	return handler.publisher.Publish(event)
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", bookingRecord.ID),
+			slog.Any("error", err),
+		)
+		return err
+	}
+	return nil
 }
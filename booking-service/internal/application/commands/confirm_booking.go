@@ -2,7 +2,9 @@ package commands
 
 import (
 	"context"
+	"log/slog"
 
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
 	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
 )
@@ -14,16 +16,21 @@ type ConfirmBookingCommand struct {
 type ConfirmBookingHandler struct {
 	repo      booking.Repository
 	publisher booking.EventPublisher
+	logger    *slog.Logger
 }
 
-func NewConfirmBookingHandler(repo booking.Repository, publisher booking.EventPublisher) *ConfirmBookingHandler {
+func NewConfirmBookingHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger) *ConfirmBookingHandler {
 	return &ConfirmBookingHandler{
 		repo:      repo,
 		publisher: publisher,
+		logger:    logger,
 	}
 }
 
-func (handler *ConfirmBookingHandler) Handle(ctx context.Context, cmd ConfirmBookingCommand) error {
+func (handler *ConfirmBookingHandler) Handle(ctx context.Context, cmd ConfirmBookingCommand) (err error) {
+	ctx, span := tracer.Start(ctx, "ConfirmBookingHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
 	if err := validator.ValidateBookingID(cmd.BookingID); err != nil {
 		return err
 	}
@@ -33,6 +40,10 @@ func (handler *ConfirmBookingHandler) Handle(ctx context.Context, cmd ConfirmBoo
 		return err
 	}
 
+	if err := auth.AuthorizeManage(ctx, bookingRecord); err != nil {
+		return err
+	}
+
 	if err := bookingRecord.Confirm(); err != nil {
 		return err
 	}
@@ -42,5 +53,13 @@ func (handler *ConfirmBookingHandler) Handle(ctx context.Context, cmd ConfirmBoo
 	}
 
 	event := booking.NewBookingEvent(bookingRecord, "confirmed")
-	return handler.publisher.Publish(event)
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", bookingRecord.ID),
+			slog.Any("error", err),
+		)
+		return err
+	}
+	return nil
 }
@@ -2,9 +2,10 @@ package commands
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 
 	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
 	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
 	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
@@ -21,16 +22,25 @@ type CreateBookingResult struct {
 type CreateBookingHandler struct {
 	repo      booking.Repository
 	publisher booking.EventPublisher
+	logger    *slog.Logger
+	checks    []BookingCheck
 }
 
-func NewCreateBookingHandler(repo booking.Repository, publisher booking.EventPublisher) *CreateBookingHandler {
+// NewCreateBookingHandler returns a handler that runs checks, in order, on every booking
+// that does not overlap an existing one.
+func NewCreateBookingHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger, checks ...BookingCheck) *CreateBookingHandler {
 	return &CreateBookingHandler{
 		repo:      repo,
 		publisher: publisher,
+		logger:    logger,
+		checks:    checks,
 	}
 }
 
-func (handler *CreateBookingHandler) Handle(ctx context.Context, cmd CreateBookingCommand) (*CreateBookingResult, error) {
+func (handler *CreateBookingHandler) Handle(ctx context.Context, cmd CreateBookingCommand) (_ *CreateBookingResult, err error) {
+	ctx, span := tracer.Start(ctx, "CreateBookingHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
 	if err := validator.ValidateCreateBookingDTO(cmd.DTO); err != nil {
 		return nil, err
 	}
@@ -40,8 +50,7 @@ func (handler *CreateBookingHandler) Handle(ctx context.Context, cmd CreateBooki
 		return nil, err
 	}
 
-	existingBookings, err := handler.repo.ListByGymID(ctx, gymID, startTime, endTime)
-	if err != nil {
+	if err := auth.AuthorizeCreate(ctx, userID, gymID); err != nil {
 		return nil, err
 	}
 
@@ -51,23 +60,30 @@ func (handler *CreateBookingHandler) Handle(ctx context.Context, cmd CreateBooki
 	}
 
 	newBooking.ID = uuid.New().String()
+	newBooking.SessionID = cmd.DTO.SessionID
+	newBooking.TrainerID = cmd.DTO.TrainerID
+	newBooking.ResourceID = cmd.DTO.ResourceID
 
-	for _, existing := range existingBookings {
-		if newBooking.OverlapsWith(existing) {
-			return nil, booking.ErrOverlappingBooking
-		}
+	rejection, err := vetBooking(ctx, handler.repo, handler.checks, newBooking, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rejection != nil {
+		return nil, rejection
 	}
-
-	fmt.Printf("new booking: %+v\n", newBooking)
 
 	if err := handler.repo.Create(ctx, newBooking); err != nil {
 		return nil, err
 	}
 
 	event := booking.NewBookingEvent(newBooking, "created")
-	if err := handler.publisher.Publish(event); err != nil {
-		// Log the error but don't fail the request
-		// TODO: Add proper logging
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		// The booking is stored; a failed publish must not fail the request.
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", newBooking.ID),
+			slog.Any("error", err),
+		)
 	}
 
 	return &CreateBookingResult{
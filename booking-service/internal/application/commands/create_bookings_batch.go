@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+const MaxBatchSize = 100
+
+type CreateBookingsBatchCommand struct {
+	DTO *dtos.BatchCreateBookingsDTO
+}
+
+type CreateBookingsBatchResult struct {
+	Bookings []*dtos.BookingDTO
+}
+
+// BatchItemError is the reason a single item of a batch was rejected.
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+// BatchRejectedError is returned when at least one item of a batch is invalid. Nothing
+// from the batch has been written when it is returned.
+type BatchRejectedError struct {
+	Items []BatchItemError
+}
+
+func (err *BatchRejectedError) Error() string {
+	return fmt.Sprintf("batch rejected: %d of its bookings are invalid", len(err.Items))
+}
+
+type CreateBookingsBatchHandler struct {
+	repo      booking.Repository
+	publisher booking.EventPublisher
+	logger    *slog.Logger
+	checks    []BookingCheck
+}
+
+// NewCreateBookingsBatchHandler returns a handler that runs checks, in order, on every item
+// that does not overlap an existing booking or an earlier item.
+func NewCreateBookingsBatchHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger, checks ...BookingCheck) *CreateBookingsBatchHandler {
+	return &CreateBookingsBatchHandler{
+		repo:      repo,
+		publisher: publisher,
+		logger:    logger,
+		checks:    checks,
+	}
+}
+
+func (handler *CreateBookingsBatchHandler) Handle(ctx context.Context, cmd CreateBookingsBatchCommand) (_ *CreateBookingsBatchResult, err error) {
+	ctx, span := tracer.Start(ctx, "CreateBookingsBatchHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if len(cmd.DTO.Bookings) == 0 {
+		return nil, validator.NewValidationError(dtos.NewValidationErrorDTO("bookings", "is required", validator.CodeRequired))
+	}
+	if len(cmd.DTO.Bookings) > MaxBatchSize {
+		return nil, validator.NewValidationError(dtos.NewValidationErrorDTO("bookings", fmt.Sprintf("must be at most %d items long", MaxBatchSize), validator.CodeTooLong))
+	}
+
+	var rejected []BatchItemError
+	accepted := make([]*booking.Booking, 0, len(cmd.DTO.Bookings))
+
+	for index, dto := range cmd.DTO.Bookings {
+		newBooking, itemErr, err := handler.prepare(ctx, dto, accepted)
+		if err != nil {
+			return nil, err
+		}
+		if itemErr != nil {
+			rejected = append(rejected, BatchItemError{Index: index, Err: itemErr})
+			continue
+		}
+		accepted = append(accepted, newBooking)
+	}
+
+	if len(rejected) > 0 {
+		return nil, &BatchRejectedError{Items: rejected}
+	}
+
+	if err := handler.repo.CreateMany(ctx, accepted); err != nil {
+		return nil, err
+	}
+
+	result := &CreateBookingsBatchResult{
+		Bookings: make([]*dtos.BookingDTO, len(accepted)),
+	}
+	for i, newBooking := range accepted {
+		event := booking.NewBookingEvent(newBooking, "created")
+		if err := handler.publisher.Publish(ctx, event); err != nil {
+			// The batch is already committed; a failed publish must not report it as failed.
+			handler.logger.ErrorContext(ctx, "failed to publish booking event",
+				slog.String("event", event.EventName()),
+				slog.String("booking_id", newBooking.ID),
+				slog.Any("error", err),
+			)
+		}
+		result.Bookings[i] = dtos.FromDomain(newBooking)
+	}
+
+	return result, nil
+}
+
+// prepare validates one item against the stored bookings and the items accepted before it.
+// Problems with the item itself are returned as itemErr; err is reserved for failures that
+// should abort the whole batch.
+func (handler *CreateBookingsBatchHandler) prepare(ctx context.Context, dto *dtos.CreateBookingDTO, accepted []*booking.Booking) (newBooking *booking.Booking, itemErr error, err error) {
+	if dto == nil {
+		return nil, booking.ErrInvalidInput, nil
+	}
+	if err := validator.ValidateCreateBookingDTO(dto); err != nil {
+		return nil, err, nil
+	}
+
+	userID, gymID, startTime, endTime, err := dto.ToDomain()
+	if err != nil {
+		return nil, booking.ErrInvalidInput, nil
+	}
+
+	if err := auth.AuthorizeCreate(ctx, userID, gymID); err != nil {
+		return nil, err, nil
+	}
+
+	newBooking, err = booking.NewBooking(userID, gymID, startTime, endTime)
+	if err != nil {
+		return nil, err, nil
+	}
+	newBooking.ID = uuid.New().String()
+	newBooking.SessionID = dto.SessionID
+	newBooking.TrainerID = dto.TrainerID
+	newBooking.ResourceID = dto.ResourceID
+
+	rejection, err := vetBooking(ctx, handler.repo, handler.checks, newBooking, accepted)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rejection != nil {
+		return nil, rejection, nil
+	}
+
+	return newBooking, nil, nil
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+type CreateClassSessionCommand struct {
+	DTO *dtos.CreateClassSessionDTO
+}
+
+type ClassSessionResult struct {
+	Session *dtos.ClassSessionDTO
+}
+
+type CreateClassSessionHandler struct {
+	repo   session.Repository
+	logger *slog.Logger
+}
+
+func NewCreateClassSessionHandler(repo session.Repository, logger *slog.Logger) *CreateClassSessionHandler {
+	return &CreateClassSessionHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *CreateClassSessionHandler) Handle(ctx context.Context, cmd CreateClassSessionCommand) (_ *ClassSessionResult, err error) {
+	ctx, span := tracer.Start(ctx, "CreateClassSessionHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+	startTime, endTime, err := cmd.DTO.Times()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, cmd.DTO.GymID); err != nil {
+		return nil, err
+	}
+
+	classSession, err := session.NewClassSession(cmd.DTO.GymID, cmd.DTO.InstructorID, cmd.DTO.Title, startTime, endTime, cmd.DTO.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	classSession.ID = uuid.New().String()
+
+	if err := handler.repo.Create(ctx, classSession); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "class session created",
+		slog.String("session_id", classSession.ID),
+		slog.String("gym_id", classSession.GymID),
+	)
+
+	return &ClassSessionResult{
+		Session: dtos.FromClassSession(classSession, 0),
+	}, nil
+}
@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/closures"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+// CreateClosureCommand closes a gym for a period, once or every year. Upcoming bookings
+// during the closure refuse it unless they are to be cancelled.
+type CreateClosureCommand struct {
+	GymID string
+	DTO   *dtos.CreateClosureDTO
+}
+
+type CreateClosuresResult struct {
+	Result *dtos.ClosuresResultDTO
+}
+
+type CreateClosureHandler struct {
+	repo      closure.Repository
+	checker   *closures.Checker
+	bookings  booking.Repository
+	publisher booking.EventPublisher
+	logger    *slog.Logger
+}
+
+func NewCreateClosureHandler(
+	repo closure.Repository,
+	checker *closures.Checker,
+	bookings booking.Repository,
+	publisher booking.EventPublisher,
+	logger *slog.Logger,
+) *CreateClosureHandler {
+	return &CreateClosureHandler{
+		repo:      repo,
+		checker:   checker,
+		bookings:  bookings,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+func (handler *CreateClosureHandler) Handle(ctx context.Context, cmd CreateClosureCommand) (_ *CreateClosuresResult, err error) {
+	ctx, span := tracer.Start(ctx, "CreateClosureHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateGymID(cmd.GymID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, cmd.GymID); err != nil {
+		return nil, err
+	}
+
+	closureRecord, err := cmd.DTO.ToDomain(cmd.GymID)
+	if errors.Is(err, closure.ErrInvalidClosure) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+
+	return handler.add(ctx, cmd.GymID, []*closure.Closure{closureRecord}, cmd.DTO.CancelBookings, cmd.DTO.DryRun)
+}
+
+// add stores closures of the gym after cancelling the upcoming bookings they fall on, if
+// cancel is set. The bookings are cancelled first, so that a request failing halfway can be
+// repeated. A dry run only reports the bookings.
+func (handler *CreateClosureHandler) add(ctx context.Context, gymID string, newClosures []*closure.Closure, cancel, dryRun bool) (*CreateClosuresResult, error) {
+	location, err := handler.checker.Location(ctx, gymID)
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := handler.checker.Conflicts(ctx, gymID, newClosures, location)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return newClosuresResult(newClosures, conflicts, true), nil
+	}
+	if len(conflicts) > 0 && !cancel {
+		return nil, fmt.Errorf("%w: %d upcoming bookings fall within the closure", closure.ErrClosureHasBookings, len(conflicts))
+	}
+
+	cancelled := make(map[*closure.Closure]int, len(newClosures))
+	for _, conflict := range conflicts {
+		if err := conflict.Booking.CancelWithReason(closures.CancelReasonFor(conflict.Closure)); err != nil {
+			return nil, err
+		}
+		if err := handler.bookings.Update(ctx, conflict.Booking); err != nil {
+			return nil, err
+		}
+		cancelled[conflict.Closure]++
+		handler.publish(ctx, booking.NewBookingEvent(conflict.Booking, "cancelled"), conflict.Booking.ID)
+	}
+
+	for _, closureRecord := range newClosures {
+		closureRecord.ID = uuid.New().String()
+	}
+	if err := handler.repo.CreateMany(ctx, newClosures); err != nil {
+		return nil, err
+	}
+	for _, closureRecord := range newClosures {
+		handler.publish(ctx, closure.NewClosureCreatedEvent(closureRecord, cancelled[closureRecord]), "")
+	}
+
+	handler.logger.InfoContext(ctx, "gym closures created",
+		slog.String("gym_id", gymID),
+		slog.Int("closures", len(newClosures)),
+		slog.Int("cancelled_bookings", len(conflicts)),
+	)
+
+	return newClosuresResult(newClosures, conflicts, false), nil
+}
+
+// publish logs instead of failing: the closures and cancellations are stored already.
+func (handler *CreateClosureHandler) publish(ctx context.Context, event booking.Event, bookingID string) {
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		handler.logger.ErrorContext(ctx, "failed to publish event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", bookingID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+func newClosuresResult(newClosures []*closure.Closure, conflicts []closures.Conflict, dryRun bool) *CreateClosuresResult {
+	result := &dtos.ClosuresResultDTO{
+		Closures:         make([]*dtos.ClosureDTO, 0, len(newClosures)),
+		AffectedBookings: make([]*dtos.BookingDTO, 0, len(conflicts)),
+		DryRun:           dryRun,
+	}
+	for _, closureRecord := range newClosures {
+		result.Closures = append(result.Closures, dtos.FromClosure(closureRecord))
+	}
+	for _, conflict := range conflicts {
+		result.AffectedBookings = append(result.AffectedBookings, dtos.FromDomain(conflict.Booking))
+	}
+	return &CreateClosuresResult{Result: result}
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+// CreateResourceCommand adds a bookable resource, such as a court or squat rack, to a gym.
+// It starts out in service.
+type CreateResourceCommand struct {
+	GymID string
+	DTO   *dtos.SetResourceDTO
+}
+
+type ResourceResult struct {
+	Resource *dtos.ResourceDTO
+}
+
+type CreateResourceHandler struct {
+	repo   resource.Repository
+	logger *slog.Logger
+}
+
+func NewCreateResourceHandler(repo resource.Repository, logger *slog.Logger) *CreateResourceHandler {
+	return &CreateResourceHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *CreateResourceHandler) Handle(ctx context.Context, cmd CreateResourceCommand) (_ *ResourceResult, err error) {
+	ctx, span := tracer.Start(ctx, "CreateResourceHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateGymID(cmd.GymID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, cmd.GymID); err != nil {
+		return nil, err
+	}
+
+	resourceRecord := cmd.DTO.ToDomain(cmd.GymID)
+	if err := resourceRecord.Validate(); err != nil {
+		return nil, err
+	}
+	resourceRecord.ID = uuid.New().String()
+	resourceRecord.CreatedAt = time.Now()
+	resourceRecord.UpdatedAt = resourceRecord.CreatedAt
+
+	if err := handler.repo.Create(ctx, resourceRecord); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "resource created",
+		slog.String("resource_id", resourceRecord.ID),
+		slog.String("gym_id", resourceRecord.GymID),
+	)
+
+	return &ResourceResult{
+		Resource: dtos.FromResource(resourceRecord),
+	}, nil
+}
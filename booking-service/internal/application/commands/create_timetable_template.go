@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/timetables"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+// CreateTimetableTemplateCommand adds a weekly class to a gym's timetable.
+type CreateTimetableTemplateCommand struct {
+	GymID string
+	DTO   *dtos.SetTimetableTemplateDTO
+}
+
+type TimetableTemplateResult struct {
+	Template *dtos.TimetableTemplateDTO
+}
+
+type CreateTimetableTemplateHandler struct {
+	repo      timetable.Repository
+	generator *timetables.Generator
+	logger    *slog.Logger
+}
+
+func NewCreateTimetableTemplateHandler(repo timetable.Repository, generator *timetables.Generator, logger *slog.Logger) *CreateTimetableTemplateHandler {
+	return &CreateTimetableTemplateHandler{
+		repo:      repo,
+		generator: generator,
+		logger:    logger,
+	}
+}
+
+func (handler *CreateTimetableTemplateHandler) Handle(ctx context.Context, cmd CreateTimetableTemplateCommand) (_ *TimetableTemplateResult, err error) {
+	ctx, span := tracer.Start(ctx, "CreateTimetableTemplateHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateGymID(cmd.GymID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, cmd.GymID); err != nil {
+		return nil, err
+	}
+
+	template, err := cmd.DTO.ToDomain(cmd.GymID)
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	template.ID = uuid.New().String()
+	template.CreatedAt = time.Now()
+	template.UpdatedAt = template.CreatedAt
+
+	if err := handler.repo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "timetable template created",
+		slog.String("template_id", template.ID),
+		slog.String("gym_id", template.GymID),
+	)
+	generateSessions(ctx, handler.generator, template, handler.logger)
+
+	return &TimetableTemplateResult{
+		Template: dtos.FromTimetableTemplate(template),
+	}, nil
+}
+
+// generateSessions brings a saved template's sessions in line with it right away instead
+// of at the generator's next run, which retries if this fails.
+func generateSessions(ctx context.Context, generator *timetables.Generator, template *timetable.Template, logger *slog.Logger) {
+	if _, err := generator.Generate(ctx, template, time.Now()); err != nil {
+		logger.ErrorContext(ctx, "failed to generate timetable sessions",
+			slog.String("template_id", template.ID),
+			slog.Any("error", err),
+		)
+	}
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+// CreateTrainerCommand adds a personal trainer members can book appointments with.
+type CreateTrainerCommand struct {
+	DTO *dtos.SetTrainerDTO
+}
+
+type TrainerResult struct {
+	Trainer *dtos.TrainerDTO
+}
+
+type CreateTrainerHandler struct {
+	repo   trainer.Repository
+	logger *slog.Logger
+}
+
+func NewCreateTrainerHandler(repo trainer.Repository, logger *slog.Logger) *CreateTrainerHandler {
+	return &CreateTrainerHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *CreateTrainerHandler) Handle(ctx context.Context, cmd CreateTrainerCommand) (_ *TrainerResult, err error) {
+	ctx, span := tracer.Start(ctx, "CreateTrainerHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageTrainers(ctx); err != nil {
+		return nil, err
+	}
+
+	trainerRecord, err := cmd.DTO.ToDomain()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := trainerRecord.Validate(); err != nil {
+		return nil, err
+	}
+	trainerRecord.ID = uuid.New().String()
+	trainerRecord.CreatedAt = time.Now()
+	trainerRecord.UpdatedAt = trainerRecord.CreatedAt
+
+	if err := handler.repo.Create(ctx, trainerRecord); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "trainer created", slog.String("trainer_id", trainerRecord.ID))
+
+	return &TrainerResult{
+		Trainer: dtos.FromTrainer(trainerRecord),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+// DeleteClosureCommand reopens a gym during a closure. Bookings cancelled for the closure
+// stay cancelled.
+type DeleteClosureCommand struct {
+	ClosureID string
+}
+
+type DeleteClosureHandler struct {
+	repo   closure.Repository
+	logger *slog.Logger
+}
+
+func NewDeleteClosureHandler(repo closure.Repository, logger *slog.Logger) *DeleteClosureHandler {
+	return &DeleteClosureHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *DeleteClosureHandler) Handle(ctx context.Context, cmd DeleteClosureCommand) (err error) {
+	ctx, span := tracer.Start(ctx, "DeleteClosureHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateClosureID(cmd.ClosureID); err != nil {
+		return err
+	}
+
+	closureRecord, err := handler.repo.GetByID(ctx, cmd.ClosureID)
+	if err != nil {
+		return err
+	}
+	if err := auth.AuthorizeManageGym(ctx, closureRecord.GymID); err != nil {
+		return err
+	}
+	if err := handler.repo.Delete(ctx, closureRecord.ID); err != nil {
+		return err
+	}
+
+	handler.logger.InfoContext(ctx, "gym closure deleted",
+		slog.String("gym_id", closureRecord.GymID),
+		slog.String("closure_id", closureRecord.ID),
+	)
+	return nil
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+// DeleteTrainerTimeOffCommand makes a trainer's time off bookable again.
+type DeleteTrainerTimeOffCommand struct {
+	TrainerID string
+	TimeOffID string
+}
+
+type DeleteTrainerTimeOffHandler struct {
+	repo   trainer.Repository
+	logger *slog.Logger
+}
+
+func NewDeleteTrainerTimeOffHandler(repo trainer.Repository, logger *slog.Logger) *DeleteTrainerTimeOffHandler {
+	return &DeleteTrainerTimeOffHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *DeleteTrainerTimeOffHandler) Handle(ctx context.Context, cmd DeleteTrainerTimeOffCommand) (err error) {
+	ctx, span := tracer.Start(ctx, "DeleteTrainerTimeOffHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateTrainerID(cmd.TrainerID); err != nil {
+		return err
+	}
+	if err := validator.ValidateRequiredString(cmd.TimeOffID, "time_off_id"); err != nil {
+		return err
+	}
+
+	if err := auth.AuthorizeManageTrainers(ctx); err != nil {
+		return err
+	}
+
+	if err := handler.repo.DeleteTimeOff(ctx, cmd.TrainerID, cmd.TimeOffID); err != nil {
+		return err
+	}
+
+	handler.logger.InfoContext(ctx, "trainer time off deleted",
+		slog.String("trainer_id", cmd.TrainerID),
+		slog.String("time_off_id", cmd.TimeOffID),
+	)
+	return nil
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/timetables"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+// EndTimetableTemplateCommand takes a class off the timetable from today on. Its upcoming
+// sessions are cancelled, except for those members are enrolled in. The template is kept,
+// so the sessions already generated from it still reference it.
+type EndTimetableTemplateCommand struct {
+	TemplateID string
+}
+
+type EndTimetableTemplateHandler struct {
+	repo      timetable.Repository
+	generator *timetables.Generator
+	logger    *slog.Logger
+}
+
+func NewEndTimetableTemplateHandler(repo timetable.Repository, generator *timetables.Generator, logger *slog.Logger) *EndTimetableTemplateHandler {
+	return &EndTimetableTemplateHandler{
+		repo:      repo,
+		generator: generator,
+		logger:    logger,
+	}
+}
+
+func (handler *EndTimetableTemplateHandler) Handle(ctx context.Context, cmd EndTimetableTemplateCommand) (_ *TimetableTemplateResult, err error) {
+	ctx, span := tracer.Start(ctx, "EndTimetableTemplateHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateTemplateID(cmd.TemplateID); err != nil {
+		return nil, err
+	}
+
+	template, err := handler.repo.GetByID(ctx, cmd.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, template.GymID); err != nil {
+		return nil, err
+	}
+
+	today, err := handler.generator.Today(ctx, template.GymID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	template.End(today.AddDate(0, 0, -1))
+
+	if err := handler.repo.Update(ctx, template); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "timetable template ended", slog.String("template_id", template.ID))
+	generateSessions(ctx, handler.generator, template, handler.logger)
+
+	return &TimetableTemplateResult{
+		Template: dtos.FromTimetableTemplate(template),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+// EnrollCommand enrolls a member in a class session by booking them into it.
+type EnrollCommand struct {
+	SessionID string
+	DTO       *dtos.EnrollDTO
+}
+
+// EnrollHandler books members into class sessions through a CreateBookingHandler, so that
+// enrollments go through the same checks as every other booking.
+type EnrollHandler struct {
+	sessions session.Repository
+	create   *CreateBookingHandler
+}
+
+func NewEnrollHandler(sessions session.Repository, create *CreateBookingHandler) *EnrollHandler {
+	return &EnrollHandler{
+		sessions: sessions,
+		create:   create,
+	}
+}
+
+func (handler *EnrollHandler) Handle(ctx context.Context, cmd EnrollCommand) (_ *CreateBookingResult, err error) {
+	ctx, span := tracer.Start(ctx, "EnrollHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateSessionID(cmd.SessionID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	classSession, err := handler.sessions.GetByID(ctx, cmd.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.create.Handle(ctx, CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+		UserID:    cmd.DTO.UserID,
+		GymID:     classSession.GymID,
+		StartTime: classSession.StartTime.Format(time.RFC3339),
+		EndTime:   classSession.EndTime.Format(time.RFC3339),
+		SessionID: classSession.ID,
+	}})
+}
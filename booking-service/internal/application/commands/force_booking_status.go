@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// ForceBookingStatusCommand moves a booking to Status regardless of the usual transitions,
+// to repair bookings by hand. Reason is required and kept in the booking's history.
+type ForceBookingStatusCommand struct {
+	BookingID string                `json:"booking_id" validate:"required"`
+	Status    booking.BookingStatus `json:"status" validate:"required,oneof=PENDING CONFIRMED CANCELLED COMPLETED"`
+	Reason    string                `json:"reason" validate:"required,max=500"`
+}
+
+type ForceBookingStatusHandler struct {
+	repo      booking.Repository
+	publisher booking.EventPublisher
+	logger    *slog.Logger
+}
+
+func NewForceBookingStatusHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger) *ForceBookingStatusHandler {
+	return &ForceBookingStatusHandler{
+		repo:      repo,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+func (handler *ForceBookingStatusHandler) Handle(ctx context.Context, cmd ForceBookingStatusCommand) (err error) {
+	ctx, span := tracer.Start(ctx, "ForceBookingStatusHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.Struct(&cmd); err != nil {
+		return err
+	}
+
+	if err := auth.AuthorizeForceStatus(ctx); err != nil {
+		return err
+	}
+
+	bookingRecord, err := handler.repo.GetByID(ctx, cmd.BookingID)
+	if err != nil {
+		return err
+	}
+
+	previousStatus := bookingRecord.Status
+	if err := bookingRecord.ForceStatus(cmd.Status, cmd.Reason); err != nil {
+		return err
+	}
+
+	if err := handler.repo.Update(ctx, bookingRecord); err != nil {
+		return err
+	}
+
+	handler.logger.WarnContext(ctx, "booking status forced",
+		slog.String("booking_id", bookingRecord.ID),
+		slog.String("from", previousStatus.String()),
+		slog.String("to", bookingRecord.Status.String()),
+	)
+
+	event := booking.NewBookingStatusChangedEvent(bookingRecord, previousStatus)
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", bookingRecord.ID),
+			slog.Any("error", err),
+		)
+		return err
+	}
+	return nil
+}
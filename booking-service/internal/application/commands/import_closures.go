@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/closures"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// ImportClosuresCommand closes a gym for each event of an iCalendar file, all at once:
+// either every closure is created or none is.
+type ImportClosuresCommand struct {
+	GymID string
+	DTO   *dtos.ImportClosuresDTO
+}
+
+// ImportClosuresHandler parses the calendar and hands its closures to create.
+type ImportClosuresHandler struct {
+	checker *closures.Checker
+	create  *CreateClosureHandler
+}
+
+func NewImportClosuresHandler(checker *closures.Checker, create *CreateClosureHandler) *ImportClosuresHandler {
+	return &ImportClosuresHandler{
+		checker: checker,
+		create:  create,
+	}
+}
+
+func (handler *ImportClosuresHandler) Handle(ctx context.Context, cmd ImportClosuresCommand) (_ *CreateClosuresResult, err error) {
+	ctx, span := tracer.Start(ctx, "ImportClosuresHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateGymID(cmd.GymID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, cmd.GymID); err != nil {
+		return nil, err
+	}
+
+	location, err := handler.checker.Location(ctx, cmd.GymID)
+	if err != nil {
+		return nil, err
+	}
+	imported, err := closures.ParseCalendar(cmd.DTO.Calendar, cmd.GymID, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.create.add(ctx, cmd.GymID, imported, cmd.DTO.CancelBookings, cmd.DTO.DryRun)
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/strikes"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+)
+
+// MarkNoShowCommand records that the member of a confirmed booking that has started did not
+// turn up.
+type MarkNoShowCommand struct {
+	BookingID string `json:"booking_id" validate:"required"`
+}
+
+type MarkNoShowHandler struct {
+	repo      booking.Repository
+	publisher booking.EventPublisher
+	logger    *slog.Logger
+	strikes   *strikes.Tracker
+}
+
+// NewMarkNoShowHandler records a strike for every no-show through strikes, unless it is nil.
+func NewMarkNoShowHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger, strikes *strikes.Tracker) *MarkNoShowHandler {
+	return &MarkNoShowHandler{
+		repo:      repo,
+		publisher: publisher,
+		logger:    logger,
+		strikes:   strikes,
+	}
+}
+
+func (handler *MarkNoShowHandler) Handle(ctx context.Context, cmd MarkNoShowCommand) (err error) {
+	ctx, span := tracer.Start(ctx, "MarkNoShowHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateBookingID(cmd.BookingID); err != nil {
+		return err
+	}
+
+	bookingRecord, err := handler.repo.GetByID(ctx, cmd.BookingID)
+	if err != nil {
+		return err
+	}
+
+	if err := auth.AuthorizeManage(ctx, bookingRecord); err != nil {
+		return err
+	}
+
+	if err := bookingRecord.MarkNoShow(time.Now()); err != nil {
+		return err
+	}
+
+	if err := handler.repo.Update(ctx, bookingRecord); err != nil {
+		return err
+	}
+
+	if handler.strikes != nil {
+		// The no-show stands even if its strike is lost.
+		if err := handler.strikes.Record(ctx, bookingRecord, strike.ReasonNoShow); err != nil {
+			handler.logger.ErrorContext(ctx, "failed to record strike",
+				slog.String("booking_id", bookingRecord.ID),
+				slog.String("reason", string(strike.ReasonNoShow)),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	event := booking.NewBookingEvent(bookingRecord, "no_show")
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", bookingRecord.ID),
+			slog.Any("error", err),
+		)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+// RescheduleBookingCommand moves a booking that has not started to new times. The moved
+// booking goes through the same checks as a new one, the gym's policy included.
+type RescheduleBookingCommand struct {
+	BookingID string
+	DTO       *dtos.RescheduleBookingDTO
+}
+
+type RescheduleBookingResult struct {
+	Booking *dtos.BookingDTO
+}
+
+type RescheduleBookingHandler struct {
+	repo      booking.Repository
+	publisher booking.EventPublisher
+	logger    *slog.Logger
+	checks    []BookingCheck
+}
+
+// NewRescheduleBookingHandler returns a handler that runs checks, in order, on every moved
+// booking that does not overlap another one. Checks see the moved booking with its stored
+// ID, so that they leave the stored booking it replaces out.
+func NewRescheduleBookingHandler(repo booking.Repository, publisher booking.EventPublisher, logger *slog.Logger, checks ...BookingCheck) *RescheduleBookingHandler {
+	return &RescheduleBookingHandler{
+		repo:      repo,
+		publisher: publisher,
+		logger:    logger,
+		checks:    checks,
+	}
+}
+
+func (handler *RescheduleBookingHandler) Handle(ctx context.Context, cmd RescheduleBookingCommand) (_ *RescheduleBookingResult, err error) {
+	ctx, span := tracer.Start(ctx, "RescheduleBookingHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateBookingID(cmd.BookingID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+	startTime, endTime, err := cmd.DTO.Times()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+
+	stored, err := handler.repo.GetByID(ctx, cmd.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := auth.AuthorizeCancel(ctx, stored); err != nil {
+		return nil, err
+	}
+	if stored.SessionID != "" {
+		return nil, fmt.Errorf("%w: enrollments move with their class session", session.ErrSessionMismatch)
+	}
+
+	moved := *stored
+	if err := moved.Reschedule(startTime, endTime, time.Now()); err != nil {
+		return nil, err
+	}
+
+	rejection, err := vetBooking(ctx, handler.repo, handler.checks, &moved, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rejection != nil {
+		return nil, rejection
+	}
+
+	if err := handler.repo.Update(ctx, &moved); err != nil {
+		return nil, err
+	}
+
+	event := booking.NewBookingRescheduledEvent(&moved, stored.StartTime, stored.EndTime)
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		// The booking is moved already; only the notification is lost.
+		handler.logger.ErrorContext(ctx, "failed to publish booking event",
+			slog.String("event", event.EventName()),
+			slog.String("booking_id", moved.ID),
+			slog.Any("error", err),
+		)
+	}
+
+	handler.logger.InfoContext(ctx, "booking rescheduled",
+		slog.String("booking_id", moved.ID),
+		slog.Time("start_time", moved.StartTime),
+		slog.Time("end_time", moved.EndTime),
+	)
+
+	return &RescheduleBookingResult{
+		Booking: dtos.FromDomain(&moved),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+// ReturnResourceToServiceCommand makes an out-of-service resource bookable again and
+// clears the flag from its upcoming bookings. Returning a resource in service is a no-op.
+type ReturnResourceToServiceCommand struct {
+	ResourceID string
+}
+
+type ReturnResourceToServiceHandler struct {
+	resources resource.Repository
+	bookings  booking.Repository
+	logger    *slog.Logger
+}
+
+func NewReturnResourceToServiceHandler(resources resource.Repository, bookings booking.Repository, logger *slog.Logger) *ReturnResourceToServiceHandler {
+	return &ReturnResourceToServiceHandler{
+		resources: resources,
+		bookings:  bookings,
+		logger:    logger,
+	}
+}
+
+func (handler *ReturnResourceToServiceHandler) Handle(ctx context.Context, cmd ReturnResourceToServiceCommand) (_ *ResourceResult, err error) {
+	ctx, span := tracer.Start(ctx, "ReturnResourceToServiceHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateResourceID(cmd.ResourceID); err != nil {
+		return nil, err
+	}
+
+	resourceRecord, err := handler.resources.GetByID(ctx, cmd.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, resourceRecord.GymID); err != nil {
+		return nil, err
+	}
+	if !resourceRecord.ReturnToService() {
+		return &ResourceResult{
+			Resource: dtos.FromResource(resourceRecord),
+		}, nil
+	}
+
+	upcoming, err := handler.bookings.ListActiveByResourceID(ctx, resourceRecord.ID, time.Now(), time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	unflagged := 0
+	for _, bookingRecord := range upcoming {
+		if !bookingRecord.Unflag(resource.OutOfServiceFlag) {
+			continue
+		}
+		if err := handler.bookings.Update(ctx, bookingRecord); err != nil {
+			return nil, err
+		}
+		unflagged++
+	}
+
+	if err := handler.resources.Update(ctx, resourceRecord); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "resource returned to service",
+		slog.String("resource_id", resourceRecord.ID),
+		slog.Int("unflagged_bookings", unflagged),
+	)
+
+	return &ResourceResult{
+		Resource: dtos.FromResource(resourceRecord),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+)
+
+// SetGymPolicyCommand replaces a gym's booking rules. Existing bookings are not affected.
+type SetGymPolicyCommand struct {
+	GymID string
+	DTO   *dtos.SetGymPolicyDTO
+}
+
+type SetGymPolicyResult struct {
+	Policy *dtos.GymPolicyDTO
+}
+
+type SetGymPolicyHandler struct {
+	repo   policy.Repository
+	logger *slog.Logger
+}
+
+func NewSetGymPolicyHandler(repo policy.Repository, logger *slog.Logger) *SetGymPolicyHandler {
+	return &SetGymPolicyHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *SetGymPolicyHandler) Handle(ctx context.Context, cmd SetGymPolicyCommand) (_ *SetGymPolicyResult, err error) {
+	ctx, span := tracer.Start(ctx, "SetGymPolicyHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateGymID(cmd.GymID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, cmd.GymID); err != nil {
+		return nil, err
+	}
+
+	gymPolicy := cmd.DTO.ToDomain(cmd.GymID)
+	if err := gymPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	gymPolicy.UpdatedAt = time.Now()
+
+	if err := handler.repo.Save(ctx, gymPolicy); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "gym booking policy updated", slog.String("gym_id", cmd.GymID))
+
+	return &SetGymPolicyResult{
+		Policy: dtos.FromPolicy(gymPolicy),
+	}, nil
+}
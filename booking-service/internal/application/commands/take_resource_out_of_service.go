@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+// TakeResourceOutOfServiceCommand stops a resource from being booked and flags its
+// upcoming bookings, which keep their status, for staff to move or cancel.
+type TakeResourceOutOfServiceCommand struct {
+	ResourceID string
+	DTO        *dtos.OutOfServiceDTO
+}
+
+type TakeResourceOutOfServiceResult struct {
+	Result *dtos.OutOfServiceResultDTO
+}
+
+type TakeResourceOutOfServiceHandler struct {
+	resources resource.Repository
+	bookings  booking.Repository
+	publisher booking.EventPublisher
+	logger    *slog.Logger
+}
+
+func NewTakeResourceOutOfServiceHandler(
+	resources resource.Repository,
+	bookings booking.Repository,
+	publisher booking.EventPublisher,
+	logger *slog.Logger,
+) *TakeResourceOutOfServiceHandler {
+	return &TakeResourceOutOfServiceHandler{
+		resources: resources,
+		bookings:  bookings,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Handle flags the bookings before taking the resource out of service, so that a request
+// failing halfway can be repeated.
+func (handler *TakeResourceOutOfServiceHandler) Handle(ctx context.Context, cmd TakeResourceOutOfServiceCommand) (_ *TakeResourceOutOfServiceResult, err error) {
+	ctx, span := tracer.Start(ctx, "TakeResourceOutOfServiceHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateResourceID(cmd.ResourceID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	resourceRecord, err := handler.resources.GetByID(ctx, cmd.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, resourceRecord.GymID); err != nil {
+		return nil, err
+	}
+	if !resourceRecord.Active {
+		return nil, resource.ErrResourceOutOfService
+	}
+
+	upcoming, err := handler.bookings.ListActiveByResourceID(ctx, resourceRecord.ID, time.Now(), time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	for _, bookingRecord := range upcoming {
+		if bookingRecord.FlagReason == resource.OutOfServiceFlag {
+			continue
+		}
+		bookingRecord.Flag(resource.OutOfServiceFlag)
+		if err := handler.bookings.Update(ctx, bookingRecord); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := resourceRecord.TakeOutOfService(cmd.DTO.Reason); err != nil {
+		return nil, err
+	}
+	if err := handler.resources.Update(ctx, resourceRecord); err != nil {
+		return nil, err
+	}
+
+	event := resource.NewOutOfServiceEvent(resourceRecord, len(upcoming))
+	if err := handler.publisher.Publish(ctx, event); err != nil {
+		// The resource is out of service already; only the notification is lost.
+		handler.logger.ErrorContext(ctx, "failed to publish resource event",
+			slog.String("event", event.EventName()),
+			slog.String("resource_id", resourceRecord.ID),
+			slog.Any("error", err),
+		)
+	}
+
+	handler.logger.InfoContext(ctx, "resource taken out of service",
+		slog.String("resource_id", resourceRecord.ID),
+		slog.Int("flagged_bookings", len(upcoming)),
+	)
+
+	return &TakeResourceOutOfServiceResult{
+		Result: &dtos.OutOfServiceResultDTO{
+			Resource:        dtos.FromResource(resourceRecord),
+			FlaggedBookings: len(upcoming),
+		},
+	}, nil
+}
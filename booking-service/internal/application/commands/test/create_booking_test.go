@@ -15,7 +15,7 @@ import (
 func TestCreateBookingHandler(t *testing.T) {
 	repo := mocks.NewMockRepository()
 	publisher := mocks.NewMockEventPublisher()
-	handler := commands.NewCreateBookingHandler(repo, publisher)
+	handler := commands.NewCreateBookingHandler(repo, publisher, mocks.NewLogger())
 
 	now := time.Now().Truncate(time.Second) // Truncate to seconds for consistent comparison
 	validBookingRequest := &dtos.CreateBookingDTO{
@@ -108,4 +108,25 @@ func TestCreateBookingHandler(t *testing.T) {
 		assert.Nil(t, result)
 		assert.ErrorIs(t, err, booking.ErrOverlappingBooking)
 	})
+	t.Run("cancelled booking", func(t *testing.T) {
+		repo.Clear()
+		publisher.Clear()
+
+		result, err := handler.Handle(context.Background(), commands.CreateBookingCommand{DTO: validBookingRequest})
+		assert.NoError(t, err)
+		cancelled, err := repo.GetByID(context.Background(), result.Booking.ID)
+		assert.NoError(t, err)
+		assert.NoError(t, cancelled.Cancel())
+
+		result, err = handler.Handle(context.Background(), commands.CreateBookingCommand{
+			DTO: &dtos.CreateBookingDTO{
+				UserID:    "user2",
+				GymID:     "gym1",
+				StartTime: validBookingRequest.StartTime,
+				EndTime:   validBookingRequest.EndTime,
+			},
+		})
+		assert.NoError(t, err, "cancelled bookings do not hold their slot")
+		assert.NotNil(t, result)
+	})
 }
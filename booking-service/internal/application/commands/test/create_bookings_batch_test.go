@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+)
+
+func TestCreateBookingsBatchHandler(t *testing.T) {
+	repo := mocks.NewMockRepository()
+	publisher := mocks.NewMockEventPublisher()
+	handler := commands.NewCreateBookingsBatchHandler(repo, publisher, mocks.NewLogger())
+
+	now := time.Now().Truncate(time.Second)
+	slot := func(userID string, startOffset, endOffset time.Duration) *dtos.CreateBookingDTO {
+		return &dtos.CreateBookingDTO{
+			UserID:    userID,
+			GymID:     "gym1",
+			StartTime: now.Add(startOffset).Format(time.RFC3339),
+			EndTime:   now.Add(endOffset).Format(time.RFC3339),
+		}
+	}
+
+	t.Run("creates every booking", func(t *testing.T) {
+		repo.Clear()
+		publisher.Clear()
+
+		result, err := handler.Handle(context.Background(), commands.CreateBookingsBatchCommand{
+			DTO: &dtos.BatchCreateBookingsDTO{Bookings: []*dtos.CreateBookingDTO{
+				slot("user1", time.Hour, 2*time.Hour),
+				slot("user2", 2*time.Hour, 3*time.Hour),
+			}},
+		})
+		require.NoError(t, err)
+		assert.Len(t, result.Bookings, 2)
+		assert.Len(t, publisher.GetEvents(), 2)
+	})
+
+	t.Run("rejects the whole batch and reports every invalid item", func(t *testing.T) {
+		repo.Clear()
+		publisher.Clear()
+
+		existing, err := booking.NewBooking("user9", "gym1", now.Add(5*time.Hour), now.Add(6*time.Hour))
+		require.NoError(t, err)
+		existing.ID = "existing"
+		repo.AddBooking(existing)
+
+		result, err := handler.Handle(context.Background(), commands.CreateBookingsBatchCommand{
+			DTO: &dtos.BatchCreateBookingsDTO{Bookings: []*dtos.CreateBookingDTO{
+				slot("user1", time.Hour, 2*time.Hour),
+				slot("user2", 90*time.Minute, 3*time.Hour),
+				slot("user3", 5*time.Hour, 6*time.Hour),
+				slot("user4", -time.Hour, time.Hour),
+				slot("", 7*time.Hour, 8*time.Hour),
+			}},
+		})
+		assert.Nil(t, result)
+
+		var rejected *commands.BatchRejectedError
+		require.ErrorAs(t, err, &rejected)
+		require.Len(t, rejected.Items, 4)
+		assert.Equal(t, 1, rejected.Items[0].Index)
+		assert.ErrorIs(t, rejected.Items[0].Err, booking.ErrOverlappingBooking)
+		assert.Equal(t, 2, rejected.Items[1].Index)
+		assert.ErrorIs(t, rejected.Items[1].Err, booking.ErrOverlappingBooking)
+		assert.Equal(t, 3, rejected.Items[2].Index)
+		assert.ErrorIs(t, rejected.Items[2].Err, booking.ErrPastBooking)
+		assert.Equal(t, 4, rejected.Items[3].Index)
+		assert.ErrorIs(t, rejected.Items[3].Err, booking.ErrInvalidInput)
+
+		stored, err := repo.ListByGymID(context.Background(), "gym1", now, now.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Len(t, stored, 1)
+		assert.Empty(t, publisher.GetEvents())
+	})
+
+	t.Run("checks partly overlapping and ignores cancelled bookings", func(t *testing.T) {
+		repo.Clear()
+		publisher.Clear()
+
+		straddling, err := booking.NewBooking("user8", "gym1", now.Add(90*time.Minute), now.Add(150*time.Minute))
+		require.NoError(t, err)
+		straddling.ID = "straddling"
+		repo.AddBooking(straddling)
+		cancelled, err := booking.NewBooking("user9", "gym1", now.Add(4*time.Hour), now.Add(5*time.Hour))
+		require.NoError(t, err)
+		cancelled.ID = "cancelled"
+		require.NoError(t, cancelled.Cancel())
+		repo.AddBooking(cancelled)
+
+		_, err = handler.Handle(context.Background(), commands.CreateBookingsBatchCommand{
+			DTO: &dtos.BatchCreateBookingsDTO{Bookings: []*dtos.CreateBookingDTO{
+				slot("user1", 2*time.Hour, 3*time.Hour),
+			}},
+		})
+		var rejected *commands.BatchRejectedError
+		require.ErrorAs(t, err, &rejected)
+		assert.ErrorIs(t, rejected.Items[0].Err, booking.ErrOverlappingBooking)
+
+		result, err := handler.Handle(context.Background(), commands.CreateBookingsBatchCommand{
+			DTO: &dtos.BatchCreateBookingsDTO{Bookings: []*dtos.CreateBookingDTO{
+				slot("user1", 4*time.Hour, 5*time.Hour),
+			}},
+		})
+		require.NoError(t, err)
+		assert.Len(t, result.Bookings, 1)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		result, err := handler.Handle(context.Background(), commands.CreateBookingsBatchCommand{
+			DTO: &dtos.BatchCreateBookingsDTO{},
+		})
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, booking.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+)
+
+func TestForceBookingStatusHandler(t *testing.T) {
+	repo := mocks.NewMockRepository()
+	publisher := mocks.NewMockEventPublisher()
+	handler := commands.NewForceBookingStatusHandler(repo, publisher, mocks.NewLogger())
+
+	now := time.Now()
+	testBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	testBooking.ID = "booking-1"
+	require.NoError(t, repo.Create(context.Background(), testBooking))
+
+	t.Run("staff may not force a status", func(t *testing.T) {
+		staff := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "staff1", Role: auth.RoleStaff, GymIDs: []string{"gym1"}})
+		err := handler.Handle(staff, commands.ForceBookingStatusCommand{
+			BookingID: "booking-1",
+			Status:    booking.StatusCompleted,
+			Reason:    "attended",
+		})
+		assert.ErrorIs(t, err, auth.ErrForbidden)
+	})
+
+	t.Run("reason and a known status are required", func(t *testing.T) {
+		err := handler.Handle(context.Background(), commands.ForceBookingStatusCommand{
+			BookingID: "booking-1",
+			Status:    "ARCHIVED",
+		})
+		var validationErr *validator.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Len(t, validationErr.Fields, 2)
+	})
+
+	t.Run("admin forces a status", func(t *testing.T) {
+		publisher.Clear()
+		admin := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "admin1", Role: auth.RoleAdmin})
+		err := handler.Handle(admin, commands.ForceBookingStatusCommand{
+			BookingID: "booking-1",
+			Status:    booking.StatusCompleted,
+			Reason:    "attended, confirmation was lost",
+		})
+		require.NoError(t, err)
+
+		stored, err := repo.GetByID(context.Background(), "booking-1")
+		require.NoError(t, err)
+		assert.Equal(t, booking.StatusCompleted, stored.Status)
+
+		event, ok := publisher.GetLastEvent().(booking.BookingStatusChangedEvent)
+		require.True(t, ok)
+		assert.Equal(t, booking.StatusPending, event.PreviousStatus)
+		assert.Equal(t, booking.StatusCompleted, event.Status)
+		assert.Equal(t, "attended, confirmation was lost", event.Reason)
+
+		history, err := repo.ListStatusChanges(context.Background(), "booking-1")
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, booking.StatusPending, history[1].FromStatus)
+		assert.Equal(t, booking.StatusCompleted, history[1].ToStatus)
+		assert.Equal(t, "attended, confirmation was lost", history[1].Reason)
+	})
+}
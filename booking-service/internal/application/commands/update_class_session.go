@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+type UpdateClassSessionCommand struct {
+	SessionID string
+	DTO       *dtos.UpdateClassSessionDTO
+}
+
+type UpdateClassSessionHandler struct {
+	repo        session.Repository
+	enrollments *sessions.EnrollmentCheck
+	logger      *slog.Logger
+}
+
+func NewUpdateClassSessionHandler(repo session.Repository, enrollments *sessions.EnrollmentCheck, logger *slog.Logger) *UpdateClassSessionHandler {
+	return &UpdateClassSessionHandler{
+		repo:        repo,
+		enrollments: enrollments,
+		logger:      logger,
+	}
+}
+
+func (handler *UpdateClassSessionHandler) Handle(ctx context.Context, cmd UpdateClassSessionCommand) (_ *ClassSessionResult, err error) {
+	ctx, span := tracer.Start(ctx, "UpdateClassSessionHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateSessionID(cmd.SessionID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+	startTime, endTime, err := cmd.DTO.Times()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+
+	classSession, err := handler.repo.GetByID(ctx, cmd.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, classSession.GymID); err != nil {
+		return nil, err
+	}
+
+	enrolled, err := handler.enrollments.Enrolled(ctx, classSession.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := classSession.Reschedule(cmd.DTO.InstructorID, cmd.DTO.Title, startTime, endTime, cmd.DTO.Capacity, len(enrolled)); err != nil {
+		return nil, err
+	}
+
+	if err := handler.repo.Update(ctx, classSession); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "class session updated", slog.String("session_id", classSession.ID))
+
+	return &ClassSessionResult{
+		Session: dtos.FromClassSession(classSession, len(enrolled)),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+// UpdateResourceCommand replaces a resource's name, type, capacity and buffers. Bookings
+// already made are kept, even where a lowered capacity or longer buffers no longer fit them
+// all.
+type UpdateResourceCommand struct {
+	ResourceID string
+	DTO        *dtos.SetResourceDTO
+}
+
+type UpdateResourceHandler struct {
+	repo   resource.Repository
+	logger *slog.Logger
+}
+
+func NewUpdateResourceHandler(repo resource.Repository, logger *slog.Logger) *UpdateResourceHandler {
+	return &UpdateResourceHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *UpdateResourceHandler) Handle(ctx context.Context, cmd UpdateResourceCommand) (_ *ResourceResult, err error) {
+	ctx, span := tracer.Start(ctx, "UpdateResourceHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateResourceID(cmd.ResourceID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	resourceRecord, err := handler.repo.GetByID(ctx, cmd.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, resourceRecord.GymID); err != nil {
+		return nil, err
+	}
+
+	updated := cmd.DTO.ToDomain(resourceRecord.GymID)
+	resourceRecord.Name = updated.Name
+	resourceRecord.Type = updated.Type
+	resourceRecord.Capacity = updated.Capacity
+	resourceRecord.BufferBefore = updated.BufferBefore
+	resourceRecord.BufferAfter = updated.BufferAfter
+	if err := resourceRecord.Validate(); err != nil {
+		return nil, err
+	}
+	resourceRecord.UpdatedAt = time.Now()
+
+	if err := handler.repo.Update(ctx, resourceRecord); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "resource updated", slog.String("resource_id", resourceRecord.ID))
+
+	return &ResourceResult{
+		Resource: dtos.FromResource(resourceRecord),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/timetables"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+// UpdateTimetableTemplateCommand replaces a template's details. The sessions generated
+// from it follow, except for those members are enrolled in, which keep their times.
+type UpdateTimetableTemplateCommand struct {
+	TemplateID string
+	DTO        *dtos.SetTimetableTemplateDTO
+}
+
+type UpdateTimetableTemplateHandler struct {
+	repo      timetable.Repository
+	generator *timetables.Generator
+	logger    *slog.Logger
+}
+
+func NewUpdateTimetableTemplateHandler(repo timetable.Repository, generator *timetables.Generator, logger *slog.Logger) *UpdateTimetableTemplateHandler {
+	return &UpdateTimetableTemplateHandler{
+		repo:      repo,
+		generator: generator,
+		logger:    logger,
+	}
+}
+
+func (handler *UpdateTimetableTemplateHandler) Handle(ctx context.Context, cmd UpdateTimetableTemplateCommand) (_ *TimetableTemplateResult, err error) {
+	ctx, span := tracer.Start(ctx, "UpdateTimetableTemplateHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateTemplateID(cmd.TemplateID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	existing, err := handler.repo.GetByID(ctx, cmd.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageGym(ctx, existing.GymID); err != nil {
+		return nil, err
+	}
+
+	template, err := cmd.DTO.ToDomain(existing.GymID)
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	template.ID = existing.ID
+	template.CreatedAt = existing.CreatedAt
+	template.UpdatedAt = time.Now()
+
+	if err := handler.repo.Update(ctx, template); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "timetable template updated", slog.String("template_id", template.ID))
+	generateSessions(ctx, handler.generator, template, handler.logger)
+
+	return &TimetableTemplateResult{
+		Template: dtos.FromTimetableTemplate(template),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+// UpdateTrainerCommand replaces a trainer's details and weekly availability. Appointments
+// already booked are kept, even where the trainer is no longer available.
+type UpdateTrainerCommand struct {
+	TrainerID string
+	DTO       *dtos.SetTrainerDTO
+}
+
+type UpdateTrainerHandler struct {
+	repo   trainer.Repository
+	logger *slog.Logger
+}
+
+func NewUpdateTrainerHandler(repo trainer.Repository, logger *slog.Logger) *UpdateTrainerHandler {
+	return &UpdateTrainerHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (handler *UpdateTrainerHandler) Handle(ctx context.Context, cmd UpdateTrainerCommand) (_ *TrainerResult, err error) {
+	ctx, span := tracer.Start(ctx, "UpdateTrainerHandler.Handle")
+	defer func() { endSpan(span, err) }()
+
+	if err := validator.ValidateTrainerID(cmd.TrainerID); err != nil {
+		return nil, err
+	}
+	if cmd.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(cmd.DTO); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeManageTrainers(ctx); err != nil {
+		return nil, err
+	}
+
+	existing, err := handler.repo.GetByID(ctx, cmd.TrainerID)
+	if err != nil {
+		return nil, err
+	}
+
+	trainerRecord, err := cmd.DTO.ToDomain()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := trainerRecord.Validate(); err != nil {
+		return nil, err
+	}
+	trainerRecord.ID = existing.ID
+	trainerRecord.CreatedAt = existing.CreatedAt
+	trainerRecord.UpdatedAt = time.Now()
+
+	if err := handler.repo.Update(ctx, trainerRecord); err != nil {
+		return nil, err
+	}
+
+	handler.logger.InfoContext(ctx, "trainer updated", slog.String("trainer_id", trainerRecord.ID))
+
+	return &TrainerResult{
+		Trainer: dtos.FromTrainer(trainerRecord),
+	}, nil
+}
@@ -21,26 +21,80 @@ type BookingDTO struct {
 	StartTime string `json:"start_time"`
 	EndTime   string `json:"end_time"`
 	Status    string `json:"status"`
-	Duration  int    `json:"duration"` // in minutes
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	// StatusReason explains the current status when one was recorded, e.g. for a forced change.
+	StatusReason string `json:"status_reason,omitempty"`
+	// CancelledLate is set when the member cancelled after the gym's cancellation cutoff.
+	CancelledLate bool `json:"cancelled_late,omitempty"`
+	// SessionID is the class session the booking enrolls its member in, if any.
+	SessionID string `json:"session_id,omitempty"`
+	// TrainerID is the personal trainer the booking is a 1:1 appointment with, if any.
+	TrainerID string `json:"trainer_id,omitempty"`
+	// ResourceID is the resource inside the gym the booking holds a place on, if any.
+	ResourceID string `json:"resource_id,omitempty"`
+	// FlagReason is set while the booking needs staff attention, e.g. because its resource
+	// is out of service.
+	FlagReason string `json:"flag_reason,omitempty"`
+	Duration   int    `json:"duration"` // in minutes
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// StatusChangeDTO is one entry of a booking's status history. FromStatus is omitted for the
+// entry recorded when the booking was created.
+type StatusChangeDTO struct {
+	FromStatus string `json:"from_status,omitempty"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason,omitempty"`
+	ChangedAt  string `json:"changed_at"`
+}
+
+// BookingHistoryDTO is a booking together with its status history, oldest change first.
+type BookingHistoryDTO struct {
+	Booking *BookingDTO        `json:"booking"`
+	History []*StatusChangeDTO `json:"history"`
 }
 
 type CreateBookingDTO struct {
-	UserID    string `json:"user_id" validate:"required"`
-	GymID     string `json:"gym_id" validate:"required"`
+	UserID    string `json:"user_id" validate:"required,max=36"`
+	GymID     string `json:"gym_id" validate:"required,max=36"`
 	StartTime string `json:"start_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
 	EndTime   string `json:"end_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	// SessionID enrolls the member in a class session, whose gym and times the booking
+	// must have.
+	SessionID string `json:"session_id,omitempty" validate:"max=36"`
+	// TrainerID books a 1:1 appointment with a personal trainer. It cannot be combined with
+	// SessionID.
+	TrainerID string `json:"trainer_id,omitempty" validate:"max=36"`
+	// ResourceID holds a place on a resource inside the gym, such as a court or squat rack.
+	// The booking then competes only with the other bookings of that resource.
+	ResourceID string `json:"resource_id,omitempty" validate:"max=36"`
+}
+
+type BatchCreateBookingsDTO struct {
+	Bookings []*CreateBookingDTO `json:"bookings" validate:"required,max=100"`
 }
 
 type UpdateBookingDTO struct {
 	Status string `json:"status" validate:"required,oneof=PENDING CONFIRMED CANCELLED COMPLETED"`
 }
 
+// RescheduleBookingDTO moves a booking to new times.
+type RescheduleBookingDTO struct {
+	StartTime string `json:"start_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndTime   string `json:"end_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// ForceStatusDTO moves a booking to any status outside the usual transitions.
+type ForceStatusDTO struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
 type ErrorDTO struct {
-	Code    string   `json:"code"`
-	Message string   `json:"message"`
-	Details []string `json:"details,omitempty"`
+	Code    string                `json:"code"`
+	Message string                `json:"message"`
+	Details []string              `json:"details,omitempty"`
+	Fields  []*ValidationErrorDTO `json:"fields,omitempty"`
 }
 
 type ValidationErrorDTO struct {
@@ -49,6 +103,14 @@ type ValidationErrorDTO struct {
 	Code    string `json:"code"`
 }
 
+// BatchItemErrorDTO reports why the item at Index of a batch request was rejected.
+type BatchItemErrorDTO struct {
+	Index   int                   `json:"index"`
+	Code    string                `json:"code"`
+	Message string                `json:"message"`
+	Fields  []*ValidationErrorDTO `json:"fields,omitempty"`
+}
+
 func (dto *CreateBookingDTO) ToDomain() (string, string, time.Time, time.Time, error) {
 	startTime, err := time.Parse(time.RFC3339, dto.StartTime)
 	if err != nil {
@@ -63,19 +125,38 @@ func (dto *CreateBookingDTO) ToDomain() (string, string, time.Time, time.Time, e
 	return dto.UserID, dto.GymID, startTime, endTime, nil
 }
 
+func (dto *RescheduleBookingDTO) Times() (time.Time, time.Time, error) {
+	return parseTimeRange(dto.StartTime, dto.EndTime)
+}
+
 func FromDomain(booking *booking.Booking) *BookingDTO {
 	duration := int(booking.EndTime.Sub(booking.StartTime).Minutes())
 
 	return &BookingDTO{
-		ID:        booking.ID,
-		UserID:    booking.UserID,
-		GymID:     booking.GymID,
-		StartTime: booking.StartTime.Format(time.RFC3339),
-		EndTime:   booking.EndTime.Format(time.RFC3339),
-		Status:    booking.Status.String(),
-		Duration:  duration,
-		CreatedAt: booking.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: booking.UpdatedAt.Format(time.RFC3339),
+		ID:            booking.ID,
+		UserID:        booking.UserID,
+		GymID:         booking.GymID,
+		StartTime:     booking.StartTime.Format(time.RFC3339),
+		EndTime:       booking.EndTime.Format(time.RFC3339),
+		Status:        booking.Status.String(),
+		StatusReason:  booking.StatusReason,
+		CancelledLate: booking.CancelledLate,
+		SessionID:     booking.SessionID,
+		TrainerID:     booking.TrainerID,
+		ResourceID:    booking.ResourceID,
+		FlagReason:    booking.FlagReason,
+		Duration:      duration,
+		CreatedAt:     booking.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     booking.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func FromStatusChange(change *booking.StatusChange) *StatusChangeDTO {
+	return &StatusChangeDTO{
+		FromStatus: change.FromStatus.String(),
+		ToStatus:   change.ToStatus.String(),
+		Reason:     change.Reason,
+		ChangedAt:  change.ChangedAt.Format(time.RFC3339),
 	}
 }
 
@@ -0,0 +1,69 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+// ClosureDTO is a period a gym is closed. Recurring closures repeat every year at the same
+// local dates and times.
+type ClosureDTO struct {
+	// ID is empty on a dry run, which stores nothing.
+	ID        string `json:"id,omitempty"`
+	GymID     string `json:"gym_id"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Reason    string `json:"reason,omitempty"`
+	Recurring bool   `json:"recurring"`
+	CreatedAt string `json:"created_at"`
+}
+
+// CreateClosureDTO closes a gym. Upcoming bookings during the closure refuse it unless
+// CancelBookings is set, when they are cancelled. DryRun only reports those bookings.
+type CreateClosureDTO struct {
+	StartTime string `json:"start_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndTime   string `json:"end_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Reason    string `json:"reason" validate:"max=500"`
+	Recurring bool   `json:"recurring"`
+
+	CancelBookings bool `json:"cancel_bookings"`
+	DryRun         bool `json:"dry_run"`
+}
+
+// ImportClosuresDTO closes a gym for each event of an iCalendar (.ics) file, such as a
+// public holiday calendar. Bookings are handled as by CreateClosureDTO.
+type ImportClosuresDTO struct {
+	Calendar string `json:"calendar" validate:"required"`
+
+	CancelBookings bool `json:"cancel_bookings"`
+	DryRun         bool `json:"dry_run"`
+}
+
+// ClosuresResultDTO lists the closures created, or that would be on a dry run, and the
+// upcoming bookings they fall on, which were cancelled unless it is a dry run.
+type ClosuresResultDTO struct {
+	Closures         []*ClosureDTO `json:"closures"`
+	AffectedBookings []*BookingDTO `json:"affected_bookings"`
+	DryRun           bool          `json:"dry_run"`
+}
+
+func (dto *CreateClosureDTO) ToDomain(gymID string) (*closure.Closure, error) {
+	startTime, endTime, err := parseTimeRange(dto.StartTime, dto.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	return closure.NewClosure(gymID, startTime, endTime, dto.Reason, dto.Recurring)
+}
+
+func FromClosure(closureRecord *closure.Closure) *ClosureDTO {
+	return &ClosureDTO{
+		ID:        closureRecord.ID,
+		GymID:     closureRecord.GymID,
+		StartTime: closureRecord.StartTime.Format(time.RFC3339),
+		EndTime:   closureRecord.EndTime.Format(time.RFC3339),
+		Reason:    closureRecord.Reason,
+		Recurring: closureRecord.Recurring,
+		CreatedAt: closureRecord.CreatedAt.Format(time.RFC3339),
+	}
+}
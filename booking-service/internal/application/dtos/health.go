@@ -0,0 +1,23 @@
+package dtos
+
+// Health statuses reported by HealthDTO and ComponentHealthDTO.
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+	HealthStatusDown     = "down"
+)
+
+type HealthDTO struct {
+	Status     string                `json:"status"`
+	Components []*ComponentHealthDTO `json:"components,omitempty"`
+}
+
+// ComponentHealthDTO is the result of checking one dependency. Only required components
+// make the service unready when they are down.
+type ComponentHealthDTO struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Required  bool    `json:"required"`
+	LatencyMS float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
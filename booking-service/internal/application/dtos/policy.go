@@ -0,0 +1,111 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+)
+
+// GymPolicyDTO is a gym's booking rules. A zero limit means the rule is off.
+type GymPolicyDTO struct {
+	GymID              string `json:"gym_id"`
+	MinLeadMinutes     int    `json:"min_lead_minutes"`
+	MaxAdvanceMinutes  int    `json:"max_advance_minutes"`
+	MinDurationMinutes int    `json:"min_duration_minutes"`
+	MaxDurationMinutes int    `json:"max_duration_minutes"`
+	SlotMinutes        int    `json:"slot_minutes"`
+	MaxBookingsPerDay  int    `json:"max_bookings_per_day"`
+	TimeZone           string `json:"time_zone"`
+	// Cancelling less than CancellationCutoffMinutes before the start is a late
+	// cancellation. More than MaxStrikes late cancellations and no-shows within
+	// StrikeWindowMinutes suspend the member for SuspensionMinutes.
+	CancellationCutoffMinutes int `json:"cancellation_cutoff_minutes"`
+	MaxStrikes                int `json:"max_strikes"`
+	StrikeWindowMinutes       int `json:"strike_window_minutes"`
+	SuspensionMinutes         int `json:"suspension_minutes"`
+	// BufferBeforeMinutes and BufferAfterMinutes are kept free around every open booking
+	// for changeover. They are not part of the bookings' times.
+	BufferBeforeMinutes int `json:"buffer_before_minutes"`
+	BufferAfterMinutes  int `json:"buffer_after_minutes"`
+	// UpdatedAt is omitted while the gym has no policy of its own.
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// SetGymPolicyDTO replaces a gym's booking rules. Omitted limits are turned off.
+type SetGymPolicyDTO struct {
+	MinLeadMinutes     int    `json:"min_lead_minutes" validate:"min=0"`
+	MaxAdvanceMinutes  int    `json:"max_advance_minutes" validate:"min=0"`
+	MinDurationMinutes int    `json:"min_duration_minutes" validate:"min=0"`
+	MaxDurationMinutes int    `json:"max_duration_minutes" validate:"min=0"`
+	SlotMinutes        int    `json:"slot_minutes" validate:"min=0"`
+	MaxBookingsPerDay  int    `json:"max_bookings_per_day" validate:"min=0"`
+	TimeZone           string `json:"time_zone" validate:"max=64"`
+
+	CancellationCutoffMinutes int `json:"cancellation_cutoff_minutes" validate:"min=0"`
+	MaxStrikes                int `json:"max_strikes" validate:"min=0"`
+	StrikeWindowMinutes       int `json:"strike_window_minutes" validate:"min=0"`
+	SuspensionMinutes         int `json:"suspension_minutes" validate:"min=0"`
+
+	BufferBeforeMinutes int `json:"buffer_before_minutes" validate:"min=0"`
+	BufferAfterMinutes  int `json:"buffer_after_minutes" validate:"min=0"`
+}
+
+// PolicyPreviewDTO lists the rules of the gym's policy a proposed booking would break.
+type PolicyPreviewDTO struct {
+	Allowed    bool                  `json:"allowed"`
+	Violations []*ValidationErrorDTO `json:"violations"`
+}
+
+func (dto *SetGymPolicyDTO) ToDomain(gymID string) *policy.Policy {
+	return &policy.Policy{
+		GymID:              gymID,
+		MinLeadTime:        time.Duration(dto.MinLeadMinutes) * time.Minute,
+		MaxAdvance:         time.Duration(dto.MaxAdvanceMinutes) * time.Minute,
+		MinDuration:        time.Duration(dto.MinDurationMinutes) * time.Minute,
+		MaxDuration:        time.Duration(dto.MaxDurationMinutes) * time.Minute,
+		SlotAlignment:      time.Duration(dto.SlotMinutes) * time.Minute,
+		MaxBookingsPerDay:  dto.MaxBookingsPerDay,
+		TimeZone:           dto.TimeZone,
+		CancellationCutoff: time.Duration(dto.CancellationCutoffMinutes) * time.Minute,
+		MaxStrikes:         dto.MaxStrikes,
+		StrikeWindow:       time.Duration(dto.StrikeWindowMinutes) * time.Minute,
+		SuspensionPeriod:   time.Duration(dto.SuspensionMinutes) * time.Minute,
+		BufferBefore:       time.Duration(dto.BufferBeforeMinutes) * time.Minute,
+		BufferAfter:        time.Duration(dto.BufferAfterMinutes) * time.Minute,
+	}
+}
+
+func FromPolicy(gymPolicy *policy.Policy) *GymPolicyDTO {
+	dto := &GymPolicyDTO{
+		GymID:              gymPolicy.GymID,
+		MinLeadMinutes:     int(gymPolicy.MinLeadTime / time.Minute),
+		MaxAdvanceMinutes:  int(gymPolicy.MaxAdvance / time.Minute),
+		MinDurationMinutes: int(gymPolicy.MinDuration / time.Minute),
+		MaxDurationMinutes: int(gymPolicy.MaxDuration / time.Minute),
+		SlotMinutes:        int(gymPolicy.SlotAlignment / time.Minute),
+		MaxBookingsPerDay:  gymPolicy.MaxBookingsPerDay,
+		TimeZone:           gymPolicy.Location().String(),
+
+		CancellationCutoffMinutes: int(gymPolicy.CancellationCutoff / time.Minute),
+		MaxStrikes:                gymPolicy.MaxStrikes,
+		StrikeWindowMinutes:       int(gymPolicy.StrikeWindow / time.Minute),
+		SuspensionMinutes:         int(gymPolicy.SuspensionPeriod / time.Minute),
+
+		BufferBeforeMinutes: int(gymPolicy.BufferBefore / time.Minute),
+		BufferAfterMinutes:  int(gymPolicy.BufferAfter / time.Minute),
+	}
+	if !gymPolicy.UpdatedAt.IsZero() {
+		dto.UpdatedAt = gymPolicy.UpdatedAt.Format(time.RFC3339)
+	}
+	return dto
+}
+
+// FromViolations reports policy violations in the shape of field validation errors, with
+// the rule as the code.
+func FromViolations(violations []policy.Violation) []*ValidationErrorDTO {
+	dtos := make([]*ValidationErrorDTO, len(violations))
+	for i, violation := range violations {
+		dtos[i] = NewValidationErrorDTO(violation.Field, violation.Message, string(violation.Rule))
+	}
+	return dtos
+}
@@ -0,0 +1,52 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/quota"
+)
+
+// UserQuotaDTO is a member's booking quota and how much of it they use. A zero limit is
+// unlimited.
+type UserQuotaDTO struct {
+	UserID string         `json:"user_id"`
+	Tier   string         `json:"tier"`
+	Limits QuotaLimitsDTO `json:"limits"`
+	Usage  QuotaUsageDTO  `json:"usage"`
+}
+
+type QuotaLimitsDTO struct {
+	MaxActiveBookings int `json:"max_active_bookings"`
+	MaxWeeklyMinutes  int `json:"max_weekly_minutes"`
+	MaxAdvanceMinutes int `json:"max_advance_minutes"`
+}
+
+type QuotaUsageDTO struct {
+	ActiveBookings int `json:"active_bookings"`
+	// WeekStart is the Monday, 00:00 UTC, of the week WeeklyMinutes covers.
+	WeekStart     string `json:"week_start"`
+	WeeklyMinutes int    `json:"weekly_minutes"`
+}
+
+func FromQuota(userID string, tier quota.Tier, limits quota.Limits, usage *quota.Usage) *UserQuotaDTO {
+	return &UserQuotaDTO{
+		UserID: userID,
+		Tier:   string(tier),
+		Limits: QuotaLimitsDTO{
+			MaxActiveBookings: limits.MaxActiveBookings,
+			MaxWeeklyMinutes:  int(limits.MaxWeeklyDuration / time.Minute),
+			MaxAdvanceMinutes: int(limits.MaxAdvance / time.Minute),
+		},
+		Usage: QuotaUsageDTO{
+			ActiveBookings: usage.ActiveBookings,
+			WeekStart:      usage.WeekStart.Format(time.RFC3339),
+			WeeklyMinutes:  int(usage.WeeklyDuration / time.Minute),
+		},
+	}
+}
+
+// FromQuotaExceeded reports the exceeded quota in the shape of a field validation error,
+// with the limit as the code.
+func FromQuotaExceeded(exceeded *quota.ExceededError) []*ValidationErrorDTO {
+	return []*ValidationErrorDTO{NewValidationErrorDTO(exceeded.Limit.Field(), exceeded.Message, string(exceeded.Limit))}
+}
@@ -0,0 +1,130 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+// ResourceDTO is a bookable resource inside a gym, such as a court, room or squat rack.
+type ResourceDTO struct {
+	ID       string `json:"id"`
+	GymID    string `json:"gym_id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Capacity int    `json:"capacity"`
+	// BufferBeforeMinutes and BufferAfterMinutes are kept free around each booking of the
+	// resource for changeover. They are not part of the bookings' times.
+	BufferBeforeMinutes int  `json:"buffer_before_minutes"`
+	BufferAfterMinutes  int  `json:"buffer_after_minutes"`
+	Active              bool `json:"active"`
+	// OutOfServiceReason explains why an inactive resource is out of service, when recorded.
+	OutOfServiceReason string `json:"out_of_service_reason,omitempty"`
+	CreatedAt          string `json:"created_at"`
+	UpdatedAt          string `json:"updated_at"`
+}
+
+// SetResourceDTO creates a resource, or replaces the details of an existing one. Whether
+// it is in service is changed separately. Omitted buffers are turned off.
+type SetResourceDTO struct {
+	Name     string `json:"name" validate:"required,max=200"`
+	Type     string `json:"type" validate:"required,max=50"`
+	Capacity int    `json:"capacity" validate:"min=1"`
+
+	BufferBeforeMinutes int `json:"buffer_before_minutes" validate:"min=0"`
+	BufferAfterMinutes  int `json:"buffer_after_minutes" validate:"min=0"`
+}
+
+type OutOfServiceDTO struct {
+	Reason string `json:"reason" validate:"max=500"`
+}
+
+// OutOfServiceResultDTO is a resource taken out of service, with how many of its upcoming
+// bookings were flagged.
+type OutOfServiceResultDTO struct {
+	Resource        *ResourceDTO `json:"resource"`
+	FlaggedBookings int          `json:"flagged_bookings"`
+}
+
+// ResourceAvailabilityQueryDTO selects the free places of a gym's resources within
+// [from, to), optionally of one type only.
+type ResourceAvailabilityQueryDTO struct {
+	GymID string `json:"gym_id" validate:"required,max=36"`
+	Type  string `json:"type" validate:"max=50"`
+	From  string `json:"from" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	To    string `json:"to" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// ResourceAvailabilityDTO lists the free places of each in-service resource between from
+// and to.
+type ResourceAvailabilityDTO struct {
+	GymID     string                  `json:"gym_id"`
+	From      string                  `json:"from"`
+	To        string                  `json:"to"`
+	Resources []*ResourceFreeSlotsDTO `json:"resources"`
+}
+
+type ResourceFreeSlotsDTO struct {
+	ResourceID string             `json:"resource_id"`
+	Name       string             `json:"name"`
+	Type       string             `json:"type"`
+	Capacity   int                `json:"capacity"`
+	Slots      []*ResourceSlotDTO `json:"slots"`
+}
+
+// ResourceSlotDTO is a stretch of time during which Free more bookings of a resource fit.
+type ResourceSlotDTO struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Free      int    `json:"free"`
+}
+
+func (dto *SetResourceDTO) ToDomain(gymID string) *resource.Resource {
+	return &resource.Resource{
+		GymID:        gymID,
+		Name:         dto.Name,
+		Type:         dto.Type,
+		Capacity:     dto.Capacity,
+		BufferBefore: time.Duration(dto.BufferBeforeMinutes) * time.Minute,
+		BufferAfter:  time.Duration(dto.BufferAfterMinutes) * time.Minute,
+		Active:       true,
+	}
+}
+
+func (dto *ResourceAvailabilityQueryDTO) Times() (time.Time, time.Time, error) {
+	return parseTimeRange(dto.From, dto.To)
+}
+
+func FromResource(resourceRecord *resource.Resource) *ResourceDTO {
+	return &ResourceDTO{
+		ID:                  resourceRecord.ID,
+		GymID:               resourceRecord.GymID,
+		Name:                resourceRecord.Name,
+		Type:                resourceRecord.Type,
+		Capacity:            resourceRecord.Capacity,
+		BufferBeforeMinutes: int(resourceRecord.BufferBefore / time.Minute),
+		BufferAfterMinutes:  int(resourceRecord.BufferAfter / time.Minute),
+		Active:              resourceRecord.Active,
+		OutOfServiceReason:  resourceRecord.OutOfServiceReason,
+		CreatedAt:           resourceRecord.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           resourceRecord.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func FromResourceSlots(resourceRecord *resource.Resource, free []resource.Slot) *ResourceFreeSlotsDTO {
+	slots := make([]*ResourceSlotDTO, 0, len(free))
+	for _, slot := range free {
+		slots = append(slots, &ResourceSlotDTO{
+			StartTime: slot.Start.Format(time.RFC3339),
+			EndTime:   slot.End.Format(time.RFC3339),
+			Free:      slot.Free,
+		})
+	}
+	return &ResourceFreeSlotsDTO{
+		ResourceID: resourceRecord.ID,
+		Name:       resourceRecord.Name,
+		Type:       resourceRecord.Type,
+		Capacity:   resourceRecord.Capacity,
+		Slots:      slots,
+	}
+}
@@ -0,0 +1,104 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+// ClassSessionDTO is an instructor-led class and how many members are enrolled in it.
+type ClassSessionDTO struct {
+	ID           string `json:"id"`
+	GymID        string `json:"gym_id"`
+	InstructorID string `json:"instructor_id"`
+	Title        string `json:"title"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+	Capacity     int    `json:"capacity"`
+	Enrolled     int    `json:"enrolled"`
+	Status       string `json:"status"`
+	CancelReason string `json:"cancel_reason,omitempty"`
+	TemplateID   string `json:"template_id,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type CreateClassSessionDTO struct {
+	GymID        string `json:"gym_id" validate:"required,max=36"`
+	InstructorID string `json:"instructor_id" validate:"max=36"`
+	Title        string `json:"title" validate:"required,max=200"`
+	StartTime    string `json:"start_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndTime      string `json:"end_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Capacity     int    `json:"capacity" validate:"min=1"`
+}
+
+// UpdateClassSessionDTO replaces a session's details. Its gym cannot change, and neither
+// can its times while members are enrolled.
+type UpdateClassSessionDTO struct {
+	InstructorID string `json:"instructor_id" validate:"max=36"`
+	Title        string `json:"title" validate:"required,max=200"`
+	StartTime    string `json:"start_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndTime      string `json:"end_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Capacity     int    `json:"capacity" validate:"min=1"`
+}
+
+// ListClassSessionsDTO selects a gym's sessions starting within [from, to).
+type ListClassSessionsDTO struct {
+	GymID string `json:"gym_id" validate:"required,max=36"`
+	From  string `json:"from" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	To    string `json:"to" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// EnrollDTO enrolls a member in a class session.
+type EnrollDTO struct {
+	UserID string `json:"user_id" validate:"required,max=36"`
+}
+
+// CancelledClassSessionDTO is a cancelled session and how many enrollments were cancelled
+// with it.
+type CancelledClassSessionDTO struct {
+	Session              *ClassSessionDTO `json:"session"`
+	CancelledEnrollments int              `json:"cancelled_enrollments"`
+}
+
+func FromClassSession(classSession *session.ClassSession, enrolled int) *ClassSessionDTO {
+	return &ClassSessionDTO{
+		ID:           classSession.ID,
+		GymID:        classSession.GymID,
+		InstructorID: classSession.InstructorID,
+		Title:        classSession.Title,
+		StartTime:    classSession.StartTime.Format(time.RFC3339),
+		EndTime:      classSession.EndTime.Format(time.RFC3339),
+		Capacity:     classSession.Capacity,
+		Enrolled:     enrolled,
+		Status:       string(classSession.Status),
+		CancelReason: classSession.CancelReason,
+		TemplateID:   classSession.TemplateID,
+		CreatedAt:    classSession.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    classSession.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func (dto *CreateClassSessionDTO) Times() (time.Time, time.Time, error) {
+	return parseTimeRange(dto.StartTime, dto.EndTime)
+}
+
+func (dto *UpdateClassSessionDTO) Times() (time.Time, time.Time, error) {
+	return parseTimeRange(dto.StartTime, dto.EndTime)
+}
+
+func (dto *ListClassSessionsDTO) Times() (time.Time, time.Time, error) {
+	return parseTimeRange(dto.From, dto.To)
+}
+
+func parseTimeRange(start, end string) (time.Time, time.Time, error) {
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return startTime, endTime, nil
+}
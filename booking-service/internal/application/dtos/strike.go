@@ -0,0 +1,55 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+)
+
+// UserStrikesDTO is a member's standing at a gym: the strikes that still count against them
+// and the suspension they earned, if it is running.
+type UserStrikesDTO struct {
+	UserID              string       `json:"user_id"`
+	GymID               string       `json:"gym_id"`
+	MaxStrikes          int          `json:"max_strikes"`
+	StrikeWindowMinutes int          `json:"strike_window_minutes"`
+	Strikes             []*StrikeDTO `json:"strikes"`
+	// SuspendedUntil is omitted unless the member is suspended.
+	SuspendedUntil string `json:"suspended_until,omitempty"`
+}
+
+type StrikeDTO struct {
+	ID        string `json:"id"`
+	BookingID string `json:"booking_id"`
+	Reason    string `json:"reason"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ClearedStrikesDTO reports how many strikes staff cleared.
+type ClearedStrikesDTO struct {
+	UserID  string `json:"user_id"`
+	GymID   string `json:"gym_id"`
+	Cleared int    `json:"cleared"`
+}
+
+func FromStrikes(userID, gymID string, rules strike.Rules, strikes []*strike.Strike, suspendedUntil time.Time, suspended bool) *UserStrikesDTO {
+	dto := &UserStrikesDTO{
+		UserID:              userID,
+		GymID:               gymID,
+		MaxStrikes:          rules.MaxStrikes,
+		StrikeWindowMinutes: int(rules.Window / time.Minute),
+		Strikes:             make([]*StrikeDTO, len(strikes)),
+	}
+	for i, record := range strikes {
+		dto.Strikes[i] = &StrikeDTO{
+			ID:        record.ID,
+			BookingID: record.BookingID,
+			Reason:    string(record.Reason),
+			CreatedAt: record.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	if suspended {
+		dto.SuspendedUntil = suspendedUntil.Format(time.RFC3339)
+	}
+	return dto
+}
@@ -0,0 +1,111 @@
+package dtos
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+// TimetableTemplateDTO is a class a gym holds every week. start_time is the local time in
+// the gym's time zone, and valid_until is omitted while the template has no end.
+type TimetableTemplateDTO struct {
+	ID              string `json:"id"`
+	GymID           string `json:"gym_id"`
+	Weekday         string `json:"weekday"`
+	StartTime       string `json:"start_time"`
+	DurationMinutes int    `json:"duration_minutes"`
+	InstructorID    string `json:"instructor_id"`
+	Title           string `json:"title"`
+	Capacity        int    `json:"capacity"`
+	ValidFrom       string `json:"valid_from"`
+	ValidUntil      string `json:"valid_until,omitempty"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// SetTimetableTemplateDTO creates a template, or replaces every detail of an existing one.
+type SetTimetableTemplateDTO struct {
+	Weekday         string `json:"weekday" validate:"required,oneof=MONDAY TUESDAY WEDNESDAY THURSDAY FRIDAY SATURDAY SUNDAY"`
+	StartTime       string `json:"start_time" validate:"required,datetime=15:04"`
+	DurationMinutes int    `json:"duration_minutes" validate:"min=1,max=1440"`
+	InstructorID    string `json:"instructor_id" validate:"max=36"`
+	Title           string `json:"title" validate:"required,max=200"`
+	Capacity        int    `json:"capacity" validate:"min=1"`
+	ValidFrom       string `json:"valid_from" validate:"required,datetime=2006-01-02"`
+	ValidUntil      string `json:"valid_until" validate:"datetime=2006-01-02"`
+}
+
+func (dto *SetTimetableTemplateDTO) ToDomain(gymID string) (*timetable.Template, error) {
+	startTime, err := time.Parse("15:04", dto.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	validFrom, err := time.Parse(time.DateOnly, dto.ValidFrom)
+	if err != nil {
+		return nil, err
+	}
+	var validUntil time.Time
+	if dto.ValidUntil != "" {
+		if validUntil, err = time.Parse(time.DateOnly, dto.ValidUntil); err != nil {
+			return nil, err
+		}
+	}
+
+	return &timetable.Template{
+		GymID:        gymID,
+		Weekday:      parseWeekday(dto.Weekday),
+		StartOfDay:   sinceMidnight(startTime),
+		Duration:     time.Duration(dto.DurationMinutes) * time.Minute,
+		InstructorID: dto.InstructorID,
+		Title:        dto.Title,
+		Capacity:     dto.Capacity,
+		ValidFrom:    validFrom,
+		ValidUntil:   validUntil,
+	}, nil
+}
+
+func FromTimetableTemplate(template *timetable.Template) *TimetableTemplateDTO {
+	dto := &TimetableTemplateDTO{
+		ID:              template.ID,
+		GymID:           template.GymID,
+		Weekday:         formatWeekday(template.Weekday),
+		StartTime:       formatClock(template.StartOfDay),
+		DurationMinutes: int(template.Duration / time.Minute),
+		InstructorID:    template.InstructorID,
+		Title:           template.Title,
+		Capacity:        template.Capacity,
+		ValidFrom:       template.ValidFrom.Format(time.DateOnly),
+		CreatedAt:       template.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       template.UpdatedAt.Format(time.RFC3339),
+	}
+	if !template.ValidUntil.IsZero() {
+		dto.ValidUntil = template.ValidUntil.Format(time.DateOnly)
+	}
+	return dto
+}
+
+// parseWeekday returns the weekday named in upper case, or -1 for an unknown name.
+func parseWeekday(name string) time.Weekday {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if formatWeekday(day) == name {
+			return day
+		}
+	}
+	return -1
+}
+
+func formatWeekday(weekday time.Weekday) string {
+	return strings.ToUpper(weekday.String())
+}
+
+// sinceMidnight returns the time of day of clock, a time parsed with the 15:04 layout.
+func sinceMidnight(clock time.Time) time.Duration {
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+}
+
+// formatClock formats a time of day with the 15:04 layout. Midnight at the end of the day
+// is 00:00.
+func formatClock(offset time.Duration) string {
+	return time.Time{}.Add(offset).Format("15:04")
+}
@@ -0,0 +1,161 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+// TrainerDTO is a personal trainer. Availability times are local times in the trainer's
+// time zone.
+type TrainerDTO struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	TimeZone     string                   `json:"time_zone"`
+	Availability []*AvailabilityWindowDTO `json:"availability"`
+	CreatedAt    string                   `json:"created_at"`
+	UpdatedAt    string                   `json:"updated_at"`
+}
+
+// AvailabilityWindowDTO is a weekly window a trainer takes appointments in. An end_time of
+// 00:00 runs to midnight.
+type AvailabilityWindowDTO struct {
+	Weekday   string `json:"weekday" validate:"required,oneof=MONDAY TUESDAY WEDNESDAY THURSDAY FRIDAY SATURDAY SUNDAY"`
+	StartTime string `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime   string `json:"end_time" validate:"required,datetime=15:04"`
+}
+
+// SetTrainerDTO creates a trainer, or replaces every detail of an existing one.
+type SetTrainerDTO struct {
+	Name         string                   `json:"name" validate:"required,max=200"`
+	TimeZone     string                   `json:"time_zone" validate:"required,max=64"`
+	Availability []*AvailabilityWindowDTO `json:"availability" validate:"max=50"`
+}
+
+type TimeOffDTO struct {
+	ID        string `json:"id"`
+	TrainerID string `json:"trainer_id"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type AddTimeOffDTO struct {
+	StartTime string `json:"start_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	EndTime   string `json:"end_time" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	Reason    string `json:"reason" validate:"max=500"`
+}
+
+// TrainerAvailabilityQueryDTO selects a trainer's free slots within [from, to).
+type TrainerAvailabilityQueryDTO struct {
+	TrainerID string `json:"trainer_id" validate:"required,max=36"`
+	From      string `json:"from" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+	To        string `json:"to" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// TrainerAvailabilityDTO lists the free slots of a trainer between from and to.
+type TrainerAvailabilityDTO struct {
+	TrainerID string         `json:"trainer_id"`
+	TimeZone  string         `json:"time_zone"`
+	From      string         `json:"from"`
+	To        string         `json:"to"`
+	Slots     []*TimeSlotDTO `json:"slots"`
+}
+
+type TimeSlotDTO struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+func (dto *SetTrainerDTO) ToDomain() (*trainer.Trainer, error) {
+	availability := make([]trainer.Window, 0, len(dto.Availability))
+	for _, window := range dto.Availability {
+		startTime, err := time.Parse("15:04", window.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		endTime, err := time.Parse("15:04", window.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		end := sinceMidnight(endTime)
+		if end == 0 {
+			end = 24 * time.Hour
+		}
+		availability = append(availability, trainer.Window{
+			Weekday: parseWeekday(window.Weekday),
+			Start:   sinceMidnight(startTime),
+			End:     end,
+		})
+	}
+
+	return &trainer.Trainer{
+		Name:         dto.Name,
+		TimeZone:     dto.TimeZone,
+		Availability: availability,
+	}, nil
+}
+
+func (dto *AddTimeOffDTO) ToDomain(trainerID string) (*trainer.TimeOff, error) {
+	startTime, endTime, err := parseTimeRange(dto.StartTime, dto.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	return trainer.NewTimeOff(trainerID, startTime, endTime, dto.Reason)
+}
+
+func (dto *TrainerAvailabilityQueryDTO) Times() (time.Time, time.Time, error) {
+	return parseTimeRange(dto.From, dto.To)
+}
+
+func FromTrainer(trainerRecord *trainer.Trainer) *TrainerDTO {
+	availability := make([]*AvailabilityWindowDTO, 0, len(trainerRecord.Availability))
+	for _, window := range trainerRecord.Availability {
+		availability = append(availability, &AvailabilityWindowDTO{
+			Weekday:   formatWeekday(window.Weekday),
+			StartTime: formatClock(window.Start),
+			EndTime:   formatClock(window.End),
+		})
+	}
+
+	return &TrainerDTO{
+		ID:           trainerRecord.ID,
+		Name:         trainerRecord.Name,
+		TimeZone:     trainerRecord.TimeZone,
+		Availability: availability,
+		CreatedAt:    trainerRecord.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    trainerRecord.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func FromTimeOff(timeOff *trainer.TimeOff) *TimeOffDTO {
+	return &TimeOffDTO{
+		ID:        timeOff.ID,
+		TrainerID: timeOff.TrainerID,
+		StartTime: timeOff.StartTime.Format(time.RFC3339),
+		EndTime:   timeOff.EndTime.Format(time.RFC3339),
+		Reason:    timeOff.Reason,
+		CreatedAt: timeOff.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// FromFreeSlots lists the trainer's free slots between from and to, in the trainer's time
+// zone.
+func FromFreeSlots(trainerRecord *trainer.Trainer, from, to time.Time, free []trainer.Interval) *TrainerAvailabilityDTO {
+	location := trainerRecord.Location()
+	slots := make([]*TimeSlotDTO, 0, len(free))
+	for _, interval := range free {
+		slots = append(slots, &TimeSlotDTO{
+			StartTime: interval.Start.In(location).Format(time.RFC3339),
+			EndTime:   interval.End.In(location).Format(time.RFC3339),
+		})
+	}
+	return &TrainerAvailabilityDTO{
+		TrainerID: trainerRecord.ID,
+		TimeZone:  trainerRecord.TimeZone,
+		From:      from.In(location).Format(time.RFC3339),
+		To:        to.In(location).Format(time.RFC3339),
+		Slots:     slots,
+	}
+}
@@ -0,0 +1,33 @@
+// Package memberships refuses bookings at gyms the member has no membership for.
+package memberships
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+)
+
+// EntitlementCheck asks the membership service whether a booking's member may use its gym
+// when the booking starts. It implements commands.BookingCheck.
+type EntitlementCheck struct {
+	memberships membership.Checker
+}
+
+func NewEntitlementCheck(memberships membership.Checker) *EntitlementCheck {
+	return &EntitlementCheck{
+		memberships: memberships,
+	}
+}
+
+func (check *EntitlementCheck) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	hasAccess, err := check.memberships.HasAccess(ctx, candidate.UserID, candidate.GymID, candidate.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAccess {
+		return fmt.Errorf("%w: user %s at gym %s", membership.ErrNoActiveMembership, candidate.UserID, candidate.GymID), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/memberships"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+	membershipmocks "github.com/yourusername/fitbook/booking-service/internal/domain/membership/test/mocks"
+)
+
+func TestCreateBookingRequiresMembership(t *testing.T) {
+	repo := mocks.NewMockRepository()
+	fake := membershipmocks.NewFakeChecker()
+	handler := commands.NewCreateBookingHandler(repo, mocks.NewMockEventPublisher(), mocks.NewLogger(), memberships.NewEntitlementCheck(fake))
+
+	now := time.Now().Truncate(time.Second)
+	fake.Grant("user1", "gym1", now, now.Add(24*time.Hour))
+
+	create := func(gymID string, start time.Time) error {
+		_, err := handler.Handle(context.Background(), commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+			UserID:    "user1",
+			GymID:     gymID,
+			StartTime: start.Format(time.RFC3339),
+			EndTime:   start.Add(time.Hour).Format(time.RFC3339),
+		}})
+		return err
+	}
+
+	assert.NoError(t, create("gym1", now.Add(time.Hour)))
+	assert.ErrorIs(t, create("gym2", now.Add(3*time.Hour)), membership.ErrNoActiveMembership)
+	assert.ErrorIs(t, create("gym1", now.Add(48*time.Hour)), membership.ErrNoActiveMembership, "the membership must be active when the booking starts")
+
+	fake.SetError(membership.ErrUnavailable)
+	err := create("gym1", now.Add(5*time.Hour))
+	assert.ErrorIs(t, err, membership.ErrUnavailable)
+	assert.NotErrorIs(t, err, membership.ErrNoActiveMembership)
+
+	bookings, err := repo.ListByUserID(context.Background(), "user1", now, now.Add(72*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, bookings, 1)
+}
@@ -0,0 +1,121 @@
+// Package policies applies each gym's booking policy to the bookings made at it.
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+)
+
+// Checker evaluates bookings against the policy of their gym. Gyms without a policy allow
+// every booking.
+type Checker struct {
+	policies policy.Repository
+	bookings booking.Repository
+	now      func() time.Time
+}
+
+func NewChecker(policies policy.Repository, bookings booking.Repository) *Checker {
+	return &Checker{
+		policies: policies,
+		bookings: bookings,
+		now:      time.Now,
+	}
+}
+
+// Policy returns the gym's policy, or a policy without rules if it has none.
+func (checker *Checker) Policy(ctx context.Context, gymID string) (*policy.Policy, error) {
+	gymPolicy, err := checker.policies.Get(ctx, gymID)
+	if errors.Is(err, policy.ErrPolicyNotFound) {
+		return &policy.Policy{GymID: gymID}, nil
+	}
+	return gymPolicy, err
+}
+
+// Violations returns every rule of the gym's policy that candidate breaks. pending are
+// bookings not stored yet, such as earlier items of the same batch, which count towards
+// the daily limit. A stored booking being replaced by candidate, which has the same ID, does
+// not.
+func (checker *Checker) Violations(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) ([]policy.Violation, error) {
+	gymPolicy, err := checker.Policy(ctx, candidate.GymID)
+	if err != nil {
+		return nil, err
+	}
+	return checker.violations(ctx, gymPolicy, candidate, pending)
+}
+
+func (checker *Checker) violations(ctx context.Context, gymPolicy *policy.Policy, candidate *booking.Booking, pending []*booking.Booking) ([]policy.Violation, error) {
+	bookingsThatDay := 0
+	if gymPolicy.MaxBookingsPerDay > 0 {
+		dayStart, dayEnd := gymPolicy.Day(candidate.StartTime)
+		// Bookings that start late in the day may end on the next one.
+		existing, err := checker.bookings.ListByUserID(ctx, candidate.UserID, dayStart, dayEnd.AddDate(0, 0, 1))
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range append(existing, pending...) {
+			if (candidate.ID == "" || other.ID != candidate.ID) && other.UserID == candidate.UserID && other.GymID == candidate.GymID &&
+				isActive(other) && !other.StartTime.Before(dayStart) && other.StartTime.Before(dayEnd) {
+				bookingsThatDay++
+			}
+		}
+	}
+
+	violations := gymPolicy.Evaluate(candidate.StartTime, candidate.EndTime, checker.now(), bookingsThatDay)
+	if candidate.SessionID != "" {
+		// Staff set the times of class sessions; only the rules about when and how much
+		// members book apply to enrollments.
+		violations = slices.DeleteFunc(violations, func(violation policy.Violation) bool {
+			return violation.Rule == policy.RuleMinDuration || violation.Rule == policy.RuleMaxDuration ||
+				violation.Rule == policy.RuleSlotAlignment
+		})
+	}
+	return violations, nil
+}
+
+// Check rejects candidate with a *policy.ViolationError if it breaks any rule, and with
+// booking.ErrOverlappingBooking if it is an open booking within the gym's changeover buffer
+// of another. It implements commands.BookingCheck.
+func (checker *Checker) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	gymPolicy, err := checker.Policy(ctx, candidate.GymID)
+	if err != nil {
+		return nil, err
+	}
+	violations, err := checker.violations(ctx, gymPolicy, candidate, pending)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return &policy.ViolationError{Violations: violations}, nil
+	}
+	return checker.checkBuffer(ctx, gymPolicy.Buffer(), candidate, pending)
+}
+
+// checkBuffer leaves plain overlaps to the create handlers; it only looks for bookings
+// closer to candidate than the gym's buffer allows.
+func (checker *Checker) checkBuffer(ctx context.Context, buffer booking.Buffer, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	if buffer.Gap() == 0 {
+		return nil, nil
+	}
+
+	blockedStart, blockedEnd := buffer.Blocks(candidate)
+	existing, err := checker.bookings.ListActiveByGymID(ctx, candidate.GymID, blockedStart, blockedEnd)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range append(existing, pending...) {
+		if other.ID != candidate.ID && isActive(other) && candidate.OverlapsWithin(other, buffer) {
+			return fmt.Errorf("%w: within the gym's changeover time of another booking", booking.ErrOverlappingBooking), nil
+		}
+	}
+	return nil, nil
+}
+
+func isActive(bookingRecord *booking.Booking) bool {
+	return bookingRecord.Status == booking.StatusPending || bookingRecord.Status == booking.StatusConfirmed
+}
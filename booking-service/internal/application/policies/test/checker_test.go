@@ -0,0 +1,166 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+	policymocks "github.com/yourusername/fitbook/booking-service/internal/domain/policy/test/mocks"
+)
+
+func TestCheckerDailyLimit(t *testing.T) {
+	ctx := context.Background()
+	policyRepo := policymocks.NewMockRepository()
+	bookingRepo := mocks.NewMockRepository()
+	checker := policies.NewChecker(policyRepo, bookingRepo)
+	require.NoError(t, policyRepo.Save(ctx, &policy.Policy{GymID: "gym1", MaxBookingsPerDay: 2}))
+
+	day := time.Now().AddDate(0, 0, 2).UTC().Truncate(24 * time.Hour)
+	newBooking := func(gymID string, hour int) *booking.Booking {
+		created, err := booking.NewBooking("user1", gymID, day.Add(time.Duration(hour)*time.Hour), day.Add(time.Duration(hour+1)*time.Hour))
+		require.NoError(t, err)
+		created.ID = fmt.Sprintf("%s-%d", gymID, hour)
+		return created
+	}
+
+	stored := newBooking("gym1", 8)
+	require.NoError(t, bookingRepo.Create(ctx, stored))
+	require.NoError(t, bookingRepo.Create(ctx, newBooking("gym2", 9)))
+	cancelled := newBooking("gym1", 10)
+	require.NoError(t, cancelled.Cancel())
+	require.NoError(t, bookingRepo.Create(ctx, cancelled))
+
+	candidate := newBooking("gym1", 12)
+	violations, err := checker.Violations(ctx, candidate, nil)
+	require.NoError(t, err)
+	assert.Empty(t, violations, "other gyms and cancelled bookings do not count")
+
+	violations, err = checker.Violations(ctx, candidate, []*booking.Booking{newBooking("gym1", 11)})
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, policy.RuleMaxBookingsPerDay, violations[0].Rule)
+
+	preview := newBooking("gym1", 13)
+	preview.ID = ""
+	violations, err = checker.Violations(ctx, preview, []*booking.Booking{newBooking("gym1", 11)})
+	require.NoError(t, err)
+	assert.Len(t, violations, 1, "previewed bookings have no ID yet")
+
+	replacement := *stored
+	violations, err = checker.Violations(ctx, &replacement, []*booking.Booking{newBooking("gym1", 11)})
+	require.NoError(t, err)
+	assert.Empty(t, violations, "a booking does not count against its own replacement")
+
+	violations, err = checker.Violations(ctx, newBooking("gym3", 12), nil)
+	require.NoError(t, err)
+	assert.Empty(t, violations, "gyms without a policy allow every booking")
+}
+
+func TestCreateBookingAppliesPolicy(t *testing.T) {
+	ctx := context.Background()
+	policyRepo := policymocks.NewMockRepository()
+	bookingRepo := mocks.NewMockRepository()
+	require.NoError(t, policyRepo.Save(ctx, &policy.Policy{GymID: "gym1", MinLeadTime: 2 * time.Hour, MaxDuration: 90 * time.Minute}))
+
+	handler := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), policies.NewChecker(policyRepo, bookingRepo))
+
+	now := time.Now().Truncate(time.Second)
+	_, err := handler.Handle(ctx, commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+		UserID:    "user1",
+		GymID:     "gym1",
+		StartTime: now.Add(time.Hour).Format(time.RFC3339),
+		EndTime:   now.Add(3 * time.Hour).Format(time.RFC3339),
+	}})
+	assert.ErrorIs(t, err, policy.ErrLeadTimeTooShort)
+	assert.ErrorIs(t, err, policy.ErrDurationTooLong)
+
+	result, err := handler.Handle(ctx, commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+		UserID:    "user1",
+		GymID:     "gym1",
+		StartTime: now.Add(3 * time.Hour).Format(time.RFC3339),
+		EndTime:   now.Add(4 * time.Hour).Format(time.RFC3339),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", result.Booking.Status)
+}
+
+func TestCreateBookingKeepsGymBuffer(t *testing.T) {
+	ctx := context.Background()
+	policyRepo := policymocks.NewMockRepository()
+	bookingRepo := mocks.NewMockRepository()
+	require.NoError(t, policyRepo.Save(ctx, &policy.Policy{GymID: "gym1", BufferBefore: 5 * time.Minute, BufferAfter: 10 * time.Minute}))
+
+	handler := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), policies.NewChecker(policyRepo, bookingRepo))
+	book := func(userID string, start, end time.Time) (*commands.CreateBookingResult, error) {
+		return handler.Handle(ctx, commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+			UserID:    userID,
+			GymID:     "gym1",
+			StartTime: start.Format(time.RFC3339),
+			EndTime:   end.Format(time.RFC3339),
+		}})
+	}
+
+	start := time.Now().Truncate(time.Hour).Add(24 * time.Hour)
+	first, err := book("user1", start, start.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, start.Format(time.RFC3339), first.Booking.StartTime, "buffers are not part of the booking's times")
+	assert.Equal(t, 60, first.Booking.Duration)
+
+	_, err = book("user2", start.Add(time.Hour+14*time.Minute), start.Add(2*time.Hour))
+	assert.ErrorIs(t, err, booking.ErrOverlappingBooking)
+
+	_, err = book("user2", start.Add(-time.Hour), start.Add(-14*time.Minute))
+	assert.ErrorIs(t, err, booking.ErrOverlappingBooking)
+
+	_, err = book("user2", start.Add(time.Hour+15*time.Minute), start.Add(2*time.Hour))
+	assert.NoError(t, err)
+}
+
+func TestRescheduleBookingAppliesPolicy(t *testing.T) {
+	ctx := context.Background()
+	policyRepo := policymocks.NewMockRepository()
+	bookingRepo := mocks.NewMockRepository()
+	publisher := mocks.NewMockEventPublisher()
+	require.NoError(t, policyRepo.Save(ctx, &policy.Policy{GymID: "gym1", MaxDuration: 90 * time.Minute, MaxBookingsPerDay: 1}))
+
+	checker := policies.NewChecker(policyRepo, bookingRepo)
+	create := commands.NewCreateBookingHandler(bookingRepo, publisher, mocks.NewLogger(), checker)
+	reschedule := commands.NewRescheduleBookingHandler(bookingRepo, publisher, mocks.NewLogger(), checker)
+	move := func(bookingID string, start, end time.Time) (*commands.RescheduleBookingResult, error) {
+		return reschedule.Handle(ctx, commands.RescheduleBookingCommand{BookingID: bookingID, DTO: &dtos.RescheduleBookingDTO{
+			StartTime: start.Format(time.RFC3339),
+			EndTime:   end.Format(time.RFC3339),
+		}})
+	}
+
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 2).Add(10 * time.Hour)
+	created, err := create.Handle(ctx, commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+		UserID:    "user1",
+		GymID:     "gym1",
+		StartTime: start.Format(time.RFC3339),
+		EndTime:   start.Add(time.Hour).Format(time.RFC3339),
+	}})
+	require.NoError(t, err)
+
+	_, err = move(created.Booking.ID, start, start.Add(2*time.Hour))
+	assert.ErrorIs(t, err, policy.ErrDurationTooLong)
+
+	moved, err := move(created.Booking.ID, start.Add(2*time.Hour), start.Add(3*time.Hour))
+	require.NoError(t, err, "the booking being moved does not count towards the daily limit")
+	assert.Equal(t, start.Add(2*time.Hour).Format(time.RFC3339), moved.Booking.StartTime)
+	assert.Equal(t, "PENDING", moved.Booking.Status)
+
+	event, ok := publisher.GetLastEvent().(booking.BookingRescheduledEvent)
+	require.True(t, ok)
+	assert.True(t, event.PreviousStartTime.Equal(start))
+	assert.True(t, event.StartTime.Equal(start.Add(2*time.Hour)))
+}
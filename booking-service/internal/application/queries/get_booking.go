@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
 	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
 	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
@@ -36,6 +37,10 @@ func (handler *GetBookingHandler) Handle(ctx context.Context, query GetBookingQu
 		return nil, err
 	}
 
+	if err := auth.AuthorizeView(ctx, bookingRecord); err != nil {
+		return nil, err
+	}
+
 	return &GetBookingResult{
 		Booking: dtos.FromDomain(bookingRecord),
 	}, nil
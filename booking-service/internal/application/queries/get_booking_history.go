@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+type GetBookingHistoryQuery struct {
+	BookingID string `json:"booking_id" validate:"required"`
+}
+
+type GetBookingHistoryResult struct {
+	History *dtos.BookingHistoryDTO
+}
+
+type GetBookingHistoryHandler struct {
+	repo booking.Repository
+}
+
+func NewGetBookingHistoryHandler(repo booking.Repository) *GetBookingHistoryHandler {
+	return &GetBookingHistoryHandler{
+		repo: repo,
+	}
+}
+
+func (handler *GetBookingHistoryHandler) Handle(ctx context.Context, query GetBookingHistoryQuery) (*GetBookingHistoryResult, error) {
+	if err := validator.ValidateBookingID(query.BookingID); err != nil {
+		return nil, err
+	}
+
+	bookingRecord, err := handler.repo.GetByID(ctx, query.BookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeView(ctx, bookingRecord); err != nil {
+		return nil, err
+	}
+
+	changes, err := handler.repo.ListStatusChanges(ctx, query.BookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &dtos.BookingHistoryDTO{
+		Booking: dtos.FromDomain(bookingRecord),
+		History: make([]*dtos.StatusChangeDTO, len(changes)),
+	}
+	for i, change := range changes {
+		history.History[i] = dtos.FromStatusChange(change)
+	}
+
+	return &GetBookingHistoryResult{
+		History: history,
+	}, nil
+}
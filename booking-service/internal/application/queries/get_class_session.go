@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+type GetClassSessionQuery struct {
+	SessionID string
+}
+
+type GetClassSessionResult struct {
+	Session *dtos.ClassSessionDTO
+}
+
+// GetClassSessionHandler returns a class session with its enrollment count. Sessions are
+// public to every caller, so that members can find classes to enroll in.
+type GetClassSessionHandler struct {
+	repo        session.Repository
+	enrollments *sessions.EnrollmentCheck
+}
+
+func NewGetClassSessionHandler(repo session.Repository, enrollments *sessions.EnrollmentCheck) *GetClassSessionHandler {
+	return &GetClassSessionHandler{
+		repo:        repo,
+		enrollments: enrollments,
+	}
+}
+
+func (handler *GetClassSessionHandler) Handle(ctx context.Context, query GetClassSessionQuery) (*GetClassSessionResult, error) {
+	if err := validator.ValidateSessionID(query.SessionID); err != nil {
+		return nil, err
+	}
+
+	classSession, err := handler.repo.GetByID(ctx, query.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrolled, err := handler.enrollments.Enrolled(ctx, classSession.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetClassSessionResult{
+		Session: dtos.FromClassSession(classSession, len(enrolled)),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+)
+
+type GetGymPolicyQuery struct {
+	GymID string
+}
+
+type GetGymPolicyResult struct {
+	Policy *dtos.GymPolicyDTO
+}
+
+// GetGymPolicyHandler returns a gym's booking rules. They are public to every caller, so
+// that members can see why a booking would be refused.
+type GetGymPolicyHandler struct {
+	checker *policies.Checker
+}
+
+func NewGetGymPolicyHandler(checker *policies.Checker) *GetGymPolicyHandler {
+	return &GetGymPolicyHandler{
+		checker: checker,
+	}
+}
+
+func (handler *GetGymPolicyHandler) Handle(ctx context.Context, query GetGymPolicyQuery) (*GetGymPolicyResult, error) {
+	if err := validator.ValidateGymID(query.GymID); err != nil {
+		return nil, err
+	}
+
+	gymPolicy, err := handler.checker.Policy(ctx, query.GymID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetGymPolicyResult{
+		Policy: dtos.FromPolicy(gymPolicy),
+	}, nil
+}
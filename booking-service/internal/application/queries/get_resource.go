@@ -0,0 +1,40 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+type GetResourceQuery struct {
+	ResourceID string
+}
+
+type GetResourceResult struct {
+	Resource *dtos.ResourceDTO
+}
+
+type GetResourceHandler struct {
+	repo resource.Repository
+}
+
+func NewGetResourceHandler(repo resource.Repository) *GetResourceHandler {
+	return &GetResourceHandler{repo: repo}
+}
+
+func (handler *GetResourceHandler) Handle(ctx context.Context, query GetResourceQuery) (*GetResourceResult, error) {
+	if err := validator.ValidateResourceID(query.ResourceID); err != nil {
+		return nil, err
+	}
+
+	resourceRecord, err := handler.repo.GetByID(ctx, query.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetResourceResult{
+		Resource: dtos.FromResource(resourceRecord),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/resources"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+type GetResourceAvailabilityQuery struct {
+	DTO *dtos.ResourceAvailabilityQueryDTO
+}
+
+type GetResourceAvailabilityResult struct {
+	Availability *dtos.ResourceAvailabilityDTO
+}
+
+// GetResourceAvailabilityHandler lists how many places each of a gym's in-service
+// resources has free over time, so that members can pick a resource and a time together.
+type GetResourceAvailabilityHandler struct {
+	repo     resource.Repository
+	capacity *resources.Capacity
+}
+
+func NewGetResourceAvailabilityHandler(repo resource.Repository, capacity *resources.Capacity) *GetResourceAvailabilityHandler {
+	return &GetResourceAvailabilityHandler{
+		repo:     repo,
+		capacity: capacity,
+	}
+}
+
+func (handler *GetResourceAvailabilityHandler) Handle(ctx context.Context, query GetResourceAvailabilityQuery) (*GetResourceAvailabilityResult, error) {
+	if query.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(query.DTO); err != nil {
+		return nil, err
+	}
+	from, to, err := query.DTO.Times()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.ValidateTimeRange(from, to); err != nil {
+		return nil, err
+	}
+	if to.Sub(from) > resources.MaxAvailabilityRange {
+		return nil, validator.NewValidationError(dtos.NewValidationErrorDTO("to", "must be at most 31 days after from", validator.CodeTooLong))
+	}
+
+	gymResources, err := handler.repo.ListByGymID(ctx, query.DTO.GymID, query.DTO.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &dtos.ResourceAvailabilityDTO{
+		GymID:     query.DTO.GymID,
+		From:      query.DTO.From,
+		To:        query.DTO.To,
+		Resources: []*dtos.ResourceFreeSlotsDTO{},
+	}
+	for _, resourceRecord := range gymResources {
+		if !resourceRecord.Active {
+			continue
+		}
+		free, err := handler.capacity.Free(ctx, resourceRecord, from, to)
+		if err != nil {
+			return nil, err
+		}
+		availability.Resources = append(availability.Resources, dtos.FromResourceSlots(resourceRecord, free))
+	}
+
+	return &GetResourceAvailabilityResult{Availability: availability}, nil
+}
@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+type GetTimetableTemplateQuery struct {
+	TemplateID string
+}
+
+type GetTimetableTemplateResult struct {
+	Template *dtos.TimetableTemplateDTO
+}
+
+// GetTimetableTemplateHandler returns a timetable template. Timetables are public, like the
+// sessions generated from them.
+type GetTimetableTemplateHandler struct {
+	repo timetable.Repository
+}
+
+func NewGetTimetableTemplateHandler(repo timetable.Repository) *GetTimetableTemplateHandler {
+	return &GetTimetableTemplateHandler{repo: repo}
+}
+
+func (handler *GetTimetableTemplateHandler) Handle(ctx context.Context, query GetTimetableTemplateQuery) (*GetTimetableTemplateResult, error) {
+	if err := validator.ValidateTemplateID(query.TemplateID); err != nil {
+		return nil, err
+	}
+
+	template, err := handler.repo.GetByID(ctx, query.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTimetableTemplateResult{
+		Template: dtos.FromTimetableTemplate(template),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+type GetTrainerQuery struct {
+	TrainerID string
+}
+
+type GetTrainerResult struct {
+	Trainer *dtos.TrainerDTO
+}
+
+// GetTrainerHandler returns a trainer and their weekly availability, which members need to
+// book appointments.
+type GetTrainerHandler struct {
+	repo trainer.Repository
+}
+
+func NewGetTrainerHandler(repo trainer.Repository) *GetTrainerHandler {
+	return &GetTrainerHandler{repo: repo}
+}
+
+func (handler *GetTrainerHandler) Handle(ctx context.Context, query GetTrainerQuery) (*GetTrainerResult, error) {
+	if err := validator.ValidateTrainerID(query.TrainerID); err != nil {
+		return nil, err
+	}
+
+	trainerRecord, err := handler.repo.GetByID(ctx, query.TrainerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTrainerResult{
+		Trainer: dtos.FromTrainer(trainerRecord),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/trainers"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+type GetTrainerAvailabilityQuery struct {
+	DTO *dtos.TrainerAvailabilityQueryDTO
+}
+
+type GetTrainerAvailabilityResult struct {
+	Availability *dtos.TrainerAvailabilityDTO
+}
+
+// GetTrainerAvailabilityHandler lists when a trainer can still be booked: within their
+// weekly availability, outside their time off and their appointments at any gym.
+type GetTrainerAvailabilityHandler struct {
+	repo         trainer.Repository
+	appointments *trainers.Appointments
+}
+
+func NewGetTrainerAvailabilityHandler(repo trainer.Repository, appointments *trainers.Appointments) *GetTrainerAvailabilityHandler {
+	return &GetTrainerAvailabilityHandler{
+		repo:         repo,
+		appointments: appointments,
+	}
+}
+
+func (handler *GetTrainerAvailabilityHandler) Handle(ctx context.Context, query GetTrainerAvailabilityQuery) (*GetTrainerAvailabilityResult, error) {
+	if query.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(query.DTO); err != nil {
+		return nil, err
+	}
+	from, to, err := query.DTO.Times()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.ValidateTimeRange(from, to); err != nil {
+		return nil, err
+	}
+	if to.Sub(from) > trainers.MaxAvailabilityRange {
+		return nil, validator.NewValidationError(dtos.NewValidationErrorDTO("to", "must be at most 31 days after from", validator.CodeTooLong))
+	}
+
+	trainerRecord, err := handler.repo.GetByID(ctx, query.DTO.TrainerID)
+	if err != nil {
+		return nil, err
+	}
+
+	free, err := handler.appointments.Free(ctx, trainerRecord, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTrainerAvailabilityResult{
+		Availability: dtos.FromFreeSlots(trainerRecord, from, to, free),
+	}, nil
+}
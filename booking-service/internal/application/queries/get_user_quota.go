@@ -0,0 +1,54 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/quotas"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+)
+
+type GetUserQuotaQuery struct {
+	UserID string
+}
+
+type GetUserQuotaResult struct {
+	Quota *dtos.UserQuotaDTO
+}
+
+// GetUserQuotaHandler returns a member's booking quota with their usage this week.
+type GetUserQuotaHandler struct {
+	checker *quotas.Checker
+}
+
+func NewGetUserQuotaHandler(checker *quotas.Checker) *GetUserQuotaHandler {
+	return &GetUserQuotaHandler{
+		checker: checker,
+	}
+}
+
+func (handler *GetUserQuotaHandler) Handle(ctx context.Context, query GetUserQuotaQuery) (*GetUserQuotaResult, error) {
+	if err := validator.ValidateUserID(query.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeViewUser(ctx, query.UserID); err != nil {
+		return nil, fmt.Errorf("%w: members may only view their own quota", err)
+	}
+
+	tier, err := handler.checker.Tier(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := handler.checker.Usage(ctx, query.UserID, time.Now(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetUserQuotaResult{
+		Quota: dtos.FromQuota(query.UserID, tier, handler.checker.Limits(tier), usage),
+	}, nil
+}
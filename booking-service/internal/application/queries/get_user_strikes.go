@@ -0,0 +1,53 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/strikes"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+)
+
+type GetUserStrikesQuery struct {
+	UserID string
+	GymID  string
+}
+
+type GetUserStrikesResult struct {
+	Strikes *dtos.UserStrikesDTO
+}
+
+// GetUserStrikesHandler returns a member's strikes at a gym and whether they are suspended
+// there.
+type GetUserStrikesHandler struct {
+	tracker *strikes.Tracker
+}
+
+func NewGetUserStrikesHandler(tracker *strikes.Tracker) *GetUserStrikesHandler {
+	return &GetUserStrikesHandler{
+		tracker: tracker,
+	}
+}
+
+func (handler *GetUserStrikesHandler) Handle(ctx context.Context, query GetUserStrikesQuery) (*GetUserStrikesResult, error) {
+	if err := validator.ValidateUserID(query.UserID); err != nil {
+		return nil, err
+	}
+	if err := validator.ValidateGymID(query.GymID); err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeViewUser(ctx, query.UserID); err != nil {
+		return nil, err
+	}
+
+	standing, err := handler.tracker.Standing(ctx, query.UserID, query.GymID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetUserStrikesResult{
+		Strikes: dtos.FromStrikes(standing.UserID, standing.GymID, standing.Rules, standing.Strikes, standing.SuspendedUntil, standing.Suspended),
+	}, nil
+}
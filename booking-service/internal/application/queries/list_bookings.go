@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
 	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
 	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
@@ -35,6 +36,10 @@ func (handler *ListBookingsHandler) Handle(ctx context.Context, query ListBookin
 		return nil, err
 	}
 
+	if err := auth.AuthorizeList(ctx, query.UserID, query.GymID); err != nil {
+		return nil, err
+	}
+
 	var bookings []*booking.Booking
 	var err error
 
@@ -0,0 +1,67 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+type ListClassSessionsQuery struct {
+	DTO *dtos.ListClassSessionsDTO
+}
+
+type ListClassSessionsResult struct {
+	Sessions []*dtos.ClassSessionDTO
+}
+
+// ListClassSessionsHandler lists a gym's class sessions, cancelled ones included.
+type ListClassSessionsHandler struct {
+	repo        session.Repository
+	enrollments *sessions.EnrollmentCheck
+}
+
+func NewListClassSessionsHandler(repo session.Repository, enrollments *sessions.EnrollmentCheck) *ListClassSessionsHandler {
+	return &ListClassSessionsHandler{
+		repo:        repo,
+		enrollments: enrollments,
+	}
+}
+
+func (handler *ListClassSessionsHandler) Handle(ctx context.Context, query ListClassSessionsQuery) (*ListClassSessionsResult, error) {
+	if query.DTO == nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.Struct(query.DTO); err != nil {
+		return nil, err
+	}
+	from, to, err := query.DTO.Times()
+	if err != nil {
+		return nil, booking.ErrInvalidInput
+	}
+	if err := validator.ValidateTimeRange(from, to); err != nil {
+		return nil, err
+	}
+
+	classSessions, err := handler.repo.ListByGymID(ctx, query.DTO.GymID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListClassSessionsResult{Sessions: make([]*dtos.ClassSessionDTO, len(classSessions))}
+	for i, classSession := range classSessions {
+		enrolled := 0
+		if classSession.Status == session.StatusScheduled {
+			enrollments, err := handler.enrollments.Enrolled(ctx, classSession.ID)
+			if err != nil {
+				return nil, err
+			}
+			enrolled = len(enrollments)
+		}
+		result.Sessions[i] = dtos.FromClassSession(classSession, enrolled)
+	}
+	return result, nil
+}
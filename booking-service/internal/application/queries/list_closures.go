@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+type ListClosuresQuery struct {
+	GymID string
+}
+
+type ListClosuresResult struct {
+	Closures []*dtos.ClosureDTO
+}
+
+// ListClosuresHandler returns every closure of a gym, past ones included, earliest first.
+// Recurring closures are listed once, by their first occurrence.
+type ListClosuresHandler struct {
+	repo closure.Repository
+}
+
+func NewListClosuresHandler(repo closure.Repository) *ListClosuresHandler {
+	return &ListClosuresHandler{repo: repo}
+}
+
+func (handler *ListClosuresHandler) Handle(ctx context.Context, query ListClosuresQuery) (*ListClosuresResult, error) {
+	if err := validator.ValidateGymID(query.GymID); err != nil {
+		return nil, err
+	}
+
+	closures, err := handler.repo.ListByGymID(ctx, query.GymID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dtos.ClosureDTO, len(closures))
+	for i, closureRecord := range closures {
+		result[i] = dtos.FromClosure(closureRecord)
+	}
+	return &ListClosuresResult{Closures: result}, nil
+}
@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+type ListResourcesQuery struct {
+	GymID string
+	// Type lists only resources of that type when set.
+	Type string
+}
+
+type ListResourcesResult struct {
+	Resources []*dtos.ResourceDTO
+}
+
+// ListResourcesHandler returns a gym's resources, out-of-service ones included, ordered by
+// type and name.
+type ListResourcesHandler struct {
+	repo resource.Repository
+}
+
+func NewListResourcesHandler(repo resource.Repository) *ListResourcesHandler {
+	return &ListResourcesHandler{repo: repo}
+}
+
+func (handler *ListResourcesHandler) Handle(ctx context.Context, query ListResourcesQuery) (*ListResourcesResult, error) {
+	if err := validator.ValidateGymID(query.GymID); err != nil {
+		return nil, err
+	}
+
+	resources, err := handler.repo.ListByGymID(ctx, query.GymID, query.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dtos.ResourceDTO, len(resources))
+	for i, resourceRecord := range resources {
+		result[i] = dtos.FromResource(resourceRecord)
+	}
+	return &ListResourcesResult{Resources: result}, nil
+}
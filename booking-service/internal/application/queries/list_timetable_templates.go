@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+type ListTimetableTemplatesQuery struct {
+	GymID string
+}
+
+type ListTimetableTemplatesResult struct {
+	Templates []*dtos.TimetableTemplateDTO
+}
+
+// ListTimetableTemplatesHandler returns a gym's weekly timetable, ended templates
+// included.
+type ListTimetableTemplatesHandler struct {
+	repo timetable.Repository
+}
+
+func NewListTimetableTemplatesHandler(repo timetable.Repository) *ListTimetableTemplatesHandler {
+	return &ListTimetableTemplatesHandler{repo: repo}
+}
+
+func (handler *ListTimetableTemplatesHandler) Handle(ctx context.Context, query ListTimetableTemplatesQuery) (*ListTimetableTemplatesResult, error) {
+	if err := validator.ValidateGymID(query.GymID); err != nil {
+		return nil, err
+	}
+
+	templates, err := handler.repo.ListByGymID(ctx, query.GymID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dtos.TimetableTemplateDTO, len(templates))
+	for i, template := range templates {
+		result[i] = dtos.FromTimetableTemplate(template)
+	}
+	return &ListTimetableTemplatesResult{Templates: result}, nil
+}
@@ -0,0 +1,63 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// PreviewBookingPolicyQuery asks which rules of its gym's policy a booking would break,
+// without creating it.
+type PreviewBookingPolicyQuery struct {
+	DTO *dtos.CreateBookingDTO
+}
+
+type PreviewBookingPolicyResult struct {
+	Preview *dtos.PolicyPreviewDTO
+}
+
+type PreviewBookingPolicyHandler struct {
+	checker *policies.Checker
+}
+
+func NewPreviewBookingPolicyHandler(checker *policies.Checker) *PreviewBookingPolicyHandler {
+	return &PreviewBookingPolicyHandler{
+		checker: checker,
+	}
+}
+
+func (handler *PreviewBookingPolicyHandler) Handle(ctx context.Context, query PreviewBookingPolicyQuery) (*PreviewBookingPolicyResult, error) {
+	if err := validator.ValidateCreateBookingDTO(query.DTO); err != nil {
+		return nil, err
+	}
+
+	userID, gymID, startTime, endTime, err := query.DTO.ToDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := auth.AuthorizeCreate(ctx, userID, gymID); err != nil {
+		return nil, err
+	}
+
+	candidate, err := booking.NewBooking(userID, gymID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	violations, err := handler.checker.Violations(ctx, candidate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreviewBookingPolicyResult{
+		Preview: &dtos.PolicyPreviewDTO{
+			Allowed:    len(violations) == 0,
+			Violations: dtos.FromViolations(violations),
+		},
+	}, nil
+}
@@ -0,0 +1,46 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+)
+
+func TestGetBookingHistoryHandler(t *testing.T) {
+	repo := mocks.NewMockRepository()
+	handler := queries.NewGetBookingHistoryHandler(repo)
+	ctx := context.Background()
+
+	now := time.Now()
+	testBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	testBooking.ID = "booking-1"
+	require.NoError(t, repo.Create(ctx, testBooking))
+	require.NoError(t, testBooking.CancelWithReason("gym closed"))
+	require.NoError(t, repo.Update(ctx, testBooking))
+
+	result, err := handler.Handle(ctx, queries.GetBookingHistoryQuery{BookingID: "booking-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "CANCELLED", result.History.Booking.Status)
+	assert.Equal(t, "gym closed", result.History.Booking.StatusReason)
+	require.Len(t, result.History.History, 2)
+	assert.Equal(t, "", result.History.History[0].FromStatus)
+	assert.Equal(t, "PENDING", result.History.History[0].ToStatus)
+	assert.Equal(t, "PENDING", result.History.History[1].FromStatus)
+	assert.Equal(t, "CANCELLED", result.History.History[1].ToStatus)
+	assert.Equal(t, "gym closed", result.History.History[1].Reason)
+
+	otherMember := auth.WithPrincipal(ctx, &auth.Principal{UserID: "user2", Role: auth.RoleMember})
+	_, err = handler.Handle(otherMember, queries.GetBookingHistoryQuery{BookingID: "booking-1"})
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+
+	_, err = handler.Handle(ctx, queries.GetBookingHistoryQuery{BookingID: "missing"})
+	assert.ErrorIs(t, err, booking.ErrBookingNotFound)
+}
@@ -0,0 +1,126 @@
+// Package quotas applies the booking quotas of each member's membership tier.
+package quotas
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/quota"
+)
+
+// Checker evaluates bookings against the quota of their member's tier.
+type Checker struct {
+	bookings    booking.Repository
+	tiers       membership.TierSource
+	limits      map[quota.Tier]quota.Limits
+	defaultTier quota.Tier
+	now         func() time.Time
+}
+
+// NewChecker applies limits by the tier tiers tells. Members whose tier is unknown, and
+// everyone if tiers is nil, get defaultTier.
+func NewChecker(bookings booking.Repository, tiers membership.TierSource, limits map[quota.Tier]quota.Limits, defaultTier quota.Tier) *Checker {
+	return &Checker{
+		bookings:    bookings,
+		tiers:       tiers,
+		limits:      limits,
+		defaultTier: defaultTier,
+		now:         time.Now,
+	}
+}
+
+// Tier returns the membership tier of userID, whoever asks for it.
+func (checker *Checker) Tier(ctx context.Context, userID string) (quota.Tier, error) {
+	if checker.tiers == nil {
+		return checker.defaultTier, nil
+	}
+	tier, err := checker.tiers.Tier(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if !quota.Tier(tier).IsValid() {
+		return checker.defaultTier, nil
+	}
+	return quota.Tier(tier), nil
+}
+
+func (checker *Checker) Limits(tier quota.Tier) quota.Limits {
+	return checker.limits[tier]
+}
+
+// Usage counts the quota userID has used, with the weekly total for the week containing
+// weekOf. pending are bookings not stored yet, such as earlier items of the same batch.
+func (checker *Checker) Usage(ctx context.Context, userID string, weekOf time.Time, pending []*booking.Booking) (*quota.Usage, error) {
+	now := checker.now()
+	activeBookings, err := checker.bookings.CountActiveByUserID(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	weekStart, weekEnd := quota.Week(weekOf)
+	// Bookings that start late on Sunday may end in the next week.
+	weekBookings, err := checker.bookings.ListByUserID(ctx, userID, weekStart, weekEnd.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &quota.Usage{ActiveBookings: activeBookings, WeekStart: weekStart}
+	for _, pendingBooking := range pending {
+		if pendingBooking.UserID == userID && pendingBooking.StartTime.After(now) {
+			usage.ActiveBookings++
+		}
+	}
+	for _, weekBooking := range append(weekBookings, pending...) {
+		if weekBooking.UserID == userID && weekBooking.Status != booking.StatusCancelled &&
+			!weekBooking.StartTime.Before(weekStart) && weekBooking.StartTime.Before(weekEnd) {
+			usage.WeeklyDuration += weekBooking.EndTime.Sub(weekBooking.StartTime)
+		}
+	}
+	return usage, nil
+}
+
+// Check rejects candidate with a *quota.ExceededError if it does not fit its member's
+// quota. A stored booking candidate replaces, which has the same ID, as when it is
+// rescheduled, does not count towards it.
+// It implements commands.BookingCheck.
+func (checker *Checker) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	tier, err := checker.Tier(ctx, candidate.UserID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := checker.Usage(ctx, candidate.UserID, candidate.StartTime, pending)
+	if err != nil {
+		return nil, err
+	}
+	if err := checker.withoutReplaced(ctx, usage, candidate); err != nil {
+		return nil, err
+	}
+	if exceeded := checker.Limits(tier).Evaluate(tier, *usage, candidate.StartTime, candidate.EndTime, checker.now()); exceeded != nil {
+		return exceeded, nil
+	}
+	return nil, nil
+}
+
+// withoutReplaced takes the stored booking candidate replaces, if any, out of usage.
+func (checker *Checker) withoutReplaced(ctx context.Context, usage *quota.Usage, candidate *booking.Booking) error {
+	stored, err := checker.bookings.GetByID(ctx, candidate.ID)
+	if errors.Is(err, booking.ErrBookingNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	active := stored.Status == booking.StatusPending || stored.Status == booking.StatusConfirmed
+	if active && stored.StartTime.After(checker.now()) {
+		usage.ActiveBookings--
+	}
+	_, weekEnd := quota.Week(usage.WeekStart)
+	if stored.Status != booking.StatusCancelled && !stored.StartTime.Before(usage.WeekStart) && stored.StartTime.Before(weekEnd) {
+		usage.WeeklyDuration -= stored.EndTime.Sub(stored.StartTime)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/application/quotas"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+	membershipmocks "github.com/yourusername/fitbook/booking-service/internal/domain/membership/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/quota"
+)
+
+var limits = map[quota.Tier]quota.Limits{
+	quota.TierBasic:   {MaxActiveBookings: 2, MaxWeeklyDuration: 3 * time.Hour, MaxAdvance: 14 * 24 * time.Hour},
+	quota.TierPremium: {MaxActiveBookings: 5, MaxWeeklyDuration: 10 * time.Hour, MaxAdvance: 30 * 24 * time.Hour},
+}
+
+// newMemberships puts user1 on the premium tier and user2 on an unknown one.
+func newMemberships() *membershipmocks.FakeChecker {
+	memberships := membershipmocks.NewFakeChecker()
+	memberships.SetTier("user1", "premium")
+	memberships.SetTier("user2", "gold")
+	return memberships
+}
+
+func TestCheckerTier(t *testing.T) {
+	tests := []struct {
+		name        string
+		memberships membership.TierSource
+		userID      string
+		want        quota.Tier
+		wantErr     error
+	}{
+		{name: "member's tier", memberships: newMemberships(), userID: "user1", want: quota.TierPremium},
+		{name: "unknown tier", memberships: newMemberships(), userID: "user2", want: quota.TierBasic},
+		{name: "no membership", memberships: newMemberships(), userID: "user3", want: quota.TierBasic},
+		{name: "no membership service", userID: "user1", want: quota.TierBasic},
+		{
+			name: "membership service unavailable",
+			memberships: func() membership.TierSource {
+				memberships := newMemberships()
+				memberships.SetError(membership.ErrUnavailable)
+				return memberships
+			}(),
+			userID:  "user1",
+			wantErr: membership.ErrUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := quotas.NewChecker(mocks.NewMockRepository(), tt.memberships, limits, quota.TierBasic)
+			tier, err := checker.Tier(context.Background(), tt.userID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tier)
+		})
+	}
+}
+
+func TestGetUserQuotaHandler(t *testing.T) {
+	handler := queries.NewGetUserQuotaHandler(quotas.NewChecker(mocks.NewMockRepository(), newMemberships(), limits, quota.TierBasic))
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		wantErr   error
+	}{
+		{name: "member", principal: &auth.Principal{UserID: "user1", Role: auth.RoleMember}},
+		{name: "staff", principal: &auth.Principal{UserID: "staff1", Role: auth.RoleStaff, GymIDs: []string{"gym1"}}},
+		{name: "admin", principal: &auth.Principal{UserID: "admin1", Role: auth.RoleAdmin}},
+		{name: "other member", principal: &auth.Principal{UserID: "user2", Role: auth.RoleMember}, wantErr: auth.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler.Handle(auth.WithPrincipal(context.Background(), tt.principal), queries.GetUserQuotaQuery{UserID: "user1"})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "premium", result.Quota.Tier, "the member's tier, whoever asks")
+		})
+	}
+}
+
+func TestCheckerUsage(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository()
+	checker := quotas.NewChecker(repo, nil, limits, quota.TierBasic)
+
+	// A week that has not started yet, so that every booking in it is in the future.
+	weekStart, _ := quota.Week(time.Now().AddDate(0, 0, 7))
+	newBooking := func(userID string, day, hour int, duration time.Duration) *booking.Booking {
+		start := weekStart.AddDate(0, 0, day).Add(time.Duration(hour) * time.Hour)
+		created, err := booking.NewBooking(userID, "gym1", start, start.Add(duration))
+		require.NoError(t, err)
+		created.ID = fmt.Sprintf("%s-%d-%d", userID, day, hour)
+		return created
+	}
+
+	require.NoError(t, repo.Create(ctx, newBooking("user1", 0, 9, time.Hour)))
+	require.NoError(t, repo.Create(ctx, newBooking("user1", 6, 22, 90*time.Minute)))
+	require.NoError(t, repo.Create(ctx, newBooking("user1", 7, 9, time.Hour)))
+	require.NoError(t, repo.Create(ctx, newBooking("user2", 1, 9, time.Hour)))
+	cancelled := newBooking("user1", 2, 9, time.Hour)
+	require.NoError(t, cancelled.Cancel())
+	require.NoError(t, repo.Create(ctx, cancelled))
+
+	usage, err := checker.Usage(ctx, "user1", weekStart.Add(time.Hour), []*booking.Booking{newBooking("user1", 3, 9, 30*time.Minute)})
+	require.NoError(t, err)
+	assert.Equal(t, 4, usage.ActiveBookings)
+	assert.Equal(t, weekStart, usage.WeekStart)
+	assert.Equal(t, 3*time.Hour, usage.WeeklyDuration, "bookings of other weeks, users and cancelled ones do not count")
+}
+
+func TestCreateBookingAppliesQuota(t *testing.T) {
+	repo := mocks.NewMockRepository()
+	memberships := membershipmocks.NewFakeChecker()
+	handler := commands.NewCreateBookingHandler(repo, mocks.NewMockEventPublisher(), mocks.NewLogger(), quotas.NewChecker(repo, memberships, limits, quota.TierBasic))
+
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	create := func(ctx context.Context, userID string, offset time.Duration) error {
+		_, err := handler.Handle(ctx, commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+			UserID:    userID,
+			GymID:     "gym1",
+			StartTime: start.Add(offset).Format(time.RFC3339),
+			EndTime:   start.Add(offset + 30*time.Minute).Format(time.RFC3339),
+		}})
+		return err
+	}
+
+	require.NoError(t, create(context.Background(), "user1", 0))
+	require.NoError(t, create(context.Background(), "user1", time.Hour))
+
+	err := create(context.Background(), "user1", 2*time.Hour)
+	var exceeded *quota.ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, quota.LimitActiveBookings, exceeded.Limit)
+
+	memberships.SetTier("user1", "premium")
+	staff := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "staff1", Role: auth.RoleStaff, GymIDs: []string{"gym1"}})
+	assert.NoError(t, create(staff, "user1", 2*time.Hour), "premium members may hold more bookings, also when staff book for them")
+
+	assert.NoError(t, create(context.Background(), "user1", 20*24*time.Hour), "premium members may book further ahead")
+	err = create(context.Background(), "user1", 40*24*time.Hour)
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, quota.LimitAdvance, exceeded.Limit)
+}
+
+func TestRescheduleBookingAppliesQuota(t *testing.T) {
+	repo := mocks.NewMockRepository()
+	checker := quotas.NewChecker(repo, nil, limits, quota.TierBasic)
+	create := commands.NewCreateBookingHandler(repo, mocks.NewMockEventPublisher(), mocks.NewLogger(), checker)
+	reschedule := commands.NewRescheduleBookingHandler(repo, mocks.NewMockEventPublisher(), mocks.NewLogger(), checker)
+
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	var bookingIDs []string
+	for _, offset := range []time.Duration{0, time.Hour} {
+		result, err := create.Handle(context.Background(), commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+			UserID:    "user1",
+			GymID:     "gym1",
+			StartTime: start.Add(offset).Format(time.RFC3339),
+			EndTime:   start.Add(offset + 30*time.Minute).Format(time.RFC3339),
+		}})
+		require.NoError(t, err)
+		bookingIDs = append(bookingIDs, result.Booking.ID)
+	}
+	move := func(offset time.Duration) error {
+		_, err := reschedule.Handle(context.Background(), commands.RescheduleBookingCommand{BookingID: bookingIDs[0], DTO: &dtos.RescheduleBookingDTO{
+			StartTime: start.Add(offset).Format(time.RFC3339),
+			EndTime:   start.Add(offset + 30*time.Minute).Format(time.RFC3339),
+		}})
+		return err
+	}
+
+	assert.NoError(t, move(2*time.Hour), "the booking being moved does not count against the active bookings limit")
+
+	err := move(20 * 24 * time.Hour)
+	var exceeded *quota.ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	assert.Equal(t, quota.LimitAdvance, exceeded.Limit)
+}
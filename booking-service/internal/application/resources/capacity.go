@@ -0,0 +1,87 @@
+// Package resources books members onto bookable resources inside a gym, such as racks,
+// courts or lanes, keeping each within its capacity.
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+// MaxAvailabilityRange bounds how far apart the ends of an availability query may be.
+const MaxAvailabilityRange = 31 * 24 * time.Hour
+
+// Capacity vets resource bookings and works out how many places resources have free.
+type Capacity struct {
+	resources resource.Repository
+	bookings  booking.Repository
+}
+
+func NewCapacity(resources resource.Repository, bookings booking.Repository) *Capacity {
+	return &Capacity{
+		resources: resources,
+		bookings:  bookings,
+	}
+}
+
+// Check vets bookings of a resource: the resource must belong to the booking's gym, be in
+// service, and have a place free for the whole booking and the buffer around it. Bookings
+// without a resource pass.
+// It implements commands.BookingCheck.
+func (capacity *Capacity) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	if candidate.ResourceID == "" {
+		return nil, nil
+	}
+
+	resourceRecord, err := capacity.resources.GetByID(ctx, candidate.ResourceID)
+	if errors.Is(err, resource.ErrResourceNotFound) {
+		return err, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resourceRecord.GymID != candidate.GymID {
+		return fmt.Errorf("%w: the resource belongs to another gym", resource.ErrResourceMismatch), nil
+	}
+	if !resourceRecord.Active {
+		return resource.ErrResourceOutOfService, nil
+	}
+
+	blockedStart, blockedEnd := resourceRecord.Buffer().Blocks(candidate)
+	booked, err := capacity.bookings.ListActiveByResourceID(ctx, resourceRecord.ID, blockedStart, blockedEnd)
+	if err != nil {
+		return nil, err
+	}
+	var others []*booking.Booking
+	for _, other := range append(booked, pending...) {
+		if other.ID != candidate.ID && other.ResourceID == resourceRecord.ID && isActive(other) {
+			others = append(others, other)
+		}
+	}
+	if !resourceRecord.Fits(others, candidate.StartTime, candidate.EndTime) {
+		return resource.ErrResourceFull, nil
+	}
+	return nil, nil
+}
+
+// Free returns the stretches of [from, to) during which the resource has places free,
+// earliest first.
+func (capacity *Capacity) Free(ctx context.Context, resourceRecord *resource.Resource, from, to time.Time) ([]resource.Slot, error) {
+	if !resourceRecord.Active {
+		return nil, nil
+	}
+	gap := resourceRecord.Buffer().Gap()
+	booked, err := capacity.bookings.ListActiveByResourceID(ctx, resourceRecord.ID, from.Add(-gap), to.Add(gap))
+	if err != nil {
+		return nil, err
+	}
+	return resourceRecord.Slots(booked, from, to), nil
+}
+
+func isActive(bookingRecord *booking.Booking) bool {
+	return bookingRecord.Status == booking.StatusPending || bookingRecord.Status == booking.StatusConfirmed
+}
@@ -0,0 +1,276 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/application/resources"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+	resourcemocks "github.com/yourusername/fitbook/booking-service/internal/domain/resource/test/mocks"
+)
+
+// newRacks stores two squat racks at gym1: rack1 for one member at a time and rack2 for two.
+func newRacks() *resourcemocks.MockRepository {
+	return resourcemocks.NewMockRepository(
+		&resource.Resource{ID: "rack1", GymID: "gym1", Name: "Rack 1", Type: "squat_rack", Capacity: 1, Active: true},
+		&resource.Resource{ID: "rack2", GymID: "gym1", Name: "Rack 2", Type: "squat_rack", Capacity: 2, Active: true},
+	)
+}
+
+// takeOutOfService takes the resource out of service directly in the repository.
+func takeOutOfService(t *testing.T, resourceRepo *resourcemocks.MockRepository, id string) {
+	rack, err := resourceRepo.GetByID(context.Background(), id)
+	require.NoError(t, err)
+	require.NoError(t, rack.TakeOutOfService("broken"))
+	require.NoError(t, resourceRepo.Update(context.Background(), rack))
+}
+
+// request books userID at gymID on resourceID from startHour to endHour tomorrow.
+type request struct {
+	userID     string
+	gymID      string
+	resourceID string
+	startHour  int
+	endHour    int
+}
+
+func (r request) command() commands.CreateBookingCommand {
+	return commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+		UserID:     r.userID,
+		GymID:      r.gymID,
+		StartTime:  mocks.Tomorrow(r.startHour).Format(time.RFC3339),
+		EndTime:    mocks.Tomorrow(r.endHour).Format(time.RFC3339),
+		ResourceID: r.resourceID,
+	}}
+}
+
+func TestBookResource(t *testing.T) {
+	tests := []struct {
+		name         string
+		booked       []request
+		outOfService string
+		request      request
+		wantErr      error
+	}{
+		{
+			name:    "free place",
+			booked:  []request{{userID: "user1", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11}},
+			request: request{userID: "user2", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11},
+		},
+		{
+			name: "resource full",
+			booked: []request{
+				{userID: "user1", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11},
+				{userID: "user2", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11},
+			},
+			request: request{userID: "user3", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11},
+			wantErr: resource.ErrResourceFull,
+		},
+		{
+			name: "other resource full",
+			booked: []request{
+				{userID: "user1", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11},
+				{userID: "user2", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11},
+			},
+			request: request{userID: "user3", gymID: "gym1", resourceID: "rack1", startHour: 10, endHour: 11},
+		},
+		{
+			name:    "unknown resource",
+			request: request{userID: "user1", gymID: "gym1", resourceID: "missing", startHour: 10, endHour: 11},
+			wantErr: resource.ErrResourceNotFound,
+		},
+		{
+			name:    "resource at another gym",
+			request: request{userID: "user1", gymID: "gym2", resourceID: "rack1", startHour: 10, endHour: 11},
+			wantErr: resource.ErrResourceMismatch,
+		},
+		{
+			name:         "resource out of service",
+			outOfService: "rack1",
+			request:      request{userID: "user1", gymID: "gym1", resourceID: "rack1", startHour: 10, endHour: 11},
+			wantErr:      resource.ErrResourceOutOfService,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookingRepo := mocks.NewMockRepository()
+			resourceRepo := newRacks()
+			create := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), resources.NewCapacity(resourceRepo, bookingRepo))
+
+			for _, booked := range tt.booked {
+				_, err := create.Handle(context.Background(), booked.command())
+				require.NoError(t, err)
+			}
+			if tt.outOfService != "" {
+				takeOutOfService(t, resourceRepo, tt.outOfService)
+			}
+			result, err := create.Handle(context.Background(), tt.request.command())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.request.resourceID, result.Booking.ResourceID)
+		})
+	}
+}
+
+func TestOutOfService(t *testing.T) {
+	ctx := context.Background()
+	bookingRepo := mocks.NewMockRepository()
+	resourceRepo := newRacks()
+	publisher := mocks.NewMockEventPublisher()
+	create := commands.NewCreateBookingHandler(bookingRepo, publisher, mocks.NewLogger(), resources.NewCapacity(resourceRepo, bookingRepo))
+	outOfService := commands.NewTakeResourceOutOfServiceHandler(resourceRepo, bookingRepo, publisher, mocks.NewLogger())
+	inService := commands.NewReturnResourceToServiceHandler(resourceRepo, bookingRepo, mocks.NewLogger())
+
+	var booked []*dtos.BookingDTO
+	for _, r := range []request{
+		{userID: "user1", gymID: "gym1", resourceID: "rack1", startHour: 10, endHour: 11},
+		{userID: "user2", gymID: "gym1", resourceID: "rack1", startHour: 12, endHour: 13},
+		{userID: "user3", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 11},
+	} {
+		result, err := create.Handle(ctx, r.command())
+		require.NoError(t, err)
+		booked = append(booked, result.Booking)
+	}
+	publisher.Clear()
+
+	tests := []struct {
+		name    string
+		call    func(t *testing.T) error
+		wantErr error
+	}{
+		{
+			name: "taking a resource out of service",
+			call: func(t *testing.T) error {
+				result, err := outOfService.Handle(ctx, commands.TakeResourceOutOfServiceCommand{
+					ResourceID: "rack1",
+					DTO:        &dtos.OutOfServiceDTO{Reason: "cable snapped"},
+				})
+				require.NoError(t, err)
+				assert.Equal(t, 2, result.Result.FlaggedBookings)
+				assert.False(t, result.Result.Resource.Active)
+				assert.Equal(t, "cable snapped", result.Result.Resource.OutOfServiceReason)
+
+				for _, upcoming := range booked[:2] {
+					flagged, err := bookingRepo.GetByID(ctx, upcoming.ID)
+					require.NoError(t, err)
+					assert.Equal(t, resource.OutOfServiceFlag, flagged.FlagReason)
+					assert.Equal(t, booking.StatusPending, flagged.Status, "flagged bookings keep their status")
+				}
+				unaffected, err := bookingRepo.GetByID(ctx, booked[2].ID)
+				require.NoError(t, err)
+				assert.Empty(t, unaffected.FlagReason)
+
+				event, ok := publisher.GetLastEvent().(resource.OutOfServiceEvent)
+				require.True(t, ok)
+				assert.Equal(t, "rack1", event.ResourceID)
+				assert.Equal(t, 2, event.FlaggedBookings)
+				return nil
+			},
+		},
+		{
+			name: "taking it out of service again",
+			call: func(t *testing.T) error {
+				_, err := outOfService.Handle(ctx, commands.TakeResourceOutOfServiceCommand{
+					ResourceID: "rack1",
+					DTO:        &dtos.OutOfServiceDTO{},
+				})
+				return err
+			},
+			wantErr: resource.ErrResourceOutOfService,
+		},
+		{
+			name: "returning it to service",
+			call: func(t *testing.T) error {
+				returned, err := inService.Handle(ctx, commands.ReturnResourceToServiceCommand{ResourceID: "rack1"})
+				require.NoError(t, err)
+				assert.True(t, returned.Resource.Active)
+
+				unflagged, err := bookingRepo.GetByID(ctx, booked[0].ID)
+				require.NoError(t, err)
+				assert.Empty(t, unflagged.FlagReason)
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(t)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResourceAvailability(t *testing.T) {
+	bookingRepo := mocks.NewMockRepository()
+	resourceRepo := newRacks()
+	capacity := resources.NewCapacity(resourceRepo, bookingRepo)
+	create := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), capacity)
+	availability := queries.NewGetResourceAvailabilityHandler(resourceRepo, capacity)
+	format := func(hour int) string { return mocks.Tomorrow(hour).Format(time.RFC3339) }
+
+	for _, r := range []request{
+		{userID: "user1", gymID: "gym1", resourceID: "rack2", startHour: 10, endHour: 12},
+		{userID: "user2", gymID: "gym1", resourceID: "rack1", startHour: 10, endHour: 11},
+	} {
+		_, err := create.Handle(context.Background(), r.command())
+		require.NoError(t, err)
+	}
+	takeOutOfService(t, resourceRepo, "rack1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		want         []*dtos.ResourceFreeSlotsDTO
+	}{
+		{
+			name:         "squat racks, leaving out those out of service",
+			resourceType: "squat_rack",
+			want: []*dtos.ResourceFreeSlotsDTO{{
+				ResourceID: "rack2",
+				Slots: []*dtos.ResourceSlotDTO{
+					{StartTime: format(9), EndTime: format(10), Free: 2},
+					{StartTime: format(10), EndTime: format(12), Free: 1},
+					{StartTime: format(12), EndTime: format(13), Free: 2},
+				},
+			}},
+		},
+		{
+			name:         "no resources of the type",
+			resourceType: "court",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := availability.Handle(context.Background(), queries.GetResourceAvailabilityQuery{DTO: &dtos.ResourceAvailabilityQueryDTO{
+				GymID: "gym1",
+				Type:  tt.resourceType,
+				From:  format(9),
+				To:    format(13),
+			}})
+			require.NoError(t, err)
+			require.Len(t, result.Availability.Resources, len(tt.want))
+			for i, want := range tt.want {
+				assert.Equal(t, want.ResourceID, result.Availability.Resources[i].ResourceID)
+				assert.Equal(t, want.Slots, result.Availability.Resources[i].Slots)
+			}
+		})
+	}
+}
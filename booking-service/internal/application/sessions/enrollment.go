@@ -0,0 +1,87 @@
+// Package sessions enrolls members in class sessions, holding each session to its capacity.
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+// EnrollmentCheck vets bookings that reference a class session: the session must be
+// scheduled, the booking must match its gym and times, and the session must have room for
+// one more member who is not enrolled yet. It implements commands.BookingCheck and lets
+// every other booking through.
+type EnrollmentCheck struct {
+	sessions session.Repository
+	bookings booking.Repository
+}
+
+func NewEnrollmentCheck(sessions session.Repository, bookings booking.Repository) *EnrollmentCheck {
+	return &EnrollmentCheck{
+		sessions: sessions,
+		bookings: bookings,
+	}
+}
+
+// Enrolled returns the pending and confirmed bookings enrolling members in the session.
+func (check *EnrollmentCheck) Enrolled(ctx context.Context, sessionID string) ([]*booking.Booking, error) {
+	bookings, err := check.bookings.ListBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	enrolled := bookings[:0]
+	for _, bookingRecord := range bookings {
+		if isActive(bookingRecord) {
+			enrolled = append(enrolled, bookingRecord)
+		}
+	}
+	return enrolled, nil
+}
+
+func (check *EnrollmentCheck) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	if candidate.SessionID == "" {
+		return nil, nil
+	}
+
+	classSession, err := check.sessions.GetByID(ctx, candidate.SessionID)
+	if errors.Is(err, session.ErrSessionNotFound) {
+		return err, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case classSession.Status == session.StatusCancelled:
+		return session.ErrSessionCancelled, nil
+	case candidate.GymID != classSession.GymID,
+		!candidate.StartTime.Equal(classSession.StartTime),
+		!candidate.EndTime.Equal(classSession.EndTime):
+		return fmt.Errorf("%w: enrollments must have the session's gym, start and end time", session.ErrSessionMismatch), nil
+	}
+
+	enrolled, err := check.Enrolled(ctx, classSession.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range pending {
+		if other.SessionID == classSession.ID && isActive(other) {
+			enrolled = append(enrolled, other)
+		}
+	}
+	for _, other := range enrolled {
+		if other.UserID == candidate.UserID && other.ID != candidate.ID {
+			return session.ErrAlreadyEnrolled, nil
+		}
+	}
+	if len(enrolled) >= classSession.Capacity {
+		return fmt.Errorf("%w: all %d places are taken", session.ErrSessionFull, classSession.Capacity), nil
+	}
+	return nil, nil
+}
+
+func isActive(bookingRecord *booking.Booking) bool {
+	return bookingRecord.Status == booking.StatusPending || bookingRecord.Status == booking.StatusConfirmed
+}
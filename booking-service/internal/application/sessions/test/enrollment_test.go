@@ -0,0 +1,166 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+	sessionmocks "github.com/yourusername/fitbook/booking-service/internal/domain/session/test/mocks"
+)
+
+func newSession(t *testing.T, capacity int) *session.ClassSession {
+	classSession, err := session.NewClassSession("gym1", "instructor1", "Spin", mocks.Tomorrow(18), mocks.Tomorrow(18).Add(45*time.Minute), capacity)
+	require.NoError(t, err)
+	classSession.ID = "session1"
+	return classSession
+}
+
+func TestEnroll(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		// enrolled are enrolled before userID; the first of them cancels again if cancel is set.
+		enrolled []string
+		cancel   bool
+		userID   string
+		// request, if set, is booked directly instead of enrolling userID.
+		request func(classSession *session.ClassSession) *dtos.CreateBookingDTO
+		wantErr error
+	}{
+		{name: "place free", capacity: 2, enrolled: []string{"user1"}, userID: "user2"},
+		{name: "session full", capacity: 2, enrolled: []string{"user1", "user2"}, userID: "user3", wantErr: session.ErrSessionFull},
+		{name: "enrolled already", capacity: 5, enrolled: []string{"user1"}, userID: "user1", wantErr: session.ErrAlreadyEnrolled},
+		{name: "cancelled enrollment frees its place", capacity: 1, enrolled: []string{"user1"}, cancel: true, userID: "user2"},
+		{
+			name:     "other times than the session",
+			capacity: 5,
+			request: func(classSession *session.ClassSession) *dtos.CreateBookingDTO {
+				return &dtos.CreateBookingDTO{
+					UserID:    "user1",
+					GymID:     "gym1",
+					StartTime: classSession.StartTime.Format(time.RFC3339),
+					EndTime:   classSession.EndTime.Add(15 * time.Minute).Format(time.RFC3339),
+					SessionID: classSession.ID,
+				}
+			},
+			wantErr: session.ErrSessionMismatch,
+		},
+		{
+			name:     "unknown session",
+			capacity: 5,
+			request: func(classSession *session.ClassSession) *dtos.CreateBookingDTO {
+				return &dtos.CreateBookingDTO{
+					UserID:    "user1",
+					GymID:     "gym1",
+					StartTime: classSession.StartTime.Format(time.RFC3339),
+					EndTime:   classSession.EndTime.Format(time.RFC3339),
+					SessionID: "missing",
+				}
+			},
+			wantErr: session.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			classSession := newSession(t, tt.capacity)
+			bookingRepo := mocks.NewMockRepository()
+			sessionRepo := sessionmocks.NewMockRepository(classSession)
+			create := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), sessions.NewEnrollmentCheck(sessionRepo, bookingRepo))
+			enroll := commands.NewEnrollHandler(sessionRepo, create)
+			enrollUser := func(userID string) (*commands.CreateBookingResult, error) {
+				return enroll.Handle(ctx, commands.EnrollCommand{SessionID: classSession.ID, DTO: &dtos.EnrollDTO{UserID: userID}})
+			}
+
+			for i, userID := range tt.enrolled {
+				result, err := enrollUser(userID)
+				require.NoError(t, err, "enrollments do not overlap each other")
+				if i == 0 && tt.cancel {
+					enrollment, err := bookingRepo.GetByID(ctx, result.Booking.ID)
+					require.NoError(t, err)
+					require.NoError(t, enrollment.Cancel())
+					require.NoError(t, bookingRepo.Update(ctx, enrollment))
+				}
+			}
+
+			var result *commands.CreateBookingResult
+			var err error
+			if tt.request != nil {
+				result, err = create.Handle(ctx, commands.CreateBookingCommand{DTO: tt.request(classSession)})
+			} else {
+				result, err = enrollUser(tt.userID)
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, classSession.ID, result.Booking.SessionID)
+		})
+	}
+}
+
+func TestCancelClassSession(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		wantErr   error
+	}{
+		{name: "member", principal: &auth.Principal{UserID: "user1", Role: auth.RoleMember}, wantErr: auth.ErrForbidden},
+		{name: "staff of another gym", principal: &auth.Principal{UserID: "staff2", Role: auth.RoleStaff, GymIDs: []string{"gym2"}}, wantErr: auth.ErrForbidden},
+		{name: "staff of the gym", principal: &auth.Principal{UserID: "staff1", Role: auth.RoleStaff, GymIDs: []string{"gym1"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			classSession := newSession(t, 5)
+			bookingRepo := mocks.NewMockRepository()
+			sessionRepo := sessionmocks.NewMockRepository(classSession)
+			publisher := mocks.NewMockEventPublisher()
+			check := sessions.NewEnrollmentCheck(sessionRepo, bookingRepo)
+			enroll := commands.NewEnrollHandler(sessionRepo, commands.NewCreateBookingHandler(bookingRepo, publisher, mocks.NewLogger(), check))
+			cancel := commands.NewCancelClassSessionHandler(sessionRepo, bookingRepo, check, publisher, mocks.NewLogger())
+
+			for _, userID := range []string{"user1", "user2"} {
+				_, err := enroll.Handle(ctx, commands.EnrollCommand{SessionID: classSession.ID, DTO: &dtos.EnrollDTO{UserID: userID}})
+				require.NoError(t, err)
+			}
+			publisher.Clear()
+
+			result, err := cancel.Handle(auth.WithPrincipal(ctx, tt.principal), commands.CancelClassSessionCommand{SessionID: classSession.ID, Reason: "instructor ill"})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 2, result.Cancelled.CancelledEnrollments)
+			assert.Equal(t, string(session.StatusCancelled), result.Cancelled.Session.Status)
+
+			enrolled, err := bookingRepo.ListBySessionID(ctx, classSession.ID)
+			require.NoError(t, err)
+			for _, enrollment := range enrolled {
+				assert.Equal(t, booking.StatusCancelled, enrollment.Status)
+				assert.Equal(t, "instructor ill", enrollment.StatusReason)
+			}
+
+			events := publisher.GetEvents()
+			require.Len(t, events, 3)
+			assert.Equal(t, "booking.cancelled", events[0].EventName())
+			assert.Equal(t, "class_session.cancelled", events[2].EventName())
+
+			_, err = enroll.Handle(ctx, commands.EnrollCommand{SessionID: classSession.ID, DTO: &dtos.EnrollDTO{UserID: "user3"}})
+			assert.ErrorIs(t, err, session.ErrSessionCancelled)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/application/strikes"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+	policymocks "github.com/yourusername/fitbook/booking-service/internal/domain/policy/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+	strikemocks "github.com/yourusername/fitbook/booking-service/internal/domain/strike/test/mocks"
+)
+
+func TestStrikes(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository()
+	strikeRepo := strikemocks.NewMockRepository()
+	policyRepo := policymocks.NewMockRepository(&policy.Policy{
+		GymID:              "gym1",
+		CancellationCutoff: 2 * time.Hour,
+		MaxStrikes:         1,
+		StrikeWindow:       7 * 24 * time.Hour,
+		SuspensionPeriod:   24 * time.Hour,
+	})
+	tracker := strikes.NewTracker(policies.NewChecker(policyRepo, repo), strikeRepo)
+	publisher := mocks.NewMockEventPublisher()
+	create := commands.NewCreateBookingHandler(repo, publisher, mocks.NewLogger(), tracker)
+	cancel := commands.NewCancelBookingHandler(repo, publisher, mocks.NewLogger(), tracker)
+	noShow := commands.NewMarkNoShowHandler(repo, publisher, mocks.NewLogger(), tracker)
+	clear := commands.NewClearStrikesHandler(tracker, mocks.NewLogger())
+
+	member := auth.WithPrincipal(ctx, &auth.Principal{UserID: "user1", Role: auth.RoleMember})
+	staff := auth.WithPrincipal(ctx, &auth.Principal{UserID: "staff1", Role: auth.RoleStaff, GymIDs: []string{"gym1"}})
+
+	// book creates a half-hour booking for user1 at gym1 starting offset from now, and
+	// cancels it on behalf of by unless by is nil.
+	book := func(offset time.Duration, by context.Context) (*booking.Booking, error) {
+		start := time.Now().Add(offset).Truncate(time.Second)
+		result, err := create.Handle(member, commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+			UserID:    "user1",
+			GymID:     "gym1",
+			StartTime: start.Format(time.RFC3339),
+			EndTime:   start.Add(30 * time.Minute).Format(time.RFC3339),
+		}})
+		if err != nil || by == nil {
+			return nil, err
+		}
+		if err := cancel.Handle(by, commands.CancelBookingCommand{BookingID: result.Booking.ID}); err != nil {
+			return nil, err
+		}
+		return repo.GetByID(ctx, result.Booking.ID)
+	}
+
+	tests := []struct {
+		name    string
+		call    func(t *testing.T) error
+		wantErr error
+	}{
+		{
+			name: "cancelling before the cutoff",
+			call: func(t *testing.T) error {
+				cancelled, err := book(3*time.Hour, member)
+				require.NoError(t, err)
+				assert.False(t, cancelled.CancelledLate)
+				return nil
+			},
+		},
+		{
+			name: "cancelling after the cutoff",
+			call: func(t *testing.T) error {
+				cancelled, err := book(time.Hour, member)
+				require.NoError(t, err)
+				assert.True(t, cancelled.CancelledLate)
+
+				recorded := strikeRepo.All()
+				require.Len(t, recorded, 1)
+				assert.Equal(t, cancelled.ID, recorded[0].BookingID)
+				assert.Equal(t, strike.ReasonLateCancellation, recorded[0].Reason)
+				return nil
+			},
+		},
+		{
+			name: "staff cancelling for a member",
+			call: func(t *testing.T) error {
+				cancelled, err := book(90*time.Minute, staff)
+				require.NoError(t, err)
+				assert.False(t, cancelled.CancelledLate, "staff cancelling for a member do not cancel late")
+				assert.Len(t, strikeRepo.All(), 1)
+				return nil
+			},
+		},
+		{
+			name: "booking with one strike",
+			call: func(t *testing.T) error {
+				_, err := book(3*time.Hour, nil)
+				return err
+			},
+		},
+		{
+			name: "no-show",
+			call: func(t *testing.T) error {
+				// A no-show needs a confirmed booking that has started.
+				started := &booking.Booking{ID: "started", UserID: "user1", GymID: "gym1", StartTime: time.Now().Add(-time.Hour), EndTime: time.Now(), Status: booking.StatusConfirmed}
+				require.NoError(t, repo.Create(ctx, started))
+				return noShow.Handle(staff, commands.MarkNoShowCommand{BookingID: "started"})
+			},
+		},
+		{
+			name: "booking with too many strikes",
+			call: func(t *testing.T) error {
+				_, err := book(5*time.Hour, nil)
+				var suspended *strike.SuspendedError
+				require.ErrorAs(t, err, &suspended)
+				assert.WithinDuration(t, time.Now().Add(24*time.Hour), suspended.Until, time.Minute)
+
+				standing, standingErr := tracker.Standing(ctx, "user1", "gym1")
+				require.NoError(t, standingErr)
+				assert.True(t, standing.Suspended)
+				assert.Len(t, standing.Strikes, 2)
+				return err
+			},
+			wantErr: strike.ErrBookingSuspended,
+		},
+		{
+			name: "member clears their own strikes",
+			call: func(t *testing.T) error {
+				_, err := clear.Handle(member, commands.ClearStrikesCommand{UserID: "user1", GymID: "gym1"})
+				return err
+			},
+			wantErr: auth.ErrForbidden,
+		},
+		{
+			name: "staff clear strikes",
+			call: func(t *testing.T) error {
+				result, err := clear.Handle(staff, commands.ClearStrikesCommand{UserID: "user1", GymID: "gym1"})
+				require.NoError(t, err)
+				assert.Equal(t, 2, result.Cleared.Cleared)
+				return nil
+			},
+		},
+		{
+			name: "booking after the strikes are cleared",
+			call: func(t *testing.T) error {
+				_, err := book(7*time.Hour, nil)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(t)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
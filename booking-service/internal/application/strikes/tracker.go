@@ -0,0 +1,114 @@
+// Package strikes holds members to their gym's cancellation policy: it flags late
+// cancellations, records strikes for them and for no-shows, and refuses bookings from
+// members suspended for collecting too many.
+package strikes
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+)
+
+// Tracker applies the cancellation cutoff and strike rules of each gym's policy.
+type Tracker struct {
+	policies *policies.Checker
+	strikes  strike.Repository
+	now      func() time.Time
+}
+
+func NewTracker(policies *policies.Checker, strikes strike.Repository) *Tracker {
+	return &Tracker{
+		policies: policies,
+		strikes:  strikes,
+		now:      time.Now,
+	}
+}
+
+// Standing is a member's strikes at a gym and the suspension they earned, if any.
+type Standing struct {
+	UserID string
+	GymID  string
+	Rules  strike.Rules
+	// Strikes are the uncleared strikes that still count towards, or extend, a suspension.
+	// With the rules off, that is every uncleared strike.
+	Strikes        []*strike.Strike
+	SuspendedUntil time.Time
+	Suspended      bool
+}
+
+func rules(gymPolicy *policy.Policy) strike.Rules {
+	return strike.Rules{
+		MaxStrikes: gymPolicy.MaxStrikes,
+		Window:     gymPolicy.StrikeWindow,
+		Suspension: gymPolicy.SuspensionPeriod,
+	}
+}
+
+// Standing returns the standing of userID at gymID.
+func (tracker *Tracker) Standing(ctx context.Context, userID, gymID string) (*Standing, error) {
+	gymPolicy, err := tracker.policies.Policy(ctx, gymID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := tracker.now()
+	gymRules := rules(gymPolicy)
+	var since time.Time
+	if gymRules.Enabled() {
+		since = now.Add(-gymRules.Lookback())
+	}
+	strikes, err := tracker.strikes.ListActive(ctx, userID, gymID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	standing := &Standing{UserID: userID, GymID: gymID, Rules: gymRules, Strikes: strikes}
+	standing.SuspendedUntil, standing.Suspended = gymRules.SuspendedUntil(strikes, now)
+	return standing, nil
+}
+
+// Check rejects candidate with a *strike.SuspendedError while its member is suspended at
+// its gym. It implements commands.BookingCheck.
+func (tracker *Tracker) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	standing, err := tracker.Standing(ctx, candidate.UserID, candidate.GymID)
+	if err != nil {
+		return nil, err
+	}
+	if standing.Suspended {
+		return &strike.SuspendedError{GymID: candidate.GymID, Until: standing.SuspendedUntil}, nil
+	}
+	return nil, nil
+}
+
+// IsLateCancellation reports whether cancelling bookingRecord now breaks its gym's
+// cancellation cutoff. Only members cancelling their own bookings cancel late; staff
+// cancelling on a member's behalf never do.
+func (tracker *Tracker) IsLateCancellation(ctx context.Context, bookingRecord *booking.Booking) (bool, error) {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && principal.UserID != bookingRecord.UserID {
+		return false, nil
+	}
+	gymPolicy, err := tracker.policies.Policy(ctx, bookingRecord.GymID)
+	if err != nil {
+		return false, err
+	}
+	return bookingRecord.IsLateCancellation(tracker.now(), gymPolicy.CancellationCutoff), nil
+}
+
+// Record adds a strike against bookingRecord's member for reason.
+func (tracker *Tracker) Record(ctx context.Context, bookingRecord *booking.Booking, reason strike.Reason) error {
+	record := strike.NewStrike(bookingRecord.UserID, bookingRecord.GymID, bookingRecord.ID, reason, tracker.now())
+	record.ID = uuid.New().String()
+	return tracker.strikes.Add(ctx, record)
+}
+
+// Clear forgives every strike of userID at gymID, lifting any suspension, and returns how
+// many were cleared.
+func (tracker *Tracker) Clear(ctx context.Context, userID, gymID, clearedBy string) (int, error) {
+	return tracker.strikes.Clear(ctx, userID, gymID, clearedBy, tracker.now())
+}
@@ -0,0 +1,259 @@
+// Package timetables turns the weekly timetable templates of gyms into concrete class
+// sessions a number of weeks ahead, so members can enroll in them.
+package timetables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+// RemovedReason is recorded on sessions cancelled because their template no longer holds
+// them, e.g. after it moved to another weekday or ended.
+const RemovedReason = "removed from the timetable"
+
+// Generator materializes the sessions of timetable templates in each gym's time zone.
+// Generating again is idempotent: each template holds at most one session per date, and a
+// date whose session was cancelled, by staff or by the generator, is not generated again.
+//
+// Template edits are applied to the sessions already generated from it unless those were
+// edited themselves since. Sessions members are enrolled in keep their times, and are kept
+// when the template no longer holds them; empty ones are rescheduled or cancelled instead.
+type Generator struct {
+	templates   timetable.Repository
+	sessions    session.Repository
+	enrollments *sessions.EnrollmentCheck
+	policies    *policies.Checker
+	publisher   booking.EventPublisher
+	weeksAhead  int
+	logger      *slog.Logger
+}
+
+func NewGenerator(
+	templates timetable.Repository,
+	sessionRepo session.Repository,
+	enrollments *sessions.EnrollmentCheck,
+	policyChecker *policies.Checker,
+	publisher booking.EventPublisher,
+	weeksAhead int,
+	logger *slog.Logger,
+) *Generator {
+	return &Generator{
+		templates:   templates,
+		sessions:    sessionRepo,
+		enrollments: enrollments,
+		policies:    policyChecker,
+		publisher:   publisher,
+		weeksAhead:  weeksAhead,
+		logger:      logger,
+	}
+}
+
+// Result counts the sessions a run created, brought in line with their template and
+// cancelled.
+type Result struct {
+	Created   int
+	Updated   int
+	Cancelled int
+}
+
+func (result *Result) add(other Result) {
+	result.Created += other.Created
+	result.Updated += other.Updated
+	result.Cancelled += other.Cancelled
+}
+
+// Run generates the sessions of every template right away and then every interval, until
+// ctx is done. Failures are logged and retried on the next run.
+func (generator *Generator) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := generator.GenerateAll(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			generator.logger.ErrorContext(ctx, "failed to generate timetable sessions", slog.Any("error", err))
+		}
+		if result.Created > 0 || result.Updated > 0 || result.Cancelled > 0 {
+			generator.logger.InfoContext(ctx, "generated timetable sessions",
+				slog.Int("created", result.Created),
+				slog.Int("updated", result.Updated),
+				slog.Int("cancelled", result.Cancelled),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// GenerateAll generates the sessions of every template. A template that fails does not
+// stop the others; the errors are joined.
+func (generator *Generator) GenerateAll(ctx context.Context, now time.Time) (Result, error) {
+	var total Result
+	templates, err := generator.templates.List(ctx)
+	if err != nil {
+		return total, err
+	}
+
+	var errs []error
+	for _, template := range templates {
+		result, err := generator.Generate(ctx, template, now)
+		total.add(result)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("template %s: %w", template.ID, err))
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+// Today returns the current date at the gym, as midnight UTC.
+func (generator *Generator) Today(ctx context.Context, gymID string, now time.Time) (time.Time, error) {
+	gymPolicy, err := generator.policies.Policy(ctx, gymID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return timetable.DateOf(now, gymPolicy.Location()), nil
+}
+
+// Generate brings the template's sessions that have not started yet, up to the horizon,
+// in line with it.
+func (generator *Generator) Generate(ctx context.Context, template *timetable.Template, now time.Time) (Result, error) {
+	var result Result
+	gymPolicy, err := generator.policies.Policy(ctx, template.GymID)
+	if err != nil {
+		return result, err
+	}
+	location := gymPolicy.Location()
+	today := timetable.DateOf(now, location)
+
+	generated, err := generator.sessions.ListByTemplateID(ctx, template.ID, today)
+	if err != nil {
+		return result, err
+	}
+	byDate := make(map[string]*session.ClassSession, len(generated))
+	for _, classSession := range generated {
+		byDate[classSession.OccurrenceDate.Format(time.DateOnly)] = classSession
+	}
+
+	for _, date := range template.Dates(today, today.AddDate(0, 0, 7*generator.weeksAhead-1)) {
+		key := date.Format(time.DateOnly)
+		classSession, exists := byDate[key]
+		delete(byDate, key)
+
+		startTime, endTime := template.Times(date, location)
+		switch {
+		case !exists:
+			if !startTime.After(now) {
+				continue
+			}
+			if err := generator.create(ctx, template, date, startTime, endTime); err != nil {
+				return result, err
+			}
+			result.Created++
+		case classSession.Status == session.StatusCancelled || !classSession.StartTime.After(now):
+		case template.UpdatedAt.After(classSession.UpdatedAt):
+			if !startTime.After(now) {
+				startTime, endTime = classSession.StartTime, classSession.EndTime
+			}
+			updated, err := generator.update(ctx, template, classSession, startTime, endTime)
+			if err != nil {
+				return result, err
+			}
+			if updated {
+				result.Updated++
+			}
+		}
+	}
+
+	// Whatever is left was generated for a date the template no longer holds.
+	for _, classSession := range byDate {
+		if classSession.Status == session.StatusCancelled || !classSession.StartTime.After(now) {
+			continue
+		}
+		cancelled, err := generator.remove(ctx, classSession)
+		if err != nil {
+			return result, err
+		}
+		if cancelled {
+			result.Cancelled++
+		}
+	}
+	return result, nil
+}
+
+func (generator *Generator) create(ctx context.Context, template *timetable.Template, date, startTime, endTime time.Time) error {
+	classSession, err := session.NewClassSession(template.GymID, template.InstructorID, template.Title, startTime, endTime, template.Capacity)
+	if err != nil {
+		return err
+	}
+	classSession.ID = uuid.New().String()
+	classSession.TemplateID = template.ID
+	classSession.OccurrenceDate = date
+	return generator.sessions.Create(ctx, classSession)
+}
+
+// update applies the template to a session generated from it. A session members are
+// enrolled in keeps its times and never shrinks below its enrollment.
+func (generator *Generator) update(ctx context.Context, template *timetable.Template, classSession *session.ClassSession, startTime, endTime time.Time) (bool, error) {
+	enrolled, err := generator.enrollments.Enrolled(ctx, classSession.ID)
+	if err != nil {
+		return false, err
+	}
+	capacity := template.Capacity
+	if len(enrolled) > 0 {
+		startTime, endTime = classSession.StartTime, classSession.EndTime
+		capacity = max(capacity, len(enrolled))
+	}
+	if err := classSession.Reschedule(template.InstructorID, template.Title, startTime, endTime, capacity, len(enrolled)); err != nil {
+		return false, err
+	}
+	if err := generator.sessions.Update(ctx, classSession); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// remove cancels a session the template no longer holds, unless members are enrolled in
+// it.
+func (generator *Generator) remove(ctx context.Context, classSession *session.ClassSession) (bool, error) {
+	enrolled, err := generator.enrollments.Enrolled(ctx, classSession.ID)
+	if err != nil {
+		return false, err
+	}
+	if len(enrolled) > 0 {
+		generator.logger.InfoContext(ctx, "keeping booked session no longer in the timetable",
+			slog.String("session_id", classSession.ID),
+			slog.Int("enrolled", len(enrolled)),
+		)
+		return false, nil
+	}
+
+	if err := classSession.Cancel(RemovedReason); err != nil {
+		return false, err
+	}
+	if err := generator.sessions.Update(ctx, classSession); err != nil {
+		return false, err
+	}
+	event := session.NewClassSessionCancelledEvent(classSession, 0)
+	if err := generator.publisher.Publish(ctx, event); err != nil {
+		generator.logger.ErrorContext(ctx, "failed to publish class session event",
+			slog.String("event", event.EventName()),
+			slog.String("session_id", classSession.ID),
+			slog.Any("error", err),
+		)
+	}
+	return true, nil
+}
@@ -0,0 +1,214 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/application/timetables"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	policymocks "github.com/yourusername/fitbook/booking-service/internal/domain/policy/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+	sessionmocks "github.com/yourusername/fitbook/booking-service/internal/domain/session/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+	timetablemocks "github.com/yourusername/fitbook/booking-service/internal/domain/timetable/test/mocks"
+)
+
+// newGenerator generates two weeks ahead, in UTC since gym1 has no policy.
+func newGenerator(templates *timetablemocks.MockRepository, sessionRepo *sessionmocks.MockRepository, bookings *mocks.MockRepository) *timetables.Generator {
+	return timetables.NewGenerator(
+		templates,
+		sessionRepo,
+		sessions.NewEnrollmentCheck(sessionRepo, bookings),
+		policies.NewChecker(policymocks.NewMockRepository(), bookings),
+		mocks.NewMockEventPublisher(),
+		2,
+		mocks.NewLogger(),
+	)
+}
+
+func TestGenerate(t *testing.T) {
+	today := timetable.DateOf(time.Now().UTC(), time.UTC)
+	firstDate := today.AddDate(0, 0, 2)
+
+	tests := []struct {
+		name string
+		// enroll lists the generated sessions a member enrolls in before the template changes.
+		enroll []int
+		// edit changes the template, and change the first generated session after it, before
+		// generating again.
+		edit   func(template *timetable.Template)
+		change func(t *testing.T, classSession *session.ClassSession)
+		want   timetables.Result
+		check  func(t *testing.T, original []*session.ClassSession, generated []*session.ClassSession)
+	}{
+		{
+			name: "nothing changed",
+			check: func(t *testing.T, original []*session.ClassSession, generated []*session.ClassSession) {
+				require.Len(t, generated, 2)
+				assert.Equal(t, firstDate, generated[0].OccurrenceDate)
+				assert.Equal(t, firstDate.Add(18*time.Hour), generated[0].StartTime)
+				assert.Equal(t, firstDate.AddDate(0, 0, 7), generated[1].OccurrenceDate)
+				assert.Equal(t, "template1", generated[0].TemplateID)
+			},
+		},
+		{
+			name:   "template edited around a booked session",
+			enroll: []int{0},
+			edit: func(template *timetable.Template) {
+				template.StartOfDay = 19 * time.Hour
+				template.Title = "Spin Advanced"
+				template.Capacity = 8
+			},
+			want: timetables.Result{Updated: 2},
+			check: func(t *testing.T, original []*session.ClassSession, generated []*session.ClassSession) {
+				require.Len(t, generated, 2)
+				assert.Equal(t, original[0].ID, generated[0].ID)
+				assert.Equal(t, original[0].StartTime, generated[0].StartTime, "a booked session keeps its times")
+				assert.Equal(t, "Spin Advanced", generated[0].Title)
+				assert.Equal(t, generated[1].OccurrenceDate.Add(19*time.Hour), generated[1].StartTime)
+				assert.Equal(t, 8, generated[1].Capacity)
+			},
+		},
+		{
+			name: "template edited around an edited session",
+			change: func(t *testing.T, classSession *session.ClassSession) {
+				require.NoError(t, classSession.Reschedule("instructor2", "Spin with a guest", classSession.StartTime, classSession.EndTime, classSession.Capacity, 0))
+			},
+			edit: func(template *timetable.Template) { template.Title = "Spin Advanced" },
+			want: timetables.Result{Updated: 1},
+			check: func(t *testing.T, original []*session.ClassSession, generated []*session.ClassSession) {
+				assert.Equal(t, "Spin with a guest", generated[0].Title)
+				assert.Equal(t, "Spin Advanced", generated[1].Title)
+			},
+		},
+		{
+			name:   "template moved to another day",
+			enroll: []int{0},
+			edit:   func(template *timetable.Template) { template.Weekday = (template.Weekday + 1) % 7 },
+			want:   timetables.Result{Created: 2, Cancelled: 1},
+			check: func(t *testing.T, original []*session.ClassSession, generated []*session.ClassSession) {
+				byID := make(map[string]*session.ClassSession)
+				for _, classSession := range generated {
+					byID[classSession.ID] = classSession
+				}
+				require.Contains(t, byID, original[0].ID)
+				assert.Equal(t, session.StatusScheduled, byID[original[0].ID].Status, "a booked session is never orphaned")
+				require.Contains(t, byID, original[1].ID)
+				assert.Equal(t, session.StatusCancelled, byID[original[1].ID].Status)
+				assert.Equal(t, timetables.RemovedReason, byID[original[1].ID].CancelReason)
+			},
+		},
+		{
+			name: "cancelled occurrence",
+			change: func(t *testing.T, classSession *session.ClassSession) {
+				require.NoError(t, classSession.Cancel("holiday"))
+			},
+			check: func(t *testing.T, original []*session.ClassSession, generated []*session.ClassSession) {
+				assert.Len(t, generated, 2, "a cancelled occurrence is not generated again")
+			},
+		},
+		{
+			name: "template ended",
+			edit: func(template *timetable.Template) { template.End(today.AddDate(0, 0, -1)) },
+			want: timetables.Result{Cancelled: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			now := time.Now().UTC()
+			template := &timetable.Template{
+				ID:         "template1",
+				GymID:      "gym1",
+				Weekday:    firstDate.Weekday(),
+				StartOfDay: 18 * time.Hour,
+				Duration:   time.Hour,
+				Title:      "Spin",
+				Capacity:   12,
+				ValidFrom:  today.AddDate(0, 0, -30),
+				CreatedAt:  now.Add(-time.Hour),
+				UpdatedAt:  now.Add(-time.Hour),
+			}
+			require.NoError(t, template.Validate())
+			templates := timetablemocks.NewMockRepository(template)
+			sessionRepo := sessionmocks.NewMockRepository()
+			bookings := mocks.NewMockRepository()
+			generator := newGenerator(templates, sessionRepo, bookings)
+			generated := func() []*session.ClassSession {
+				generated, err := sessionRepo.ListByTemplateID(ctx, template.ID, today)
+				require.NoError(t, err)
+				return generated
+			}
+
+			result, err := generator.GenerateAll(ctx, now)
+			require.NoError(t, err)
+			require.Equal(t, timetables.Result{Created: 2}, result)
+			original := generated()
+
+			for _, i := range tt.enroll {
+				enrollment, err := booking.NewBooking("user1", original[i].GymID, original[i].StartTime, original[i].EndTime)
+				require.NoError(t, err)
+				enrollment.ID = "booking-" + original[i].ID
+				enrollment.SessionID = original[i].ID
+				bookings.AddBooking(enrollment)
+			}
+			if tt.edit != nil {
+				// Edit the template as an update through the API would.
+				tt.edit(template)
+				template.UpdatedAt = time.Now()
+				require.NoError(t, templates.Update(ctx, template))
+			}
+			if tt.change != nil {
+				changed := *original[0]
+				tt.change(t, &changed)
+				require.NoError(t, sessionRepo.Update(ctx, &changed))
+			}
+
+			result, err = generator.GenerateAll(ctx, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+			if tt.check != nil {
+				tt.check(t, original, generated())
+			}
+		})
+	}
+}
+
+func TestCreateTemplateGeneratesSessions(t *testing.T) {
+	templates := timetablemocks.NewMockRepository()
+	sessionRepo := sessionmocks.NewMockRepository()
+	handler := commands.NewCreateTimetableTemplateHandler(templates, newGenerator(templates, sessionRepo, mocks.NewMockRepository()), mocks.NewLogger())
+	today := timetable.DateOf(time.Now(), time.UTC)
+
+	result, err := handler.Handle(context.Background(), commands.CreateTimetableTemplateCommand{
+		GymID: "gym1",
+		DTO: &dtos.SetTimetableTemplateDTO{
+			Weekday:         strings.ToUpper(today.AddDate(0, 0, 1).Weekday().String()),
+			StartTime:       "07:30",
+			DurationMinutes: 45,
+			Title:           "Yoga",
+			Capacity:        10,
+			ValidFrom:       today.Format(time.DateOnly),
+			ValidUntil:      today.AddDate(0, 0, 6).Format(time.DateOnly),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "07:30", result.Template.StartTime)
+	assert.Equal(t, today.AddDate(0, 0, 6).Format(time.DateOnly), result.Template.ValidUntil)
+
+	generated, err := sessionRepo.ListByTemplateID(context.Background(), result.Template.ID, today)
+	require.NoError(t, err)
+	require.Len(t, generated, 1)
+	assert.Equal(t, today.AddDate(0, 0, 1).Add(7*time.Hour+30*time.Minute), generated[0].StartTime)
+	assert.Equal(t, 45*time.Minute, generated[0].EndTime.Sub(generated[0].StartTime))
+}
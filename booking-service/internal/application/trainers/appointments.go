@@ -0,0 +1,119 @@
+// Package trainers books members in for 1:1 appointments with personal trainers, keeping
+// every trainer and member to one appointment at a time.
+package trainers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+// MaxAvailabilityRange bounds how far apart the ends of an availability query may be.
+const MaxAvailabilityRange = 31 * 24 * time.Hour
+
+// Appointments vets appointments and works out when trainers are free.
+type Appointments struct {
+	trainers trainer.Repository
+	bookings booking.Repository
+}
+
+func NewAppointments(trainers trainer.Repository, bookings booking.Repository) *Appointments {
+	return &Appointments{
+		trainers: trainers,
+		bookings: bookings,
+	}
+}
+
+// Check vets bookings against personal-training appointments. An appointment must fall
+// within one of its trainer's availability windows, outside their time off, and must not
+// overlap another of their appointments at any gym. No member may hold an appointment and
+// another booking at the same time, wherever either is. It implements
+// commands.BookingCheck.
+func (appointments *Appointments) Check(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	if candidate.TrainerID != "" {
+		rejection, err := appointments.checkTrainer(ctx, candidate, pending)
+		if rejection != nil || err != nil {
+			return rejection, err
+		}
+	}
+
+	memberBookings, err := appointments.bookings.ListActiveByUserID(ctx, candidate.UserID, candidate.StartTime, candidate.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range append(memberBookings, pending...) {
+		if other.ID == candidate.ID || other.UserID != candidate.UserID || !isActive(other) || !overlaps(candidate, other) {
+			continue
+		}
+		if candidate.TrainerID != "" || other.TrainerID != "" {
+			return fmt.Errorf("%w: the member has another booking during the appointment", booking.ErrOverlappingBooking), nil
+		}
+	}
+	return nil, nil
+}
+
+func (appointments *Appointments) checkTrainer(ctx context.Context, candidate *booking.Booking, pending []*booking.Booking) (error, error) {
+	trainerRecord, err := appointments.trainers.GetByID(ctx, candidate.TrainerID)
+	if errors.Is(err, trainer.ErrTrainerNotFound) {
+		return err, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !trainerRecord.Covers(candidate.StartTime, candidate.EndTime) {
+		return fmt.Errorf("%w: outside the trainer's weekly availability", trainer.ErrTrainerUnavailable), nil
+	}
+
+	timeOff, err := appointments.trainers.ListTimeOff(ctx, trainerRecord.ID, candidate.StartTime, candidate.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if len(timeOff) > 0 {
+		return fmt.Errorf("%w: the trainer is on time off", trainer.ErrTrainerUnavailable), nil
+	}
+
+	booked, err := appointments.bookings.ListActiveByTrainerID(ctx, trainerRecord.ID, candidate.StartTime, candidate.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range append(booked, pending...) {
+		if other.ID != candidate.ID && other.TrainerID == trainerRecord.ID && isActive(other) && overlaps(candidate, other) {
+			return trainer.ErrTrainerBooked, nil
+		}
+	}
+	return nil, nil
+}
+
+// Free returns the stretches of [from, to) the trainer is available and has neither time
+// off nor an appointment, earliest first.
+func (appointments *Appointments) Free(ctx context.Context, trainerRecord *trainer.Trainer, from, to time.Time) ([]trainer.Interval, error) {
+	timeOff, err := appointments.trainers.ListTimeOff(ctx, trainerRecord.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	booked, err := appointments.bookings.ListActiveByTrainerID(ctx, trainerRecord.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	busy := make([]trainer.Interval, 0, len(timeOff)+len(booked))
+	for _, off := range timeOff {
+		busy = append(busy, trainer.Interval{Start: off.StartTime, End: off.EndTime})
+	}
+	for _, appointment := range booked {
+		busy = append(busy, trainer.Interval{Start: appointment.StartTime, End: appointment.EndTime})
+	}
+	return trainer.Without(trainerRecord.Available(from, to), busy), nil
+}
+
+func overlaps(bookingRecord, other *booking.Booking) bool {
+	return bookingRecord.StartTime.Before(other.EndTime) && bookingRecord.EndTime.After(other.StartTime)
+}
+
+func isActive(bookingRecord *booking.Booking) bool {
+	return bookingRecord.Status == booking.StatusPending || bookingRecord.Status == booking.StatusConfirmed
+}
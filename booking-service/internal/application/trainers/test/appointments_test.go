@@ -0,0 +1,221 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/application/trainers"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+	trainermocks "github.com/yourusername/fitbook/booking-service/internal/domain/trainer/test/mocks"
+)
+
+// request books userID at gymID from startHour to endHour tomorrow, with trainerID if set.
+type request struct {
+	userID    string
+	gymID     string
+	trainerID string
+	startHour int
+	endHour   int
+}
+
+func (r request) dto() *dtos.CreateBookingDTO {
+	return &dtos.CreateBookingDTO{
+		UserID:    r.userID,
+		GymID:     r.gymID,
+		StartTime: mocks.Tomorrow(r.startHour).Format(time.RFC3339),
+		EndTime:   mocks.Tomorrow(r.endHour).Format(time.RFC3339),
+		TrainerID: r.trainerID,
+	}
+}
+
+func TestBookAppointment(t *testing.T) {
+	tests := []struct {
+		name    string
+		booked  []request
+		request request
+		wantErr error
+	}{
+		{
+			name:    "free trainer",
+			booked:  []request{{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 10, endHour: 11}},
+			request: request{userID: "user2", gymID: "gym1", trainerID: "trainer1", startHour: 12, endHour: 13},
+		},
+		{
+			name:    "trainer booked at another gym",
+			booked:  []request{{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 10, endHour: 11}},
+			request: request{userID: "user2", gymID: "gym2", trainerID: "trainer1", startHour: 10, endHour: 12},
+			wantErr: trainer.ErrTrainerBooked,
+		},
+		{
+			name:    "unknown trainer",
+			request: request{userID: "user1", gymID: "gym1", trainerID: "trainer2", startHour: 10, endHour: 11},
+			wantErr: trainer.ErrTrainerNotFound,
+		},
+		{
+			name:    "before the trainer starts",
+			request: request{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 5, endHour: 7},
+			wantErr: trainer.ErrTrainerUnavailable,
+		},
+		{
+			name:    "after the trainer stops",
+			request: request{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 21, endHour: 23},
+			wantErr: trainer.ErrTrainerUnavailable,
+		},
+		{
+			name:    "appointment over the member's open booking",
+			booked:  []request{{userID: "user1", gymID: "gym2", startHour: 10, endHour: 11}},
+			request: request{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 10, endHour: 11},
+			wantErr: booking.ErrOverlappingBooking,
+		},
+		{
+			name:    "open booking over the member's appointment",
+			booked:  []request{{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 12, endHour: 13}},
+			request: request{userID: "user1", gymID: "gym3", startHour: 12, endHour: 14},
+			wantErr: booking.ErrOverlappingBooking,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookingRepo := mocks.NewMockRepository()
+			trainerRepo := trainermocks.NewMockRepository(trainermocks.NewTrainer("trainer1", 6*time.Hour, 22*time.Hour))
+			create := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), trainers.NewAppointments(trainerRepo, bookingRepo))
+
+			for _, booked := range tt.booked {
+				_, err := create.Handle(context.Background(), commands.CreateBookingCommand{DTO: booked.dto()})
+				require.NoError(t, err)
+			}
+			result, err := create.Handle(context.Background(), commands.CreateBookingCommand{DTO: tt.request.dto()})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.request.trainerID, result.Booking.TrainerID)
+		})
+	}
+}
+
+func TestSessionAndTrainerCannotBeCombined(t *testing.T) {
+	bookingRepo := mocks.NewMockRepository()
+	trainerRepo := trainermocks.NewMockRepository(trainermocks.NewTrainer("trainer1", 6*time.Hour, 22*time.Hour))
+	create := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), trainers.NewAppointments(trainerRepo, bookingRepo))
+
+	dto := request{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 10, endHour: 11}.dto()
+	dto.SessionID = "session1"
+	_, err := create.Handle(context.Background(), commands.CreateBookingCommand{DTO: dto})
+
+	var validationErr *validator.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "trainer_id", validationErr.Fields[0].Field)
+}
+
+func TestTimeOff(t *testing.T) {
+	ctx := context.Background()
+	bookingRepo := mocks.NewMockRepository()
+	trainerRepo := trainermocks.NewMockRepository(trainermocks.NewTrainer("trainer1", 6*time.Hour, 22*time.Hour))
+	appointments := trainers.NewAppointments(trainerRepo, bookingRepo)
+	create := commands.NewCreateBookingHandler(bookingRepo, mocks.NewMockEventPublisher(), mocks.NewLogger(), appointments)
+	addTimeOff := commands.NewAddTrainerTimeOffHandler(trainerRepo, bookingRepo, mocks.NewLogger())
+	availability := queries.NewGetTrainerAvailabilityHandler(trainerRepo, appointments)
+	format := func(hour int) string { return mocks.Tomorrow(hour).Format(time.RFC3339) }
+
+	tests := []struct {
+		name    string
+		call    func(t *testing.T) error
+		wantErr error
+	}{
+		{
+			name: "booking an appointment",
+			call: func(t *testing.T) error {
+				_, err := create.Handle(ctx, commands.CreateBookingCommand{DTO: request{userID: "user1", gymID: "gym1", trainerID: "trainer1", startHour: 10, endHour: 11}.dto()})
+				return err
+			},
+		},
+		{
+			name: "time off over the appointment",
+			call: func(t *testing.T) error {
+				_, err := addTimeOff.Handle(ctx, commands.AddTrainerTimeOffCommand{
+					TrainerID: "trainer1",
+					DTO:       &dtos.AddTimeOffDTO{StartTime: format(9), EndTime: format(12)},
+				})
+				return err
+			},
+			wantErr: trainer.ErrTrainerBooked,
+		},
+		{
+			name: "time off",
+			call: func(t *testing.T) error {
+				result, err := addTimeOff.Handle(ctx, commands.AddTrainerTimeOffCommand{
+					TrainerID: "trainer1",
+					DTO:       &dtos.AddTimeOffDTO{StartTime: format(14), EndTime: format(16), Reason: "Dentist"},
+				})
+				require.NoError(t, err)
+				assert.Equal(t, "Dentist", result.TimeOff.Reason)
+				return nil
+			},
+		},
+		{
+			name: "booking during the time off",
+			call: func(t *testing.T) error {
+				_, err := create.Handle(ctx, commands.CreateBookingCommand{DTO: request{userID: "user2", gymID: "gym1", trainerID: "trainer1", startHour: 15, endHour: 17}.dto()})
+				return err
+			},
+			wantErr: trainer.ErrTrainerUnavailable,
+		},
+		{
+			name: "availability",
+			call: func(t *testing.T) error {
+				result, err := availability.Handle(ctx, queries.GetTrainerAvailabilityQuery{DTO: &dtos.TrainerAvailabilityQueryDTO{
+					TrainerID: "trainer1",
+					From:      format(0),
+					To:        format(24),
+				}})
+				require.NoError(t, err)
+
+				slots := make([][2]string, len(result.Availability.Slots))
+				for i, slot := range result.Availability.Slots {
+					slots[i] = [2]string{slot.StartTime, slot.EndTime}
+				}
+				assert.Equal(t, [][2]string{
+					{format(6), format(10)},
+					{format(11), format(14)},
+					{format(16), format(22)},
+				}, slots)
+				return nil
+			},
+		},
+		{
+			name: "availability over more than 31 days",
+			call: func(t *testing.T) error {
+				_, err := availability.Handle(ctx, queries.GetTrainerAvailabilityQuery{DTO: &dtos.TrainerAvailabilityQueryDTO{
+					TrainerID: "trainer1",
+					From:      format(0),
+					To:        format(24 * 32),
+				}})
+				return err
+			},
+			wantErr: booking.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(t)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// Field error codes returned in dtos.ValidationErrorDTO.Code.
+const (
+	CodeRequired        = "REQUIRED"
+	CodeInvalidDatetime = "INVALID_DATETIME"
+	CodeInvalidChoice   = "INVALID_CHOICE"
+	CodeInvalidUUID     = "INVALID_UUID"
+	CodeTooLong         = "TOO_LONG"
+	CodeTooShort        = "TOO_SHORT"
+)
+
+// ValidationError lists every field that failed validation. It unwraps to
+// booking.ErrInvalidInput so callers that only care whether input was invalid keep working.
+type ValidationError struct {
+	Fields []*dtos.ValidationErrorDTO
+}
+
+func NewValidationError(fields ...*dtos.ValidationErrorDTO) *ValidationError {
+	return &ValidationError{Fields: fields}
+}
+
+func (err *ValidationError) Error() string {
+	messages := make([]string, len(err.Fields))
+	for i, field := range err.Fields {
+		messages[i] = field.Field + " " + field.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (err *ValidationError) Unwrap() error {
+	return booking.ErrInvalidInput
+}
+
+// RuleFunc reports whether value satisfies the rule. param is the text after "=" in the tag.
+type RuleFunc func(value reflect.Value, param string) bool
+
+type rule struct {
+	check   RuleFunc
+	code    string
+	message func(value reflect.Value, param string) string
+}
+
+var (
+	rulesMu sync.RWMutex
+	rules   = map[string]rule{
+		"datetime": {
+			check: checkDatetime,
+			code:  CodeInvalidDatetime,
+			message: func(_ reflect.Value, param string) string {
+				if param == time.RFC3339 {
+					return "must be an RFC3339 date-time"
+				}
+				return "must be a date-time formatted as " + param
+			},
+		},
+		"oneof": {
+			check: checkOneOf,
+			code:  CodeInvalidChoice,
+			message: func(_ reflect.Value, param string) string {
+				return "must be one of " + strings.Join(strings.Fields(param), ", ")
+			},
+		},
+		"uuid": {
+			check:   checkUUID,
+			code:    CodeInvalidUUID,
+			message: func(reflect.Value, string) string { return "must be a UUID" },
+		},
+		"max": {
+			check:   checkMax,
+			code:    CodeTooLong,
+			message: func(value reflect.Value, param string) string { return "must be at most " + param + lengthUnit(value) },
+		},
+		"min": {
+			check:   checkMin,
+			code:    CodeTooShort,
+			message: func(value reflect.Value, param string) string { return "must be at least " + param + lengthUnit(value) },
+		},
+	}
+)
+
+// RegisterRule makes name usable in `validate` tags. Failing fields are reported with code
+// and message.
+func RegisterRule(name, code, message string, check RuleFunc) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+	rules[name] = rule{
+		check:   check,
+		code:    code,
+		message: func(reflect.Value, string) string { return message },
+	}
+}
+
+// lookupRule copies a rule out of the registry so that it runs without holding rulesMu;
+// a registered RuleFunc may itself call RegisterRule or Struct.
+func lookupRule(name string) rule {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+	return rules[name]
+}
+
+// Struct validates value, a struct or pointer to one, against its `validate` tags and
+// returns a *ValidationError describing every failing field, or nil. Nested structs and
+// slices of structs are validated too, with their fields reported as parent.child or
+// parent[i].child. Fields are named after their json tag. The tags of each struct type are
+// checked the first time it is seen; a tag naming an unknown rule makes Struct return an
+// error for that type instead of validating it, so rules must be registered first.
+func Struct(value interface{}) error {
+	var fields []*dtos.ValidationErrorDTO
+	if err := validateStruct(reflect.ValueOf(value), "", &fields); err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return NewValidationError(fields...)
+}
+
+// CheckTag reports the first rule of a `validate` tag that is not registered or has an
+// unusable parameter.
+func CheckTag(tag string) error {
+	if tag == "-" {
+		return nil
+	}
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+	for _, definition := range strings.Split(tag, ",") {
+		ruleName, param, _ := strings.Cut(definition, "=")
+		if ruleName == "required" || ruleName == "" {
+			continue
+		}
+		if _, exists := rules[ruleName]; !exists {
+			return fmt.Errorf("unknown rule %q", ruleName)
+		}
+		if ruleName == "min" || ruleName == "max" {
+			if _, err := strconv.Atoi(param); err != nil {
+				return fmt.Errorf("rule %q needs an integer parameter, not %q", ruleName, param)
+			}
+		}
+	}
+	return nil
+}
+
+// checkedTypes caches the outcome of checking each struct type's tags.
+var checkedTypes sync.Map
+
+func checkType(structType reflect.Type) error {
+	if checked, ok := checkedTypes.Load(structType); ok {
+		err, _ := checked.(error)
+		return err
+	}
+
+	var err error
+	for i := 0; i < structType.NumField() && err == nil; i++ {
+		fieldType := structType.Field(i)
+		if tag, exists := fieldType.Tag.Lookup("validate"); exists && fieldType.IsExported() {
+			if tagErr := CheckTag(tag); tagErr != nil {
+				err = fmt.Errorf("validator: field %s of %s: %w", fieldType.Name, structType, tagErr)
+			}
+		}
+	}
+	checkedTypes.Store(structType, err)
+	return err
+}
+
+func validateStruct(value reflect.Value, prefix string, fields *[]*dtos.ValidationErrorDTO) error {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	structType := value.Type()
+	if err := checkType(structType); err != nil {
+		return err
+	}
+	for i := 0; i < structType.NumField(); i++ {
+		fieldType := structType.Field(i)
+		if !fieldType.IsExported() {
+			continue
+		}
+		fieldValue := value.Field(i)
+		name := joinFieldName(prefix, fieldName(fieldType))
+
+		if tag, exists := fieldType.Tag.Lookup("validate"); exists && tag != "-" {
+			if fieldError := validateField(fieldValue, name, tag); fieldError != nil {
+				*fields = append(*fields, fieldError)
+				continue
+			}
+		}
+
+		if err := validateNested(fieldValue, name, fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNested(value reflect.Value, name string, fields *[]*dtos.ValidationErrorDTO) error {
+	switch value.Kind() {
+	case reflect.Struct, reflect.Pointer:
+		return validateStruct(value, name, fields)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := validateStruct(value.Index(i), fmt.Sprintf("%s[%d]", name, i), fields); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateField applies the rules of a tag, already checked by checkType, in order and
+// reports the first one that fails. Empty fields that are not required pass, except for
+// numbers, so that min=1 rejects 0.
+func validateField(value reflect.Value, name, tag string) *dtos.ValidationErrorDTO {
+	definitions := strings.Split(tag, ",")
+
+	if isEmpty(value) {
+		for _, definition := range definitions {
+			if definition == "required" {
+				return dtos.NewValidationErrorDTO(name, "is required", CodeRequired)
+			}
+		}
+		if !isNumber(value) {
+			return nil
+		}
+	}
+
+	for _, definition := range definitions {
+		ruleName, param, _ := strings.Cut(definition, "=")
+		if ruleName == "required" || ruleName == "" {
+			continue
+		}
+		definedRule := lookupRule(ruleName)
+		if !definedRule.check(value, param) {
+			return dtos.NewValidationErrorDTO(name, definedRule.message(value, param), definedRule.code)
+		}
+	}
+	return nil
+}
+
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(value.String()) == ""
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return value.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	default:
+		return value.IsZero()
+	}
+}
+
+func isNumber(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+func fieldName(field reflect.StructField) string {
+	if tag := field.Tag.Get("json"); tag != "" {
+		if name, _, _ := strings.Cut(tag, ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+func joinFieldName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func checkDatetime(value reflect.Value, layout string) bool {
+	if value.Kind() != reflect.String {
+		return false
+	}
+	_, err := time.Parse(layout, value.String())
+	return err == nil
+}
+
+func checkOneOf(value reflect.Value, param string) bool {
+	actual := fmt.Sprint(value.Interface())
+	for _, option := range strings.Fields(param) {
+		if option == actual {
+			return true
+		}
+	}
+	return false
+}
+
+func checkUUID(value reflect.Value, _ string) bool {
+	if value.Kind() != reflect.String {
+		return false
+	}
+	_, err := uuid.Parse(value.String())
+	return err == nil
+}
+
+func checkMax(value reflect.Value, param string) bool {
+	limit, err := strconv.Atoi(param)
+	if err != nil {
+		return false
+	}
+	return length(value) <= limit
+}
+
+func checkMin(value reflect.Value, param string) bool {
+	limit, err := strconv.Atoi(param)
+	if err != nil {
+		return false
+	}
+	return length(value) >= limit
+}
+
+func length(value reflect.Value) int {
+	switch value.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(value.String())
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return value.Len()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(value.Uint())
+	default:
+		return 0
+	}
+}
+
+func lengthUnit(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.String:
+		return " characters long"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return " items long"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,144 @@
+package test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+type nestedRequest struct {
+	Name string `json:"name" validate:"required,max=5"`
+}
+
+type testRequest struct {
+	ID       string           `json:"id" validate:"required,uuid"`
+	Status   string           `json:"status" validate:"oneof=PENDING CONFIRMED"`
+	Starts   string           `json:"starts" validate:"datetime=2006-01-02T15:04:05Z07:00"`
+	Code     string           `json:"code" validate:"slug"`
+	Optional string           `json:"optional" validate:"uuid"`
+	Items    []*nestedRequest `json:"items" validate:"required"`
+}
+
+func TestStruct(t *testing.T) {
+	validator.RegisterRule("slug", "INVALID_SLUG", "must be lowercase", func(value reflect.Value, _ string) bool {
+		return value.String() == strings.ToLower(value.String())
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		err := validator.Struct(&testRequest{
+			ID:     "3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+			Status: "PENDING",
+			Starts: "2030-01-01T10:00:00Z",
+			Code:   "abc",
+			Items:  []*nestedRequest{{Name: "a"}},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("reports every failing field", func(t *testing.T) {
+		err := validator.Struct(&testRequest{
+			ID:     "not-a-uuid",
+			Status: "DONE",
+			Starts: "tomorrow",
+			Code:   "ABC",
+			Items:  []*nestedRequest{{Name: "a"}, {Name: "toolong"}, {}},
+		})
+
+		var validationErr *validator.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.ErrorIs(t, err, booking.ErrInvalidInput)
+		assert.Equal(t, []*dtos.ValidationErrorDTO{
+			dtos.NewValidationErrorDTO("id", "must be a UUID", validator.CodeInvalidUUID),
+			dtos.NewValidationErrorDTO("status", "must be one of PENDING, CONFIRMED", validator.CodeInvalidChoice),
+			dtos.NewValidationErrorDTO("starts", "must be an RFC3339 date-time", validator.CodeInvalidDatetime),
+			dtos.NewValidationErrorDTO("code", "must be lowercase", "INVALID_SLUG"),
+			dtos.NewValidationErrorDTO("items[1].name", "must be at most 5 characters long", validator.CodeTooLong),
+			dtos.NewValidationErrorDTO("items[2].name", "is required", validator.CodeRequired),
+		}, validationErr.Fields)
+	})
+
+	t.Run("required fields", func(t *testing.T) {
+		err := validator.Struct(&testRequest{})
+
+		var validationErr *validator.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []*dtos.ValidationErrorDTO{
+			dtos.NewValidationErrorDTO("id", "is required", validator.CodeRequired),
+			dtos.NewValidationErrorDTO("items", "is required", validator.CodeRequired),
+		}, validationErr.Fields)
+	})
+
+	t.Run("create booking DTO", func(t *testing.T) {
+		err := validator.ValidateCreateBookingDTO(&dtos.CreateBookingDTO{
+			GymID:     strings.Repeat("g", 37),
+			StartTime: "2030-01-01 10:00",
+			EndTime:   "2030-01-01T11:00:00Z",
+		})
+
+		var validationErr *validator.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []*dtos.ValidationErrorDTO{
+			dtos.NewValidationErrorDTO("user_id", "is required", validator.CodeRequired),
+			dtos.NewValidationErrorDTO("gym_id", "must be at most 36 characters long", validator.CodeTooLong),
+			dtos.NewValidationErrorDTO("start_time", "must be an RFC3339 date-time", validator.CodeInvalidDatetime),
+		}, validationErr.Fields)
+	})
+
+	t.Run("zero numbers are checked against their bounds", func(t *testing.T) {
+		err := validator.Struct(&dtos.SetResourceDTO{Name: "Court 1", Type: "court"})
+
+		var validationErr *validator.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []*dtos.ValidationErrorDTO{
+			dtos.NewValidationErrorDTO("capacity", "must be at least 1", validator.CodeTooShort),
+		}, validationErr.Fields)
+
+		err = validator.Struct(&dtos.SetTimetableTemplateDTO{})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Fields, dtos.NewValidationErrorDTO("duration_minutes", "must be at least 1", validator.CodeTooShort))
+		assert.Contains(t, validationErr.Fields, dtos.NewValidationErrorDTO("capacity", "must be at least 1", validator.CodeTooShort))
+
+		err = validator.Struct(&dtos.CreateClassSessionDTO{})
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Fields, dtos.NewValidationErrorDTO("capacity", "must be at least 1", validator.CodeTooShort))
+
+		assert.NoError(t, validator.Struct(&dtos.SetGymPolicyDTO{}), "min=0 accepts 0")
+	})
+
+	t.Run("rules may register rules", func(t *testing.T) {
+		type lazyRequest struct {
+			Name string `json:"name" validate:"lazy"`
+		}
+		validator.RegisterRule("lazy", "INVALID_LAZY", "is invalid", func(reflect.Value, string) bool {
+			validator.RegisterRule("lazy_inner", "INVALID_LAZY", "is invalid", func(reflect.Value, string) bool { return true })
+			return true
+		})
+
+		done := make(chan error, 1)
+		go func() { done <- validator.Struct(&lazyRequest{Name: "a"}) }()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("a rule calling RegisterRule deadlocked")
+		}
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		type badRequest struct {
+			Name string `json:"name" validate:"omitempty,max=5"`
+		}
+
+		err := validator.Struct(&badRequest{Name: "a"})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, booking.ErrInvalidInput, "a broken tag is a bug, not invalid input")
+		assert.ErrorContains(t, err, `unknown rule "omitempty"`)
+	})
+}
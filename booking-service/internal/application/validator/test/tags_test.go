@@ -0,0 +1,45 @@
+package test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+)
+
+// TestDTOTags checks the `validate` tag of every struct field in the dtos package, so that
+// a misspelt or unsupported rule fails here instead of on the first request using it.
+func TestDTOTags(t *testing.T) {
+	packages, err := parser.ParseDir(token.NewFileSet(), "../../dtos", nil, 0)
+	require.NoError(t, err)
+	require.Contains(t, packages, "dtos")
+
+	checked := 0
+	ast.Inspect(packages["dtos"], func(node ast.Node) bool {
+		structType, ok := node.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		for _, field := range structType.Fields.List {
+			if field.Tag == nil {
+				continue
+			}
+			tag, err := strconv.Unquote(field.Tag.Value)
+			require.NoError(t, err)
+			rules, exists := reflect.StructTag(tag).Lookup("validate")
+			if !exists {
+				continue
+			}
+			checked++
+			assert.NoError(t, validator.CheckTag(rules), "tag %s", field.Tag.Value)
+		}
+		return true
+	})
+	assert.NotZero(t, checked)
+}
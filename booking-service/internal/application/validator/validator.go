@@ -19,7 +19,7 @@ func ValidateTimeRange(startTime, endTime time.Time) error {
 
 func ValidateRequiredString(value, fieldName string) error {
 	if value == "" {
-		return booking.ErrInvalidInput
+		return NewValidationError(dtos.NewValidationErrorDTO(fieldName, "is required", CodeRequired))
 	}
 	return nil
 }
@@ -36,13 +36,36 @@ func ValidateGymID(gymID string) error {
 	return ValidateRequiredString(gymID, "gym_id")
 }
 
+func ValidateSessionID(sessionID string) error {
+	return ValidateRequiredString(sessionID, "session_id")
+}
+
+func ValidateTemplateID(templateID string) error {
+	return ValidateRequiredString(templateID, "template_id")
+}
+
+func ValidateTrainerID(trainerID string) error {
+	return ValidateRequiredString(trainerID, "trainer_id")
+}
+
+func ValidateResourceID(resourceID string) error {
+	return ValidateRequiredString(resourceID, "resource_id")
+}
+
+func ValidateClosureID(closureID string) error {
+	return ValidateRequiredString(closureID, "closure_id")
+}
+
 func ValidateCreateBookingDTO(dto *dtos.CreateBookingDTO) error {
-	if err := ValidateUserID(dto.UserID); err != nil {
-		return err
+	if dto == nil {
+		return booking.ErrInvalidInput
 	}
-	if err := ValidateGymID(dto.GymID); err != nil {
+	if err := Struct(dto); err != nil {
 		return err
 	}
+	if dto.SessionID != "" && dto.TrainerID != "" {
+		return NewValidationError(dtos.NewValidationErrorDTO("trainer_id", "cannot be combined with session_id", CodeInvalidChoice))
+	}
 
 	startTime, err := time.Parse(time.RFC3339, dto.StartTime)
 	if err != nil {
@@ -1,6 +1,7 @@
 package booking
 
 import (
+	"strings"
 	"time"
 )
 
@@ -11,8 +12,23 @@ type Booking struct {
 	StartTime time.Time
 	EndTime   time.Time
 	Status    BookingStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	// StatusReason explains why the booking entered its current status, when recorded.
+	StatusReason string
+	// CancelledLate marks a booking its member cancelled after the gym's cancellation
+	// cutoff.
+	CancelledLate bool
+	// SessionID is the class session the booking enrolls its member in, if any.
+	SessionID string
+	// TrainerID is the personal trainer the booking is a 1:1 appointment with, if any.
+	TrainerID string
+	// ResourceID is the court, room or other resource inside the gym the booking holds, if
+	// any.
+	ResourceID string
+	// FlagReason marks a booking staff need to look at, e.g. because its resource went out
+	// of service. It is empty for bookings that are not flagged.
+	FlagReason string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 func NewBooking(userID, gymID string, startTime, endTime time.Time) (*Booking, error) {
@@ -40,23 +56,33 @@ func NewBooking(userID, gymID string, startTime, endTime time.Time) (*Booking, e
 }
 
 func (booking *Booking) Cancel() error {
+	return booking.CancelWithReason("")
+}
+
+// CancelWithReason cancels the booking and records why, e.g. when staff cancel it on the
+// member's behalf.
+func (booking *Booking) CancelWithReason(reason string) error {
 	if booking.Status == StatusCancelled {
 		return ErrBookingAlreadyCancelled
 	}
-	if booking.Status == StatusCompleted {
+	if booking.Status == StatusCompleted || booking.Status == StatusNoShow {
 		return ErrInvalidStatusTransition
 	}
-	booking.Status = StatusCancelled
-	booking.UpdatedAt = time.Now()
+	booking.setStatus(StatusCancelled, reason)
 	return nil
 }
 
+// IsLateCancellation reports whether cancelling at now falls within cutoff of the booking's
+// start. A zero cutoff never makes a cancellation late.
+func (booking *Booking) IsLateCancellation(now time.Time, cutoff time.Duration) bool {
+	return cutoff > 0 && booking.StartTime.Sub(now) < cutoff
+}
+
 func (booking *Booking) Confirm() error {
 	if booking.Status != StatusPending {
 		return ErrInvalidStatusTransition
 	}
-	booking.Status = StatusConfirmed
-	booking.UpdatedAt = time.Now()
+	booking.setStatus(StatusConfirmed, "")
 	return nil
 }
 
@@ -64,13 +90,93 @@ func (booking *Booking) Complete() error {
 	if booking.Status != StatusConfirmed {
 		return ErrInvalidStatusTransition
 	}
-	booking.Status = StatusCompleted
-	booking.UpdatedAt = time.Now()
+	booking.setStatus(StatusCompleted, "")
+	return nil
+}
+
+// MarkNoShow records that the member did not turn up for a confirmed booking that has
+// started by now.
+func (booking *Booking) MarkNoShow(now time.Time) error {
+	if booking.Status != StatusConfirmed {
+		return ErrInvalidStatusTransition
+	}
+	if now.Before(booking.StartTime) {
+		return ErrBookingNotStarted
+	}
+	booking.setStatus(StatusNoShow, "")
+	return nil
+}
+
+// ForceStatus moves the booking to status regardless of the usual transitions, to repair
+// bookings by hand. The reason is mandatory and is kept in the booking's history.
+func (booking *Booking) ForceStatus(status BookingStatus, reason string) error {
+	if !status.IsValid() {
+		return ErrInvalidStatus
+	}
+	if strings.TrimSpace(reason) == "" {
+		return ErrStatusReasonRequired
+	}
+	if status == booking.Status {
+		return ErrInvalidStatusTransition
+	}
+	booking.setStatus(status, reason)
 	return nil
 }
 
+// Reschedule moves a pending or confirmed booking that has not started by now to new
+// times, keeping its status.
+func (booking *Booking) Reschedule(startTime, endTime, now time.Time) error {
+	if booking.Status != StatusPending && booking.Status != StatusConfirmed {
+		return ErrInvalidStatusTransition
+	}
+	if booking.StartTime.Before(now) || startTime.Before(now) {
+		return ErrPastBooking
+	}
+	if !startTime.Before(endTime) {
+		return ErrInvalidTimeRange
+	}
+	booking.StartTime = startTime
+	booking.EndTime = endTime
+	booking.UpdatedAt = now
+	return nil
+}
+
+// Flag marks the booking for staff attention, without changing its status.
+func (booking *Booking) Flag(reason string) {
+	booking.FlagReason = reason
+	booking.UpdatedAt = time.Now()
+}
+
+// Unflag clears a flag recorded with reason, and reports whether it did.
+func (booking *Booking) Unflag(reason string) bool {
+	if booking.FlagReason == "" || booking.FlagReason != reason {
+		return false
+	}
+	booking.FlagReason = ""
+	booking.UpdatedAt = time.Now()
+	return true
+}
+
+func (booking *Booking) setStatus(status BookingStatus, reason string) {
+	booking.Status = status
+	booking.StatusReason = reason
+	booking.UpdatedAt = time.Now()
+}
+
 func (booking *Booking) OverlapsWith(other *Booking) bool {
-	return booking.GymID == other.GymID &&
-		booking.StartTime.Before(other.EndTime) &&
-		booking.EndTime.After(other.StartTime)
+	return booking.OverlapsWithin(other, Buffer{})
+}
+
+// OverlapsWithin is OverlapsWith for a gym that keeps buffer free around every booking:
+// the bookings also conflict when they are less than the buffer's gap apart.
+func (booking *Booking) OverlapsWithin(other *Booking, buffer Buffer) bool {
+	blockedStart, blockedEnd := buffer.Blocks(other)
+	// Enrollments count against their class session's capacity instead, appointments
+	// against their trainer's calendar, and resource bookings against their resource.
+	return booking.SessionID == "" && other.SessionID == "" &&
+		booking.TrainerID == "" && other.TrainerID == "" &&
+		booking.ResourceID == "" && other.ResourceID == "" &&
+		booking.GymID == other.GymID &&
+		booking.StartTime.Before(blockedEnd) &&
+		booking.EndTime.After(blockedStart)
 }
@@ -0,0 +1,24 @@
+package booking
+
+import "time"
+
+// Buffer is changeover time kept free around bookings, e.g. for cleaning a squash court
+// between players. It widens the time over which bookings conflict without changing the
+// times members booked.
+type Buffer struct {
+	// Before is kept free ahead of each booking's start, After past its end.
+	Before time.Duration
+	After  time.Duration
+}
+
+// Gap is how far apart two bookings must be: the earlier one's After plus the later one's
+// Before.
+func (buffer Buffer) Gap() time.Duration {
+	return buffer.Before + buffer.After
+}
+
+// Blocks returns the stretch no other booking may overlap with bookingRecord, given that
+// both keep the buffer free around them.
+func (buffer Buffer) Blocks(bookingRecord *Booking) (time.Time, time.Time) {
+	return bookingRecord.StartTime.Add(-buffer.Gap()), bookingRecord.EndTime.Add(buffer.Gap())
+}
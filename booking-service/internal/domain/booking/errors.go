@@ -10,4 +10,7 @@ var (
 	ErrInvalidTimeRange        = errors.New("invalid time range")
 	ErrPastBooking             = errors.New("cannot book in the past")
 	ErrInvalidInput            = errors.New("invalid input")
+	ErrInvalidStatus           = errors.New("invalid booking status")
+	ErrStatusReasonRequired    = errors.New("a reason is required to force a status change")
+	ErrBookingNotStarted       = errors.New("booking has not started yet")
 )
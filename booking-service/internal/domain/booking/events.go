@@ -1,14 +1,19 @@
 package booking
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type Event interface {
 	EventName() string
 	OccurredAt() time.Time
 }
 
+// EventPublisher delivers events to other services. ctx carries the trace of the operation
+// that produced the event so consumers can continue it.
 type EventPublisher interface {
-	Publish(event Event) error
+	Publish(ctx context.Context, event Event) error
 }
 
 type BaseBookingEvent struct {
@@ -35,6 +40,8 @@ func (event BookingCreatedEvent) EventName() string {
 
 type BookingCancelledEvent struct {
 	BaseBookingEvent
+	// Late is set when the member cancelled after the gym's cancellation cutoff.
+	Late bool
 }
 
 func (event BookingCancelledEvent) EventName() string {
@@ -57,27 +64,80 @@ func (event BookingCompletedEvent) EventName() string {
 	return "booking.completed"
 }
 
-func NewBookingEvent(booking *Booking, eventType string) Event {
-	baseEvent := BaseBookingEvent{
-		BookingID:      booking.ID,
-		UserID:         booking.UserID,
-		GymID:          booking.GymID,
-		StartTime:      booking.StartTime,
-		EndTime:        booking.EndTime,
-		Status:         booking.Status,
-		OccurredAtTime: time.Now(),
+type BookingNoShowEvent struct {
+	BaseBookingEvent
+}
+
+func (event BookingNoShowEvent) EventName() string {
+	return "booking.no_show"
+}
+
+// BookingRescheduledEvent reports a booking moved to new times, which it carries, from
+// PreviousStartTime and PreviousEndTime.
+type BookingRescheduledEvent struct {
+	BaseBookingEvent
+	PreviousStartTime time.Time
+	PreviousEndTime   time.Time
+}
+
+func (event BookingRescheduledEvent) EventName() string {
+	return "booking.rescheduled"
+}
+
+func NewBookingRescheduledEvent(booking *Booking, previousStartTime, previousEndTime time.Time) BookingRescheduledEvent {
+	return BookingRescheduledEvent{
+		BaseBookingEvent:  newBaseBookingEvent(booking),
+		PreviousStartTime: previousStartTime,
+		PreviousEndTime:   previousEndTime,
 	}
+}
+
+// BookingStatusChangedEvent reports a status change forced outside the usual transitions.
+type BookingStatusChangedEvent struct {
+	BaseBookingEvent
+	PreviousStatus BookingStatus
+	Reason         string
+}
+
+func (event BookingStatusChangedEvent) EventName() string {
+	return "booking.status_changed"
+}
+
+func NewBookingStatusChangedEvent(booking *Booking, previousStatus BookingStatus) BookingStatusChangedEvent {
+	return BookingStatusChangedEvent{
+		BaseBookingEvent: newBaseBookingEvent(booking),
+		PreviousStatus:   previousStatus,
+		Reason:           booking.StatusReason,
+	}
+}
+
+func NewBookingEvent(booking *Booking, eventType string) Event {
+	baseEvent := newBaseBookingEvent(booking)
 
 	switch eventType {
 	case "created":
 		return BookingCreatedEvent{BaseBookingEvent: baseEvent}
 	case "cancelled":
-		return BookingCancelledEvent{BaseBookingEvent: baseEvent}
+		return BookingCancelledEvent{BaseBookingEvent: baseEvent, Late: booking.CancelledLate}
 	case "confirmed":
 		return BookingConfirmedEvent{BaseBookingEvent: baseEvent}
 	case "completed":
 		return BookingCompletedEvent{BaseBookingEvent: baseEvent}
+	case "no_show":
+		return BookingNoShowEvent{BaseBookingEvent: baseEvent}
 	default:
 		return nil
 	}
 }
+
+func newBaseBookingEvent(booking *Booking) BaseBookingEvent {
+	return BaseBookingEvent{
+		BookingID:      booking.ID,
+		UserID:         booking.UserID,
+		GymID:          booking.GymID,
+		StartTime:      booking.StartTime,
+		EndTime:        booking.EndTime,
+		Status:         booking.Status,
+		OccurredAtTime: time.Now(),
+	}
+}
@@ -0,0 +1,13 @@
+package booking
+
+import "time"
+
+// StatusChange is one entry of a booking's history: the booking entered ToStatus at
+// ChangedAt. FromStatus is empty for the entry recorded when the booking was created.
+type StatusChange struct {
+	BookingID  string
+	FromStatus BookingStatus
+	ToStatus   BookingStatus
+	Reason     string
+	ChangedAt  time.Time
+}
@@ -6,10 +6,37 @@ import (
 )
 
 type Repository interface {
+	// Create refuses an enrollment in a class session, or a booking of a resource, with no
+	// place left for it, checked under a lock on the session or resource so that concurrent
+	// bookings cannot overfill it.
 	Create(ctx context.Context, booking *Booking) error
+	// CreateMany stores all bookings atomically: either every booking is written or none is.
+	// Bookings without a place are refused as in Create.
+	CreateMany(ctx context.Context, bookings []*Booking) error
 	GetByID(ctx context.Context, id string) (*Booking, error)
+	// Update refuses new times for a resource booking as Create would refuse the booking.
 	Update(ctx context.Context, booking *Booking) error
 	DeleteByID(ctx context.Context, id string) error
 	ListByUserID(ctx context.Context, userID string, startTime, endTime time.Time) ([]*Booking, error)
+	// CountActiveByUserID counts the user's pending and confirmed bookings starting after
+	// the given time.
+	CountActiveByUserID(ctx context.Context, userID string, after time.Time) (int, error)
+	// ListActiveByUserID returns the user's pending and confirmed bookings at any gym that
+	// overlap [startTime, endTime), earliest first.
+	ListActiveByUserID(ctx context.Context, userID string, startTime, endTime time.Time) ([]*Booking, error)
 	ListByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*Booking, error)
+	// ListActiveByGymID returns the gym's pending and confirmed bookings that overlap
+	// [startTime, endTime), earliest first.
+	ListActiveByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*Booking, error)
+	// ListBySessionID returns every booking enrolling a member in the class session,
+	// whatever its status, oldest first.
+	ListBySessionID(ctx context.Context, sessionID string) ([]*Booking, error)
+	// ListActiveByTrainerID returns the trainer's pending and confirmed appointments at any
+	// gym that overlap [startTime, endTime), earliest first.
+	ListActiveByTrainerID(ctx context.Context, trainerID string, startTime, endTime time.Time) ([]*Booking, error)
+	// ListActiveByResourceID returns the pending and confirmed bookings of the resource that
+	// overlap [startTime, endTime), earliest first. A zero endTime leaves the range open.
+	ListActiveByResourceID(ctx context.Context, resourceID string, startTime, endTime time.Time) ([]*Booking, error)
+	// ListStatusChanges returns the booking's history, oldest first.
+	ListStatusChanges(ctx context.Context, bookingID string) ([]*StatusChange, error)
 }
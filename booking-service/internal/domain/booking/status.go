@@ -7,11 +7,13 @@ const (
 	StatusConfirmed BookingStatus = "CONFIRMED"
 	StatusCancelled BookingStatus = "CANCELLED"
 	StatusCompleted BookingStatus = "COMPLETED"
+	// StatusNoShow marks a confirmed booking its member did not turn up for.
+	StatusNoShow BookingStatus = "NO_SHOW"
 )
 
 func (status BookingStatus) IsValid() bool {
 	switch status {
-	case StatusPending, StatusConfirmed, StatusCancelled, StatusCompleted:
+	case StatusPending, StatusConfirmed, StatusCancelled, StatusCompleted, StatusNoShow:
 		return true
 	default:
 		return false
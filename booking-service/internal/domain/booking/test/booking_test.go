@@ -102,6 +102,60 @@ func TestBookingStatusTransitions(t *testing.T) {
 	})
 }
 
+func TestBookingForceStatus(t *testing.T) {
+	now := time.Now()
+	testBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, testBooking.ForceStatus(booking.StatusCompleted, " "), booking.ErrStatusReasonRequired)
+	assert.ErrorIs(t, testBooking.ForceStatus("ARCHIVED", "cleanup"), booking.ErrInvalidStatus)
+	assert.ErrorIs(t, testBooking.ForceStatus(booking.StatusPending, "no-op"), booking.ErrInvalidStatusTransition)
+	assert.Equal(t, booking.StatusPending, testBooking.Status)
+
+	// Pending -> Completed skips confirmation, which only ForceStatus allows
+	assert.NoError(t, testBooking.ForceStatus(booking.StatusCompleted, "attended, confirmation was lost"))
+	assert.Equal(t, booking.StatusCompleted, testBooking.Status)
+	assert.Equal(t, "attended, confirmation was lost", testBooking.StatusReason)
+
+	// Completed -> Cancelled is otherwise invalid
+	assert.NoError(t, testBooking.ForceStatus(booking.StatusCancelled, "member was charged by mistake"))
+	assert.Equal(t, booking.StatusCancelled, testBooking.Status)
+}
+
+func TestBookingCancelWithReason(t *testing.T) {
+	now := time.Now()
+	testBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+	assert.NoError(t, err)
+
+	assert.NoError(t, testBooking.CancelWithReason("gym closed for maintenance"))
+	assert.Equal(t, booking.StatusCancelled, testBooking.Status)
+	assert.Equal(t, "gym closed for maintenance", testBooking.StatusReason)
+}
+
+func TestBookingMarkNoShow(t *testing.T) {
+	now := time.Now()
+	testBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, testBooking.MarkNoShow(now.Add(90*time.Minute)), booking.ErrInvalidStatusTransition, "only confirmed bookings")
+	assert.NoError(t, testBooking.Confirm())
+	assert.ErrorIs(t, testBooking.MarkNoShow(now), booking.ErrBookingNotStarted)
+	assert.NoError(t, testBooking.MarkNoShow(now.Add(90*time.Minute)))
+	assert.Equal(t, booking.StatusNoShow, testBooking.Status)
+	assert.ErrorIs(t, testBooking.Cancel(), booking.ErrInvalidStatusTransition)
+}
+
+func TestBookingIsLateCancellation(t *testing.T) {
+	now := time.Now()
+	testBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+	assert.NoError(t, err)
+
+	assert.False(t, testBooking.IsLateCancellation(now, 0), "no cutoff")
+	assert.False(t, testBooking.IsLateCancellation(now, 30*time.Minute))
+	assert.True(t, testBooking.IsLateCancellation(now, 2*time.Hour))
+	assert.True(t, testBooking.IsLateCancellation(now.Add(3*time.Hour), 30*time.Minute), "after the start")
+}
+
 func TestBookingOverlap(t *testing.T) {
 	now := time.Now()
 	baseBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
@@ -153,3 +207,52 @@ func TestBookingOverlap(t *testing.T) {
 		})
 	}
 }
+
+func TestBookingOverlapWithinBuffer(t *testing.T) {
+	now := time.Now()
+	baseBooking, err := booking.NewBooking("user1", "gym1", now.Add(time.Hour), now.Add(2*time.Hour))
+	assert.NoError(t, err)
+	buffer := booking.Buffer{Before: 5 * time.Minute, After: 10 * time.Minute}
+
+	tests := []struct {
+		name        string
+		startTime   time.Time
+		endTime     time.Time
+		wantOverlap bool
+	}{
+		{
+			name:        "after the buffers",
+			startTime:   now.Add(2*time.Hour + 15*time.Minute),
+			endTime:     now.Add(3 * time.Hour),
+			wantOverlap: false,
+		},
+		{
+			name:        "within the buffers after",
+			startTime:   now.Add(2*time.Hour + 14*time.Minute),
+			endTime:     now.Add(3 * time.Hour),
+			wantOverlap: true,
+		},
+		{
+			name:        "before the buffers",
+			startTime:   now.Add(15 * time.Minute),
+			endTime:     now.Add(45 * time.Minute),
+			wantOverlap: false,
+		},
+		{
+			name:        "within the buffers before",
+			startTime:   now.Add(15 * time.Minute),
+			endTime:     now.Add(50 * time.Minute),
+			wantOverlap: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			comparisonBooking, err := booking.NewBooking("user2", "gym1", test.startTime, test.endTime)
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantOverlap, baseBooking.OverlapsWithin(comparisonBooking, buffer))
+			assert.Equal(t, test.wantOverlap, comparisonBooking.OverlapsWithin(baseBooking, buffer))
+			assert.False(t, baseBooking.OverlapsWith(comparisonBooking), "without buffers the bookings are apart")
+		})
+	}
+}
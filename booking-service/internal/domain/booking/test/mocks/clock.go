@@ -0,0 +1,9 @@
+package mocks
+
+import "time"
+
+// Tomorrow returns hour o'clock UTC on the next day, which tests book at so that their
+// bookings are in the future wherever they run.
+func Tomorrow(hour int) time.Time {
+	return time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1).Add(time.Duration(hour) * time.Hour)
+}
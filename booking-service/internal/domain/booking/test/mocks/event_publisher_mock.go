@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"sync"
 
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
@@ -17,7 +18,7 @@ func NewMockEventPublisher() *MockEventPublisher {
 	}
 }
 
-func (publisher *MockEventPublisher) Publish(event booking.Event) error {
+func (publisher *MockEventPublisher) Publish(ctx context.Context, event booking.Event) error {
 	publisher.mu.Lock()
 	defer publisher.mu.Unlock()
 
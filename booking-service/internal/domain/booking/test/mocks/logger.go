@@ -0,0 +1,11 @@
+package mocks
+
+import (
+	"io"
+	"log/slog"
+)
+
+// NewLogger returns a logger that discards everything, for handlers under test.
+func NewLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
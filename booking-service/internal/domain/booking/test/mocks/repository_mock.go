@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -11,11 +12,16 @@ import (
 type MockRepository struct {
 	mu       sync.RWMutex
 	bookings map[string]*booking.Booking
+	// statuses and history mimic the status history the database records.
+	statuses map[string]booking.BookingStatus
+	history  map[string][]*booking.StatusChange
 }
 
 func NewMockRepository() *MockRepository {
 	return &MockRepository{
 		bookings: make(map[string]*booking.Booking),
+		statuses: make(map[string]booking.BookingStatus),
+		history:  make(map[string][]*booking.StatusChange),
 	}
 }
 
@@ -24,6 +30,19 @@ func (repo *MockRepository) Create(ctx context.Context, booking *booking.Booking
 	defer repo.mu.Unlock()
 
 	repo.bookings[booking.ID] = booking
+	repo.recordStatus(booking)
+
+	return nil
+}
+
+func (repo *MockRepository) CreateMany(ctx context.Context, bookings []*booking.Booking) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, booking := range bookings {
+		repo.bookings[booking.ID] = booking
+		repo.recordStatus(booking)
+	}
 
 	return nil
 }
@@ -33,6 +52,7 @@ func (repo *MockRepository) Update(ctx context.Context, booking *booking.Booking
 	defer repo.mu.Unlock()
 
 	repo.bookings[booking.ID] = booking
+	repo.recordStatus(booking)
 
 	return nil
 }
@@ -62,6 +82,25 @@ func (repo *MockRepository) ListByUserID(ctx context.Context, userID string, sta
 	return result, nil
 }
 
+func (repo *MockRepository) CountActiveByUserID(ctx context.Context, userID string, after time.Time) (int, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	count := 0
+	for _, record := range repo.bookings {
+		active := record.Status == booking.StatusPending || record.Status == booking.StatusConfirmed
+		if record.UserID == userID && active && record.StartTime.After(after) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (repo *MockRepository) ListActiveByUserID(ctx context.Context, userID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	return repo.listActive(func(record *booking.Booking) bool { return record.UserID == userID }, startTime, endTime), nil
+}
+
 func (repo *MockRepository) ListByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
 	repo.mu.RLock()
 	defer repo.mu.RUnlock()
@@ -78,11 +117,82 @@ func (repo *MockRepository) ListByGymID(ctx context.Context, gymID string, start
 	return result, nil
 }
 
+func (repo *MockRepository) ListBySessionID(ctx context.Context, sessionID string) ([]*booking.Booking, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*booking.Booking
+	for _, booking := range repo.bookings {
+		if booking.SessionID == sessionID {
+			result = append(result, booking)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+
+	return result, nil
+}
+
+func (repo *MockRepository) ListActiveByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	return repo.listActive(func(record *booking.Booking) bool { return record.GymID == gymID }, startTime, endTime), nil
+}
+
+func (repo *MockRepository) ListActiveByTrainerID(ctx context.Context, trainerID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	return repo.listActive(func(record *booking.Booking) bool { return record.TrainerID == trainerID }, startTime, endTime), nil
+}
+
+func (repo *MockRepository) ListActiveByResourceID(ctx context.Context, resourceID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	return repo.listActive(func(record *booking.Booking) bool { return record.ResourceID == resourceID }, startTime, endTime), nil
+}
+
+// listActive returns the matching pending and confirmed bookings overlapping
+// [startTime, endTime), earliest first. A zero endTime leaves the range open.
+func (repo *MockRepository) listActive(matches func(record *booking.Booking) bool, startTime, endTime time.Time) []*booking.Booking {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*booking.Booking
+	for _, record := range repo.bookings {
+		active := record.Status == booking.StatusPending || record.Status == booking.StatusConfirmed
+		if matches(record) && active && (endTime.IsZero() || record.StartTime.Before(endTime)) && record.EndTime.After(startTime) {
+			result = append(result, record)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].StartTime.Before(result[j].StartTime) })
+
+	return result
+}
+
+func (repo *MockRepository) ListStatusChanges(ctx context.Context, bookingID string) ([]*booking.StatusChange, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	return append([]*booking.StatusChange(nil), repo.history[bookingID]...), nil
+}
+
+// recordStatus appends a history entry when the booking's status differs from the one last
+// stored, like the database trigger does.
+func (repo *MockRepository) recordStatus(record *booking.Booking) {
+	previous, exists := repo.statuses[record.ID]
+	if exists && previous == record.Status {
+		return
+	}
+	repo.statuses[record.ID] = record.Status
+	repo.history[record.ID] = append(repo.history[record.ID], &booking.StatusChange{
+		BookingID:  record.ID,
+		FromStatus: previous,
+		ToStatus:   record.Status,
+		Reason:     record.StatusReason,
+		ChangedAt:  time.Now(),
+	})
+}
+
 func (repo *MockRepository) Clear() {
 	repo.mu.Lock()
 	defer repo.mu.Unlock()
 
 	repo.bookings = make(map[string]*booking.Booking)
+	repo.statuses = make(map[string]booking.BookingStatus)
+	repo.history = make(map[string][]*booking.StatusChange)
 }
 
 func (repo *MockRepository) AddBooking(booking *booking.Booking) {
@@ -0,0 +1,93 @@
+// Package closure models the periods a gym is closed, such as public holidays and
+// maintenance, during which nothing at it can be booked.
+package closure
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxReasonLength bounds a closure's reason, in characters.
+const MaxReasonLength = 500
+
+// Closure is a period a gym is closed, from StartTime up to, but not including, EndTime.
+type Closure struct {
+	ID        string
+	GymID     string
+	StartTime time.Time
+	EndTime   time.Time
+	// Reason is shown to members, e.g. "Christmas Day".
+	Reason string
+	// Recurring closures repeat every year from their first occurrence, at the same local
+	// dates and times in the gym's time zone. One that starts on 29 February falls on
+	// 1 March in other years.
+	Recurring bool
+	CreatedAt time.Time
+}
+
+// Interval is one occurrence of a closure.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+func NewClosure(gymID string, startTime, endTime time.Time, reason string, recurring bool) (*Closure, error) {
+	closure := &Closure{
+		GymID:     gymID,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Reason:    strings.TrimSpace(reason),
+		Recurring: recurring,
+		CreatedAt: time.Now(),
+	}
+	if err := closure.Validate(); err != nil {
+		return nil, err
+	}
+	return closure, nil
+}
+
+// Validate reports the first detail the closure cannot be enforced with.
+func (closure *Closure) Validate() error {
+	switch {
+	case !closure.StartTime.Before(closure.EndTime):
+		return fmt.Errorf("%w: end_time must be after start_time", ErrInvalidClosure)
+	case closure.Recurring && closure.EndTime.Sub(closure.StartTime) > 365*24*time.Hour:
+		return fmt.Errorf("%w: a yearly closure must not last longer than a year", ErrInvalidClosure)
+	case len([]rune(closure.Reason)) > MaxReasonLength:
+		return fmt.Errorf("%w: reason must be at most %d characters", ErrInvalidClosure, MaxReasonLength)
+	}
+	return nil
+}
+
+// Occurrences returns the occurrences of the closure overlapping [from, to), earliest
+// first. Recurring closures repeat at the same wall-clock times in location.
+func (closure *Closure) Occurrences(from, to time.Time, location *time.Location) []Interval {
+	if !closure.Recurring {
+		if closure.StartTime.Before(to) && closure.EndTime.After(from) {
+			return []Interval{{Start: closure.StartTime, End: closure.EndTime}}
+		}
+		return nil
+	}
+
+	start := closure.StartTime.In(location)
+	end := closure.EndTime.In(location)
+	// An occurrence starting a year before from may still be running at from.
+	first := max(from.In(location).Year()-start.Year()-1, 0)
+
+	var occurrences []Interval
+	for years := first; ; years++ {
+		occurrence := Interval{Start: start.AddDate(years, 0, 0), End: end.AddDate(years, 0, 0)}
+		if !occurrence.Start.Before(to) {
+			return occurrences
+		}
+		if occurrence.End.After(from) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+}
+
+// Overlaps reports whether any occurrence of the closure overlaps [start, end).
+func (closure *Closure) Overlaps(start, end time.Time, location *time.Location) bool {
+	return len(closure.Occurrences(start, end, location)) > 0
+}
@@ -0,0 +1,13 @@
+package closure
+
+import "errors"
+
+var (
+	ErrClosureNotFound = errors.New("closure not found")
+	ErrInvalidClosure  = errors.New("invalid closure")
+	// ErrGymClosed rejects bookings overlapping one of their gym's closures.
+	ErrGymClosed = errors.New("gym is closed at that time")
+	// ErrClosureHasBookings rejects closures over upcoming bookings, unless those are to be
+	// cancelled.
+	ErrClosureHasBookings = errors.New("closure overlaps upcoming bookings")
+)
@@ -0,0 +1,37 @@
+package closure
+
+import "time"
+
+// ClosureCreatedEvent reports a new closure and how many upcoming bookings were cancelled
+// for it. The cancellation of each booking is published as a booking event of its own.
+type ClosureCreatedEvent struct {
+	ClosureID         string
+	GymID             string
+	StartTime         time.Time
+	EndTime           time.Time
+	Recurring         bool
+	Reason            string
+	CancelledBookings int
+	OccurredAtTime    time.Time
+}
+
+func NewClosureCreatedEvent(closure *Closure, cancelledBookings int) ClosureCreatedEvent {
+	return ClosureCreatedEvent{
+		ClosureID:         closure.ID,
+		GymID:             closure.GymID,
+		StartTime:         closure.StartTime,
+		EndTime:           closure.EndTime,
+		Recurring:         closure.Recurring,
+		Reason:            closure.Reason,
+		CancelledBookings: cancelledBookings,
+		OccurredAtTime:    time.Now(),
+	}
+}
+
+func (event ClosureCreatedEvent) EventName() string {
+	return "closure.created"
+}
+
+func (event ClosureCreatedEvent) OccurredAt() time.Time {
+	return event.OccurredAtTime
+}
@@ -0,0 +1,15 @@
+package closure
+
+import "context"
+
+type Repository interface {
+	// CreateMany stores every closure, or none of them.
+	CreateMany(ctx context.Context, closures []*Closure) error
+	// GetByID returns the closure, or ErrClosureNotFound.
+	GetByID(ctx context.Context, id string) (*Closure, error)
+	// Delete removes the closure, or returns ErrClosureNotFound.
+	Delete(ctx context.Context, id string) error
+	// ListByGymID returns every closure of the gym, past ones included, earliest first.
+	// Recurring closures are listed by their first occurrence.
+	ListByGymID(ctx context.Context, gymID string) ([]*Closure, error)
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+func TestNewClosureValidation(t *testing.T) {
+	start := time.Date(2030, time.December, 25, 0, 0, 0, 0, time.UTC)
+
+	created, err := closure.NewClosure("gym1", start, start.AddDate(0, 0, 1), "  Christmas Day ", true)
+	require.NoError(t, err)
+	assert.Equal(t, "Christmas Day", created.Reason)
+
+	_, err = closure.NewClosure("gym1", start, start, "", false)
+	assert.ErrorIs(t, err, closure.ErrInvalidClosure)
+
+	_, err = closure.NewClosure("gym1", start, start.AddDate(1, 0, 1), "", true)
+	assert.ErrorIs(t, err, closure.ErrInvalidClosure, "a yearly closure cannot overlap its next occurrence")
+
+	_, err = closure.NewClosure("gym1", start, start.AddDate(1, 0, 1), "", false)
+	assert.NoError(t, err, "a one-off closure may last longer than a year")
+}
+
+func TestClosureOccurrences(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	start := time.Date(2030, time.December, 25, 0, 0, 0, 0, berlin)
+
+	oneOff, err := closure.NewClosure("gym1", start, start.AddDate(0, 0, 1), "", false)
+	require.NoError(t, err)
+	assert.True(t, oneOff.Overlaps(start.Add(23*time.Hour), start.Add(25*time.Hour), berlin))
+	assert.False(t, oneOff.Overlaps(start.AddDate(1, 0, 0), start.AddDate(1, 0, 1), berlin))
+
+	yearly, err := closure.NewClosure("gym1", start, start.AddDate(0, 0, 1), "Christmas Day", true)
+	require.NoError(t, err)
+	assert.Equal(t, []closure.Interval{
+		{Start: time.Date(2031, time.December, 25, 0, 0, 0, 0, berlin), End: time.Date(2031, time.December, 26, 0, 0, 0, 0, berlin)},
+		{Start: time.Date(2032, time.December, 25, 0, 0, 0, 0, berlin), End: time.Date(2032, time.December, 26, 0, 0, 0, 0, berlin)},
+	}, yearly.Occurrences(time.Date(2031, time.June, 1, 0, 0, 0, 0, time.UTC), time.Date(2033, time.June, 1, 0, 0, 0, 0, time.UTC), berlin))
+	assert.Empty(t, yearly.Occurrences(start.AddDate(-1, 0, 0), start.AddDate(-1, 0, 1), berlin), "closures do not recur before they start")
+
+	// 23:30 UTC on 24 December is already Christmas Day in Berlin.
+	eve := time.Date(2035, time.December, 24, 23, 30, 0, 0, time.UTC)
+	assert.True(t, yearly.Overlaps(eve, eve.Add(time.Hour), berlin))
+	assert.False(t, yearly.Overlaps(eve.Add(-2*time.Hour), eve.Add(-time.Hour), berlin))
+
+	newYearsEve := time.Date(2030, time.December, 31, 20, 0, 0, 0, berlin)
+	acrossNewYear, err := closure.NewClosure("gym1", newYearsEve, newYearsEve.Add(16*time.Hour), "", true)
+	require.NoError(t, err)
+	assert.True(t, acrossNewYear.Overlaps(time.Date(2034, time.January, 1, 10, 0, 0, 0, berlin), time.Date(2034, time.January, 1, 11, 0, 0, 0, berlin), berlin),
+		"an occurrence that began the year before is still running")
+}
@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+type MockRepository struct {
+	mu       sync.RWMutex
+	closures map[string]*closure.Closure
+}
+
+func NewMockRepository() *MockRepository {
+	return &MockRepository{
+		closures: make(map[string]*closure.Closure),
+	}
+}
+
+func (repo *MockRepository) CreateMany(ctx context.Context, closures []*closure.Closure) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, closureRecord := range closures {
+		stored := *closureRecord
+		repo.closures[closureRecord.ID] = &stored
+	}
+	return nil
+}
+
+func (repo *MockRepository) GetByID(ctx context.Context, id string) (*closure.Closure, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	closureRecord, exists := repo.closures[id]
+	if !exists {
+		return nil, closure.ErrClosureNotFound
+	}
+	copied := *closureRecord
+	return &copied, nil
+}
+
+func (repo *MockRepository) Delete(ctx context.Context, id string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if _, exists := repo.closures[id]; !exists {
+		return closure.ErrClosureNotFound
+	}
+	delete(repo.closures, id)
+	return nil
+}
+
+func (repo *MockRepository) ListByGymID(ctx context.Context, gymID string) ([]*closure.Closure, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*closure.Closure
+	for _, closureRecord := range repo.closures {
+		if closureRecord.GymID == gymID {
+			copied := *closureRecord
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartTime.Before(result[j].StartTime)
+	})
+	return result, nil
+}
@@ -0,0 +1,30 @@
+// Package membership is the booking service's view of the membership service, which
+// decides who may use which gyms.
+package membership
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNoActiveMembership = errors.New("user has no active membership granting access to the gym")
+	// ErrUnavailable means entitlement could not be decided, e.g. because the membership
+	// service is down.
+	ErrUnavailable = errors.New("membership service unavailable")
+)
+
+// Checker answers whether a user's memberships grant access to a gym.
+type Checker interface {
+	// HasAccess reports whether userID holds a membership that is active at the given time
+	// and grants access to gymID.
+	HasAccess(ctx context.Context, userID, gymID string, at time.Time) (bool, error)
+}
+
+// TierSource tells which membership tier a user is on.
+type TierSource interface {
+	// Tier returns the tier of userID's current membership, such as "basic" or "premium",
+	// or "" if they have none.
+	Tier(ctx context.Context, userID string) (string, error)
+}
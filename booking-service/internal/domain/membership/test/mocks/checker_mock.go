@@ -0,0 +1,88 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// FakeChecker is an in-memory membership service. Users have access only to the gyms and
+// periods granted to them, and are on the tier set for them, if any.
+type FakeChecker struct {
+	mu     sync.RWMutex
+	grants map[string][]grant
+	tiers  map[string]string
+	err    error
+	calls  int
+}
+
+type grant struct {
+	from  time.Time
+	until time.Time
+}
+
+func NewFakeChecker() *FakeChecker {
+	return &FakeChecker{
+		grants: make(map[string][]grant),
+		tiers:  make(map[string]string),
+	}
+}
+
+// Grant gives userID access to gymID from from until until. A zero until never expires.
+func (checker *FakeChecker) Grant(userID, gymID string, from, until time.Time) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	key := userID + "/" + gymID
+	checker.grants[key] = append(checker.grants[key], grant{from: from, until: until})
+}
+
+// SetTier puts userID on tier.
+func (checker *FakeChecker) SetTier(userID, tier string) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	checker.tiers[userID] = tier
+}
+
+// SetError makes every call fail with err until it is reset with nil.
+func (checker *FakeChecker) SetError(err error) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	checker.err = err
+}
+
+// Calls returns how often HasAccess was called.
+func (checker *FakeChecker) Calls() int {
+	checker.mu.RLock()
+	defer checker.mu.RUnlock()
+
+	return checker.calls
+}
+
+func (checker *FakeChecker) HasAccess(ctx context.Context, userID, gymID string, at time.Time) (bool, error) {
+	checker.mu.Lock()
+	defer checker.mu.Unlock()
+
+	checker.calls++
+	if checker.err != nil {
+		return false, checker.err
+	}
+	for _, granted := range checker.grants[userID+"/"+gymID] {
+		if !at.Before(granted.from) && (granted.until.IsZero() || at.Before(granted.until)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (checker *FakeChecker) Tier(ctx context.Context, userID string) (string, error) {
+	checker.mu.RLock()
+	defer checker.mu.RUnlock()
+
+	if checker.err != nil {
+		return "", checker.err
+	}
+	return checker.tiers[userID], nil
+}
@@ -0,0 +1,80 @@
+package policy
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrPolicyNotFound = errors.New("gym has no booking policy")
+	ErrInvalidPolicy  = errors.New("invalid booking policy")
+
+	ErrLeadTimeTooShort  = errors.New("booking starts too soon")
+	ErrTooFarInAdvance   = errors.New("booking starts too far in advance")
+	ErrDurationTooShort  = errors.New("booking is too short")
+	ErrDurationTooLong   = errors.New("booking is too long")
+	ErrSlotMisaligned    = errors.New("booking does not start on a slot boundary")
+	ErrDailyLimitReached = errors.New("daily booking limit reached")
+)
+
+// Rule identifies one booking rule. Its value is the code reported for violations of it.
+type Rule string
+
+const (
+	RuleMinLeadTime       Rule = "LEAD_TIME_TOO_SHORT"
+	RuleMaxAdvance        Rule = "TOO_FAR_IN_ADVANCE"
+	RuleMinDuration       Rule = "DURATION_TOO_SHORT"
+	RuleMaxDuration       Rule = "DURATION_TOO_LONG"
+	RuleSlotAlignment     Rule = "SLOT_MISALIGNED"
+	RuleMaxBookingsPerDay Rule = "DAILY_LIMIT_REACHED"
+)
+
+// Err returns the sentinel error of the rule.
+func (rule Rule) Err() error {
+	switch rule {
+	case RuleMinLeadTime:
+		return ErrLeadTimeTooShort
+	case RuleMaxAdvance:
+		return ErrTooFarInAdvance
+	case RuleMinDuration:
+		return ErrDurationTooShort
+	case RuleMaxDuration:
+		return ErrDurationTooLong
+	case RuleSlotAlignment:
+		return ErrSlotMisaligned
+	case RuleMaxBookingsPerDay:
+		return ErrDailyLimitReached
+	default:
+		return ErrInvalidPolicy
+	}
+}
+
+// Violation is one rule a booking breaks. Field names the booking field at fault and
+// Message states the limit, e.g. "must be at least 2h from now".
+type Violation struct {
+	Rule    Rule
+	Field   string
+	Message string
+}
+
+// ViolationError lists every rule a booking breaks. errors.Is matches it against the
+// sentinel error of each broken rule.
+type ViolationError struct {
+	Violations []Violation
+}
+
+func (err *ViolationError) Error() string {
+	messages := make([]string, len(err.Violations))
+	for i, violation := range err.Violations {
+		messages[i] = violation.Field + " " + violation.Message
+	}
+	return "booking violates the gym's policy: " + strings.Join(messages, "; ")
+}
+
+func (err *ViolationError) Unwrap() []error {
+	errs := make([]error, len(err.Violations))
+	for i, violation := range err.Violations {
+		errs[i] = violation.Rule.Err()
+	}
+	return errs
+}
@@ -0,0 +1,146 @@
+// Package policy holds the booking rules each gym sets for itself, such as how far ahead
+// members may book and how long a booking may last.
+package policy
+
+import (
+	"fmt"
+	"time"
+	// Gym time zones must resolve on hosts without a zoneinfo database.
+	_ "time/tzdata"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// Policy is one gym's booking rules. A zero limit disables its rule, so the zero Policy
+// allows every booking.
+type Policy struct {
+	GymID string
+	// MinLeadTime is how long before its start a booking must be made.
+	MinLeadTime time.Duration
+	// MaxAdvance is how far ahead of now a booking may start.
+	MaxAdvance  time.Duration
+	MinDuration time.Duration
+	MaxDuration time.Duration
+	// SlotAlignment makes bookings start on multiples of it past midnight, e.g. 30m allows
+	// starts on :00 and :30 only.
+	SlotAlignment time.Duration
+	// MaxBookingsPerDay limits the active bookings a member may hold at the gym starting on
+	// the same day.
+	MaxBookingsPerDay int
+	// TimeZone is the IANA name of the gym's time zone, which defines its days and slots.
+	// Empty means UTC.
+	TimeZone string
+	// CancellationCutoff is how long before its start a booking can be cancelled without
+	// it counting as a late cancellation.
+	CancellationCutoff time.Duration
+	// A member who collects more than MaxStrikes strikes, for late cancellations and
+	// no-shows, within StrikeWindow cannot book at the gym for SuspensionPeriod after the
+	// last of them.
+	MaxStrikes       int
+	StrikeWindow     time.Duration
+	SuspensionPeriod time.Duration
+	// BufferBefore and BufferAfter are kept free around every open booking at the gym for
+	// changeover, so that the next booking can start no earlier than both past the end of
+	// the last. Resources set their own buffers.
+	BufferBefore time.Duration
+	BufferAfter  time.Duration
+	UpdatedAt    time.Time
+}
+
+// Validate reports the first setting that cannot be enforced.
+func (policy *Policy) Validate() error {
+	switch {
+	case policy.MinLeadTime < 0, policy.MaxAdvance < 0, policy.MinDuration < 0,
+		policy.MaxDuration < 0, policy.SlotAlignment < 0, policy.MaxBookingsPerDay < 0,
+		policy.CancellationCutoff < 0, policy.MaxStrikes < 0, policy.StrikeWindow < 0, policy.SuspensionPeriod < 0,
+		policy.BufferBefore < 0, policy.BufferAfter < 0:
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidPolicy)
+	case policy.MaxAdvance > 0 && policy.MaxAdvance <= policy.MinLeadTime:
+		return fmt.Errorf("%w: max advance must exceed the minimum lead time", ErrInvalidPolicy)
+	case policy.MaxDuration > 0 && policy.MaxDuration < policy.MinDuration:
+		return fmt.Errorf("%w: max duration must not be below the minimum duration", ErrInvalidPolicy)
+	case policy.SlotAlignment%time.Minute != 0 || (policy.SlotAlignment > 0 && (24*time.Hour)%policy.SlotAlignment != 0):
+		return fmt.Errorf("%w: slot alignment must be whole minutes dividing a day", ErrInvalidPolicy)
+	case policy.MaxStrikes > 0 && (policy.StrikeWindow == 0 || policy.SuspensionPeriod == 0):
+		return fmt.Errorf("%w: max strikes needs a strike window and a suspension period", ErrInvalidPolicy)
+	}
+	if _, err := time.LoadLocation(policy.TimeZone); err != nil {
+		return fmt.Errorf("%w: unknown time zone %q", ErrInvalidPolicy, policy.TimeZone)
+	}
+	return nil
+}
+
+// Buffer returns the changeover time the gym keeps free around its open bookings.
+func (policy *Policy) Buffer() booking.Buffer {
+	return booking.Buffer{Before: policy.BufferBefore, After: policy.BufferAfter}
+}
+
+// Location returns the gym's time zone, falling back to UTC for an unknown one.
+func (policy *Policy) Location() *time.Location {
+	location, err := time.LoadLocation(policy.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Day returns the start of the gym's day containing t and the start of the next one.
+func (policy *Policy) Day(t time.Time) (time.Time, time.Time) {
+	local := t.In(policy.Location())
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())
+	return start, start.AddDate(0, 0, 1)
+}
+
+// Evaluate returns every rule broken by a booking from startTime to endTime, made at now
+// by a member who already holds bookingsThatDay active bookings at the gym starting on the
+// same day.
+func (policy *Policy) Evaluate(startTime, endTime, now time.Time, bookingsThatDay int) []Violation {
+	var violations []Violation
+	add := func(rule Rule, field, format string, args ...interface{}) {
+		violations = append(violations, Violation{Rule: rule, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	leadTime := startTime.Sub(now)
+	if policy.MinLeadTime > 0 && leadTime < policy.MinLeadTime {
+		add(RuleMinLeadTime, "start_time", "must be at least %s from now", formatDuration(policy.MinLeadTime))
+	}
+	if policy.MaxAdvance > 0 && leadTime > policy.MaxAdvance {
+		add(RuleMaxAdvance, "start_time", "must be at most %s from now", formatDuration(policy.MaxAdvance))
+	}
+
+	duration := endTime.Sub(startTime)
+	if policy.MinDuration > 0 && duration < policy.MinDuration {
+		add(RuleMinDuration, "end_time", "must be at least %s after start_time", formatDuration(policy.MinDuration))
+	}
+	if policy.MaxDuration > 0 && duration > policy.MaxDuration {
+		add(RuleMaxDuration, "end_time", "must be at most %s after start_time", formatDuration(policy.MaxDuration))
+	}
+
+	if policy.SlotAlignment > 0 {
+		dayStart, _ := policy.Day(startTime)
+		if startTime.Sub(dayStart)%policy.SlotAlignment != 0 {
+			add(RuleSlotAlignment, "start_time", "must start on a %s boundary in %s", formatDuration(policy.SlotAlignment), policy.Location())
+		}
+	}
+
+	if policy.MaxBookingsPerDay > 0 && bookingsThatDay >= policy.MaxBookingsPerDay {
+		add(RuleMaxBookingsPerDay, "start_time", "exceeds the limit of %d bookings per day", policy.MaxBookingsPerDay)
+	}
+
+	return violations
+}
+
+// formatDuration prints whole days and hours without the trailing zero units time.Duration
+// adds, e.g. "30m", "2h" or "14d".
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", duration/(24*time.Hour))
+	case duration%time.Hour == 0:
+		return fmt.Sprintf("%dh", duration/time.Hour)
+	case duration%time.Minute == 0:
+		return fmt.Sprintf("%dm", duration/time.Minute)
+	default:
+		return duration.String()
+	}
+}
@@ -0,0 +1,10 @@
+package policy
+
+import "context"
+
+type Repository interface {
+	// Get returns the gym's policy, or ErrPolicyNotFound if none has been set.
+	Get(ctx context.Context, gymID string) (*Policy, error)
+	// Save creates or replaces the gym's policy.
+	Save(ctx context.Context, policy *Policy) error
+}
@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+)
+
+type MockRepository struct {
+	mu       sync.RWMutex
+	policies map[string]*policy.Policy
+}
+
+// NewMockRepository returns a repository holding policies.
+func NewMockRepository(policies ...*policy.Policy) *MockRepository {
+	repo := &MockRepository{
+		policies: make(map[string]*policy.Policy),
+	}
+	for _, gymPolicy := range policies {
+		repo.Save(context.Background(), gymPolicy)
+	}
+	return repo
+}
+
+func (repo *MockRepository) Get(ctx context.Context, gymID string) (*policy.Policy, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	gymPolicy, exists := repo.policies[gymID]
+	if !exists {
+		return nil, policy.ErrPolicyNotFound
+	}
+	copied := *gymPolicy
+	return &copied, nil
+}
+
+func (repo *MockRepository) Save(ctx context.Context, gymPolicy *policy.Policy) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	copied := *gymPolicy
+	repo.policies[gymPolicy.GymID] = &copied
+	return nil
+}
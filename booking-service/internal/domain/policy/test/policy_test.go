@@ -0,0 +1,123 @@
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 8, 0, 0, 0, time.UTC)
+	gymPolicy := &policy.Policy{
+		GymID:             "gym1",
+		MinLeadTime:       time.Hour,
+		MaxAdvance:        14 * 24 * time.Hour,
+		MinDuration:       30 * time.Minute,
+		MaxDuration:       2 * time.Hour,
+		SlotAlignment:     30 * time.Minute,
+		MaxBookingsPerDay: 2,
+	}
+
+	tests := []struct {
+		name            string
+		start           time.Time
+		duration        time.Duration
+		bookingsThatDay int
+		want            []policy.Rule
+	}{
+		{name: "allowed", start: now.Add(2 * time.Hour), duration: time.Hour},
+		{name: "too soon", start: now.Add(30 * time.Minute), duration: time.Hour, want: []policy.Rule{policy.RuleMinLeadTime}},
+		{name: "too far ahead", start: now.Add(15 * 24 * time.Hour), duration: time.Hour, want: []policy.Rule{policy.RuleMaxAdvance}},
+		{name: "too short", start: now.Add(2 * time.Hour), duration: 15 * time.Minute, want: []policy.Rule{policy.RuleMinDuration}},
+		{name: "too long", start: now.Add(2 * time.Hour), duration: 3 * time.Hour, want: []policy.Rule{policy.RuleMaxDuration}},
+		{name: "misaligned", start: now.Add(2*time.Hour + 10*time.Minute), duration: time.Hour, want: []policy.Rule{policy.RuleSlotAlignment}},
+		{name: "daily limit", start: now.Add(2 * time.Hour), duration: time.Hour, bookingsThatDay: 2, want: []policy.Rule{policy.RuleMaxBookingsPerDay}},
+		{
+			name:     "several rules",
+			start:    now.Add(10 * time.Minute),
+			duration: 5 * time.Minute,
+			want:     []policy.Rule{policy.RuleMinLeadTime, policy.RuleMinDuration, policy.RuleSlotAlignment},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			violations := gymPolicy.Evaluate(test.start, test.start.Add(test.duration), now, test.bookingsThatDay)
+
+			var rules []policy.Rule
+			for _, violation := range violations {
+				rules = append(rules, violation.Rule)
+				assert.NotEmpty(t, violation.Field)
+				assert.NotEmpty(t, violation.Message)
+			}
+			assert.Equal(t, test.want, rules)
+		})
+	}
+
+	assert.Empty(t, (&policy.Policy{}).Evaluate(now.Add(-time.Hour), now.Add(48*time.Hour), now, 100), "the zero policy allows everything")
+}
+
+func TestSlotAlignmentUsesGymTimeZone(t *testing.T) {
+	// Kathmandu is UTC+5:45, so its half-hour slots fall on :15 and :45 UTC.
+	gymPolicy := &policy.Policy{SlotAlignment: 30 * time.Minute, TimeZone: "Asia/Kathmandu"}
+	now := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	aligned := time.Date(2026, time.March, 2, 6, 15, 0, 0, time.UTC)
+	assert.Empty(t, gymPolicy.Evaluate(aligned, aligned.Add(time.Hour), now, 0))
+
+	misaligned := time.Date(2026, time.March, 2, 6, 30, 0, 0, time.UTC)
+	assert.Len(t, gymPolicy.Evaluate(misaligned, misaligned.Add(time.Hour), now, 0), 1)
+
+	dayStart, dayEnd := gymPolicy.Day(aligned)
+	assert.Equal(t, time.Date(2026, time.March, 1, 18, 15, 0, 0, time.UTC), dayStart.UTC())
+	assert.Equal(t, 24*time.Hour, dayEnd.Sub(dayStart))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  policy.Policy
+		wantErr bool
+	}{
+		{name: "empty", policy: policy.Policy{}},
+		{name: "complete", policy: policy.Policy{MinLeadTime: time.Hour, MaxAdvance: 24 * time.Hour, MinDuration: time.Hour, MaxDuration: time.Hour, SlotAlignment: 15 * time.Minute, MaxBookingsPerDay: 1, TimeZone: "Europe/Berlin"}},
+		{name: "negative limit", policy: policy.Policy{MinDuration: -time.Minute}, wantErr: true},
+		{name: "advance within lead time", policy: policy.Policy{MinLeadTime: 2 * time.Hour, MaxAdvance: time.Hour}, wantErr: true},
+		{name: "max below min duration", policy: policy.Policy{MinDuration: 2 * time.Hour, MaxDuration: time.Hour}, wantErr: true},
+		{name: "slot not dividing a day", policy: policy.Policy{SlotAlignment: 7 * time.Minute}, wantErr: true},
+		{name: "unknown time zone", policy: policy.Policy{TimeZone: "Mars/Olympus_Mons"}, wantErr: true},
+		{name: "strikes", policy: policy.Policy{CancellationCutoff: time.Hour, MaxStrikes: 3, StrikeWindow: 30 * 24 * time.Hour, SuspensionPeriod: 7 * 24 * time.Hour}},
+		{name: "strikes without a window", policy: policy.Policy{MaxStrikes: 3, SuspensionPeriod: time.Hour}, wantErr: true},
+		{name: "negative cutoff", policy: policy.Policy{CancellationCutoff: -time.Hour}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.policy.Validate()
+			if test.wantErr {
+				assert.ErrorIs(t, err, policy.ErrInvalidPolicy)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestViolationError(t *testing.T) {
+	err := error(&policy.ViolationError{Violations: []policy.Violation{
+		{Rule: policy.RuleMinLeadTime, Field: "start_time", Message: "must be at least 1h from now"},
+		{Rule: policy.RuleMaxBookingsPerDay, Field: "start_time", Message: "exceeds the limit of 2 bookings per day"},
+	}})
+
+	assert.ErrorIs(t, err, policy.ErrLeadTimeTooShort)
+	assert.ErrorIs(t, err, policy.ErrDailyLimitReached)
+	assert.False(t, errors.Is(err, policy.ErrDurationTooLong))
+
+	var violationErr *policy.ViolationError
+	require.ErrorAs(t, err, &violationErr)
+	assert.Len(t, violationErr.Violations, 2)
+}
@@ -0,0 +1,39 @@
+package quota
+
+import "errors"
+
+var ErrQuotaExceeded = errors.New("booking quota exceeded")
+
+// Limit names one quota of a tier.
+type Limit string
+
+const (
+	LimitActiveBookings Limit = "ACTIVE_BOOKINGS"
+	LimitWeeklyDuration Limit = "WEEKLY_DURATION"
+	LimitAdvance        Limit = "ADVANCE_WINDOW"
+)
+
+// Field returns the booking field a booking exceeding the limit is rejected for.
+func (limit Limit) Field() string {
+	switch limit {
+	case LimitWeeklyDuration:
+		return "end_time"
+	default:
+		return "start_time"
+	}
+}
+
+// ExceededError reports the quota a booking would exceed. It matches ErrQuotaExceeded.
+type ExceededError struct {
+	Tier    Tier
+	Limit   Limit
+	Message string
+}
+
+func (err *ExceededError) Error() string {
+	return "booking quota exceeded: " + err.Message
+}
+
+func (err *ExceededError) Unwrap() error {
+	return ErrQuotaExceeded
+}
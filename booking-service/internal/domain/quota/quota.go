@@ -0,0 +1,97 @@
+// Package quota limits how much each member may book, depending on their membership tier.
+package quota
+
+import (
+	"fmt"
+	"time"
+)
+
+// Tier is a membership plan. Each tier has its own Limits.
+type Tier string
+
+const (
+	TierBasic   Tier = "basic"
+	TierPremium Tier = "premium"
+)
+
+func (tier Tier) IsValid() bool {
+	switch tier {
+	case TierBasic, TierPremium:
+		return true
+	default:
+		return false
+	}
+}
+
+// Limits are the quotas of one tier. A zero limit is unlimited.
+type Limits struct {
+	// MaxActiveBookings caps the pending and confirmed bookings a member holds that have
+	// not started yet.
+	MaxActiveBookings int
+	// MaxWeeklyDuration caps the total length of a member's bookings starting in one week,
+	// Monday to Sunday in UTC. Cancelled bookings do not count.
+	MaxWeeklyDuration time.Duration
+	// MaxAdvance is how far ahead of now a member may book.
+	MaxAdvance time.Duration
+}
+
+// Usage is how much of their quota a member has used.
+type Usage struct {
+	ActiveBookings int
+	// WeekStart is the start of the week WeeklyDuration was counted for.
+	WeekStart      time.Time
+	WeeklyDuration time.Duration
+}
+
+// Week returns the start of the week containing t, Monday 00:00 UTC, and of the next one.
+func Week(t time.Time) (time.Time, time.Time) {
+	utc := t.UTC()
+	daysSinceMonday := (int(utc.Weekday()) + 6) % 7
+	start := time.Date(utc.Year(), utc.Month(), utc.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 0, 7)
+}
+
+// Evaluate returns the first limit a booking from startTime to endTime, made at now,
+// exceeds given the member's usage before it, or nil if it fits the tier's quota. usage
+// must count the week of startTime.
+func (limits Limits) Evaluate(tier Tier, usage Usage, startTime, endTime, now time.Time) *ExceededError {
+	if limits.MaxActiveBookings > 0 && usage.ActiveBookings >= limits.MaxActiveBookings {
+		return &ExceededError{
+			Tier:    tier,
+			Limit:   LimitActiveBookings,
+			Message: fmt.Sprintf("the %s tier allows at most %d active bookings", tier, limits.MaxActiveBookings),
+		}
+	}
+	if limits.MaxWeeklyDuration > 0 && usage.WeeklyDuration+endTime.Sub(startTime) > limits.MaxWeeklyDuration {
+		return &ExceededError{
+			Tier:  tier,
+			Limit: LimitWeeklyDuration,
+			Message: fmt.Sprintf("the %s tier allows at most %s of bookings per week and %s are already booked",
+				tier, formatHours(limits.MaxWeeklyDuration), formatHours(usage.WeeklyDuration)),
+		}
+	}
+	if limits.MaxAdvance > 0 && startTime.Sub(now) > limits.MaxAdvance {
+		return &ExceededError{
+			Tier:    tier,
+			Limit:   LimitAdvance,
+			Message: fmt.Sprintf("the %s tier allows booking at most %s ahead", tier, formatHours(limits.MaxAdvance)),
+		}
+	}
+	return nil
+}
+
+// formatHours prints durations as members think of them, e.g. "5h", "1h30m" or "14d".
+func formatHours(duration time.Duration) string {
+	switch {
+	case duration == 0:
+		return "0h"
+	case duration%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", duration/(24*time.Hour))
+	case duration%time.Hour == 0:
+		return fmt.Sprintf("%dh", duration/time.Hour)
+	case duration < time.Hour:
+		return fmt.Sprintf("%dm", duration/time.Minute)
+	default:
+		return fmt.Sprintf("%dh%dm", duration/time.Hour, duration%time.Hour/time.Minute)
+	}
+}
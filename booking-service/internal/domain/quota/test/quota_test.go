@@ -0,0 +1,60 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/quota"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 8, 0, 0, 0, time.UTC)
+	limits := quota.Limits{MaxActiveBookings: 3, MaxWeeklyDuration: 5 * time.Hour, MaxAdvance: 14 * 24 * time.Hour}
+	start := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		usage    quota.Usage
+		start    time.Time
+		duration time.Duration
+		want     quota.Limit
+	}{
+		{name: "within quota", usage: quota.Usage{ActiveBookings: 2, WeeklyDuration: 4 * time.Hour}, start: start, duration: time.Hour},
+		{name: "too many active bookings", usage: quota.Usage{ActiveBookings: 3}, start: start, duration: time.Hour, want: quota.LimitActiveBookings},
+		{name: "weekly hours used up", usage: quota.Usage{WeeklyDuration: 4*time.Hour + 30*time.Minute}, start: start, duration: time.Hour, want: quota.LimitWeeklyDuration},
+		{name: "too far ahead", start: now.Add(15 * 24 * time.Hour), duration: time.Hour, want: quota.LimitAdvance},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exceeded := limits.Evaluate(quota.TierBasic, test.usage, test.start, test.start.Add(test.duration), now)
+			if test.want == "" {
+				assert.Nil(t, exceeded)
+				return
+			}
+			require.NotNil(t, exceeded)
+			assert.Equal(t, test.want, exceeded.Limit)
+			assert.Equal(t, quota.TierBasic, exceeded.Tier)
+			assert.ErrorIs(t, exceeded, quota.ErrQuotaExceeded)
+			assert.Contains(t, exceeded.Error(), "basic tier")
+		})
+	}
+
+	assert.Nil(t, quota.Limits{}.Evaluate(quota.TierPremium, quota.Usage{ActiveBookings: 100, WeeklyDuration: 100 * time.Hour}, now.Add(365*24*time.Hour), now.Add(366*24*time.Hour), now),
+		"zero limits are unlimited")
+}
+
+func TestWeek(t *testing.T) {
+	monday := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, day := range []time.Time{monday, monday.Add(3*24*time.Hour + 13*time.Hour), monday.Add(7*24*time.Hour - time.Second)} {
+		start, end := quota.Week(day)
+		assert.Equal(t, monday, start, day.Weekday().String())
+		assert.Equal(t, monday.AddDate(0, 0, 7), end)
+	}
+
+	start, _ := quota.Week(time.Date(2026, time.March, 2, 0, 30, 0, 0, time.FixedZone("CET", 3600)))
+	assert.Equal(t, monday.AddDate(0, 0, -7), start, "weeks are counted in UTC")
+}
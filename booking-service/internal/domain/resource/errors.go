@@ -0,0 +1,12 @@
+package resource
+
+import "errors"
+
+var (
+	ErrResourceNotFound = errors.New("resource not found")
+	ErrInvalidResource  = errors.New("invalid resource")
+	// ErrResourceMismatch rejects bookings of a resource at another gym.
+	ErrResourceMismatch     = errors.New("booking does not match its resource")
+	ErrResourceOutOfService = errors.New("resource is out of service")
+	ErrResourceFull         = errors.New("resource is fully booked at that time")
+)
@@ -0,0 +1,31 @@
+package resource
+
+import "time"
+
+// OutOfServiceEvent reports a resource taken out of service and how many upcoming bookings
+// of it were flagged for staff to move or cancel.
+type OutOfServiceEvent struct {
+	ResourceID      string
+	GymID           string
+	Reason          string
+	FlaggedBookings int
+	OccurredAtTime  time.Time
+}
+
+func NewOutOfServiceEvent(resource *Resource, flaggedBookings int) OutOfServiceEvent {
+	return OutOfServiceEvent{
+		ResourceID:      resource.ID,
+		GymID:           resource.GymID,
+		Reason:          resource.OutOfServiceReason,
+		FlaggedBookings: flaggedBookings,
+		OccurredAtTime:  time.Now(),
+	}
+}
+
+func (event OutOfServiceEvent) EventName() string {
+	return "resource.out_of_service"
+}
+
+func (event OutOfServiceEvent) OccurredAt() time.Time {
+	return event.OccurredAtTime
+}
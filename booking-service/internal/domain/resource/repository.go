@@ -0,0 +1,13 @@
+package resource
+
+import "context"
+
+type Repository interface {
+	Create(ctx context.Context, resource *Resource) error
+	// GetByID returns the resource, or ErrResourceNotFound.
+	GetByID(ctx context.Context, id string) (*Resource, error)
+	Update(ctx context.Context, resource *Resource) error
+	// ListByGymID returns the gym's resources of resourceType, or of every type if it is
+	// empty, out-of-service ones included, ordered by type and name.
+	ListByGymID(ctx context.Context, gymID, resourceType string) ([]*Resource, error)
+}
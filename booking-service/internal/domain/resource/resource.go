@@ -0,0 +1,90 @@
+// Package resource models the bookable things inside a gym, such as courts, rooms and
+// squat racks. A booking that references a resource competes only with the other bookings
+// of that resource, up to its capacity, instead of with every booking at the gym.
+package resource
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// MaxNameLength bounds a resource's name, in characters.
+const MaxNameLength = 200
+
+// OutOfServiceFlag is recorded on the upcoming bookings of a resource taken out of service.
+const OutOfServiceFlag = "resource out of service"
+
+// typePattern keeps resource types to short lowercase identifiers such as "squat_rack", so
+// that they can be filtered on.
+var typePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+type Resource struct {
+	ID    string
+	GymID string
+	Name  string
+	// Type groups the gym's resources, e.g. "court" or "room".
+	Type string
+	// Capacity is how many bookings of the resource may overlap at any moment.
+	Capacity int
+	// BufferBefore and BufferAfter are kept free around each booking of the resource for
+	// changeover, e.g. cleaning a court, and count towards its capacity like the booking.
+	BufferBefore time.Duration
+	BufferAfter  time.Duration
+	// Active is unset while the resource is out of service, when it cannot be booked.
+	Active bool
+	// OutOfServiceReason explains why an inactive resource is out of service, when
+	// recorded.
+	OutOfServiceReason string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Validate reports the first detail the resource cannot be booked with.
+func (resource *Resource) Validate() error {
+	resource.Name = strings.TrimSpace(resource.Name)
+	switch {
+	case resource.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidResource)
+	case len([]rune(resource.Name)) > MaxNameLength:
+		return fmt.Errorf("%w: name must be at most %d characters", ErrInvalidResource, MaxNameLength)
+	case !typePattern.MatchString(resource.Type):
+		return fmt.Errorf("%w: type must be a lowercase identifier of at most 50 characters", ErrInvalidResource)
+	case resource.Capacity < 1:
+		return fmt.Errorf("%w: capacity must be at least 1", ErrInvalidResource)
+	case resource.BufferBefore < 0, resource.BufferAfter < 0:
+		return fmt.Errorf("%w: buffers must not be negative", ErrInvalidResource)
+	}
+	return nil
+}
+
+// Buffer returns the changeover time kept free around the resource's bookings.
+func (resource *Resource) Buffer() booking.Buffer {
+	return booking.Buffer{Before: resource.BufferBefore, After: resource.BufferAfter}
+}
+
+// TakeOutOfService stops the resource from being booked.
+func (resource *Resource) TakeOutOfService(reason string) error {
+	if !resource.Active {
+		return ErrResourceOutOfService
+	}
+	resource.Active = false
+	resource.OutOfServiceReason = strings.TrimSpace(reason)
+	resource.UpdatedAt = time.Now()
+	return nil
+}
+
+// ReturnToService makes the resource bookable again. It reports whether the resource was
+// out of service.
+func (resource *Resource) ReturnToService() bool {
+	if resource.Active {
+		return false
+	}
+	resource.Active = true
+	resource.OutOfServiceReason = ""
+	resource.UpdatedAt = time.Now()
+	return true
+}
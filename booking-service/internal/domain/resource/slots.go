@@ -0,0 +1,83 @@
+package resource
+
+import (
+	"slices"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// Slot is a stretch of time from Start up to, but not including, End during which Free
+// more bookings of a resource fit.
+type Slot struct {
+	Start time.Time
+	End   time.Time
+	Free  int
+}
+
+// Slots divides [from, to) by how many more bookings fit next to bookings, earliest first.
+// A booking takes its place for the resource's buffer around it as well, so a stretch has
+// a place free only if a booking of it would keep the buffer from every other. Adjacent
+// stretches with as many free places are joined, and full ones left out. An out-of-service
+// resource has no slots.
+func (resource *Resource) Slots(bookings []*booking.Booking, from, to time.Time) []Slot {
+	if !resource.Active {
+		return nil
+	}
+
+	buffer := resource.Buffer()
+	boundaries := []time.Time{from, to}
+	for _, bookingRecord := range bookings {
+		blockedStart, blockedEnd := buffer.Blocks(bookingRecord)
+		for _, boundary := range []time.Time{blockedStart, blockedEnd} {
+			if boundary.After(from) && boundary.Before(to) {
+				boundaries = append(boundaries, boundary)
+			}
+		}
+	}
+	slices.SortFunc(boundaries, func(a, b time.Time) int { return a.Compare(b) })
+	boundaries = slices.CompactFunc(boundaries, time.Time.Equal)
+
+	var slots []Slot
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		free := resource.Capacity - overlapping(bookings, buffer, start, end)
+		if free <= 0 {
+			continue
+		}
+		if last := len(slots) - 1; last >= 0 && slots[last].End.Equal(start) && slots[last].Free == free {
+			slots[last].End = end
+			continue
+		}
+		slots = append(slots, Slot{Start: start, End: end, Free: free})
+	}
+	return slots
+}
+
+// Fits reports whether one more booking of [start, end) fits next to bookings: at no
+// moment in between would the resource hold more than its capacity.
+func (resource *Resource) Fits(bookings []*booking.Booking, start, end time.Time) bool {
+	slots := resource.Slots(bookings, start, end)
+	if len(slots) == 0 || !slots[0].Start.Equal(start) || !slots[len(slots)-1].End.Equal(end) {
+		return false
+	}
+	for i := 1; i < len(slots); i++ {
+		if !slots[i].Start.Equal(slots[i-1].End) {
+			return false
+		}
+	}
+	return true
+}
+
+// overlapping counts the bookings blocking [start, end), which lies between two
+// consecutive boundaries so that every booking blocks either all of it or none.
+func overlapping(bookings []*booking.Booking, buffer booking.Buffer, start, end time.Time) int {
+	count := 0
+	for _, bookingRecord := range bookings {
+		blockedStart, blockedEnd := buffer.Blocks(bookingRecord)
+		if blockedStart.Before(end) && blockedEnd.After(start) {
+			count++
+		}
+	}
+	return count
+}
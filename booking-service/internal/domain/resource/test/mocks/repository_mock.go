@@ -0,0 +1,78 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+type MockRepository struct {
+	mu        sync.RWMutex
+	resources map[string]*resource.Resource
+}
+
+// NewMockRepository returns a repository holding resources.
+func NewMockRepository(resources ...*resource.Resource) *MockRepository {
+	repo := &MockRepository{
+		resources: make(map[string]*resource.Resource),
+	}
+	for _, resourceRecord := range resources {
+		repo.Create(context.Background(), resourceRecord)
+	}
+	return repo
+}
+
+func (repo *MockRepository) Create(ctx context.Context, resourceRecord *resource.Resource) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	stored := *resourceRecord
+	repo.resources[resourceRecord.ID] = &stored
+	return nil
+}
+
+func (repo *MockRepository) GetByID(ctx context.Context, id string) (*resource.Resource, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	resourceRecord, exists := repo.resources[id]
+	if !exists {
+		return nil, resource.ErrResourceNotFound
+	}
+	copied := *resourceRecord
+	return &copied, nil
+}
+
+func (repo *MockRepository) Update(ctx context.Context, resourceRecord *resource.Resource) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if _, exists := repo.resources[resourceRecord.ID]; !exists {
+		return resource.ErrResourceNotFound
+	}
+	stored := *resourceRecord
+	repo.resources[resourceRecord.ID] = &stored
+	return nil
+}
+
+func (repo *MockRepository) ListByGymID(ctx context.Context, gymID, resourceType string) ([]*resource.Resource, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*resource.Resource
+	for _, resourceRecord := range repo.resources {
+		if resourceRecord.GymID == gymID && (resourceType == "" || resourceRecord.Type == resourceType) {
+			copied := *resourceRecord
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
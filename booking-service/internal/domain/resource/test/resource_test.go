@@ -0,0 +1,104 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+var day = time.Date(2030, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+func at(hour int) time.Time {
+	return day.Add(time.Duration(hour) * time.Hour)
+}
+
+func booked(startHour, endHour int) *booking.Booking {
+	return &booking.Booking{StartTime: at(startHour), EndTime: at(endHour), Status: booking.StatusConfirmed}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*resource.Resource)
+		valid  bool
+	}{
+		{name: "valid", modify: func(*resource.Resource) {}, valid: true},
+		{name: "blank name", modify: func(resourceRecord *resource.Resource) { resourceRecord.Name = " " }},
+		{name: "no type", modify: func(resourceRecord *resource.Resource) { resourceRecord.Type = "" }},
+		{name: "type with spaces", modify: func(resourceRecord *resource.Resource) { resourceRecord.Type = "squat rack" }},
+		{name: "uppercase type", modify: func(resourceRecord *resource.Resource) { resourceRecord.Type = "Court" }},
+		{name: "no capacity", modify: func(resourceRecord *resource.Resource) { resourceRecord.Capacity = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceRecord := &resource.Resource{Name: "Rack 1", Type: "squat_rack", Capacity: 2, Active: true}
+			tt.modify(resourceRecord)
+
+			err := resourceRecord.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, resource.ErrInvalidResource)
+			}
+		})
+	}
+}
+
+func TestOutOfService(t *testing.T) {
+	resourceRecord := &resource.Resource{Name: "Court 1", Type: "court", Capacity: 1, Active: true}
+
+	require.NoError(t, resourceRecord.TakeOutOfService(" flooded "))
+	assert.False(t, resourceRecord.Active)
+	assert.Equal(t, "flooded", resourceRecord.OutOfServiceReason)
+	assert.ErrorIs(t, resourceRecord.TakeOutOfService("again"), resource.ErrResourceOutOfService)
+
+	assert.True(t, resourceRecord.ReturnToService())
+	assert.True(t, resourceRecord.Active)
+	assert.Empty(t, resourceRecord.OutOfServiceReason)
+	assert.False(t, resourceRecord.ReturnToService(), "the resource is in service already")
+}
+
+func TestSlots(t *testing.T) {
+	resourceRecord := &resource.Resource{Capacity: 2, Active: true}
+	bookings := []*booking.Booking{booked(9, 11), booked(10, 12), booked(14, 15)}
+
+	assert.Equal(t, []resource.Slot{
+		{Start: at(8), End: at(9), Free: 2},
+		{Start: at(9), End: at(10), Free: 1},
+		{Start: at(11), End: at(12), Free: 1},
+		{Start: at(12), End: at(14), Free: 2},
+		{Start: at(14), End: at(15), Free: 1},
+		{Start: at(15), End: at(16), Free: 2},
+	}, resourceRecord.Slots(bookings, at(8), at(16)))
+
+	resourceRecord.Active = false
+	assert.Empty(t, resourceRecord.Slots(bookings, at(8), at(16)), "an out-of-service resource has no places free")
+}
+
+func TestFits(t *testing.T) {
+	resourceRecord := &resource.Resource{Capacity: 2, Active: true}
+	bookings := []*booking.Booking{booked(9, 11), booked(10, 12)}
+
+	assert.True(t, resourceRecord.Fits(bookings, at(8), at(10)))
+	assert.True(t, resourceRecord.Fits(bookings, at(11), at(13)))
+	assert.False(t, resourceRecord.Fits(bookings, at(10), at(11)), "both places are taken from 10 to 11")
+	assert.False(t, resourceRecord.Fits(bookings, at(8), at(13)), "the booking would pass through the full hour")
+}
+
+func TestSlotsKeepBuffers(t *testing.T) {
+	resourceRecord := &resource.Resource{Capacity: 1, Active: true, BufferBefore: 30 * time.Minute, BufferAfter: 30 * time.Minute}
+	bookings := []*booking.Booking{booked(10, 11)}
+
+	assert.Equal(t, []resource.Slot{
+		{Start: at(8), End: at(9), Free: 1},
+		{Start: at(12), End: at(14), Free: 1},
+	}, resourceRecord.Slots(bookings, at(8), at(14)))
+
+	assert.True(t, resourceRecord.Fits(bookings, at(12), at(13)))
+	assert.False(t, resourceRecord.Fits(bookings, at(11), at(12)), "the changeover after the booking takes the place")
+}
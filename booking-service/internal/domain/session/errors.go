@@ -0,0 +1,14 @@
+package session
+
+import "errors"
+
+var (
+	ErrSessionNotFound         = errors.New("class session not found")
+	ErrInvalidSession          = errors.New("invalid class session")
+	ErrSessionCancelled        = errors.New("class session is cancelled")
+	ErrSessionFull             = errors.New("class session is full")
+	ErrAlreadyEnrolled         = errors.New("member is already enrolled in the class session")
+	ErrSessionMismatch         = errors.New("booking does not match its class session")
+	ErrCapacityBelowEnrollment = errors.New("capacity is below the number of enrolled members")
+	ErrSessionHasEnrollments   = errors.New("class session times cannot change while members are enrolled")
+)
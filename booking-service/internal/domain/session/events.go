@@ -0,0 +1,35 @@
+package session
+
+import "time"
+
+// ClassSessionCancelledEvent reports a cancelled session. The cancellation of each of its
+// enrollments is published as a booking event of its own.
+type ClassSessionCancelledEvent struct {
+	SessionID      string
+	GymID          string
+	StartTime      time.Time
+	EndTime        time.Time
+	Reason         string
+	Enrollments    int
+	OccurredAtTime time.Time
+}
+
+func NewClassSessionCancelledEvent(classSession *ClassSession, enrollments int) ClassSessionCancelledEvent {
+	return ClassSessionCancelledEvent{
+		SessionID:      classSession.ID,
+		GymID:          classSession.GymID,
+		StartTime:      classSession.StartTime,
+		EndTime:        classSession.EndTime,
+		Reason:         classSession.CancelReason,
+		Enrollments:    enrollments,
+		OccurredAtTime: time.Now(),
+	}
+}
+
+func (event ClassSessionCancelledEvent) EventName() string {
+	return "class_session.cancelled"
+}
+
+func (event ClassSessionCancelledEvent) OccurredAt() time.Time {
+	return event.OccurredAtTime
+}
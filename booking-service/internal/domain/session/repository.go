@@ -0,0 +1,19 @@
+package session
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, classSession *ClassSession) error
+	// GetByID returns the session, or ErrSessionNotFound.
+	GetByID(ctx context.Context, id string) (*ClassSession, error)
+	Update(ctx context.Context, classSession *ClassSession) error
+	// ListByGymID returns the gym's sessions, cancelled ones included, starting within
+	// [startTime, endTime), earliest first.
+	ListByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*ClassSession, error)
+	// ListByTemplateID returns the sessions generated from the template, cancelled ones
+	// included, for dates from firstDate on, earliest first.
+	ListByTemplateID(ctx context.Context, templateID string, firstDate time.Time) ([]*ClassSession, error)
+}
@@ -0,0 +1,99 @@
+// Package session models instructor-led classes that members enroll in. An enrollment is a
+// booking that references its session, and counts against the session's capacity instead
+// of competing with other bookings for the gym.
+package session
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusScheduled Status = "SCHEDULED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// MaxTitleLength bounds a session's title, in characters.
+const MaxTitleLength = 200
+
+type ClassSession struct {
+	ID           string
+	GymID        string
+	InstructorID string
+	Title        string
+	StartTime    time.Time
+	EndTime      time.Time
+	// Capacity is how many members may be enrolled at once.
+	Capacity int
+	Status   Status
+	// CancelReason explains why a cancelled session was cancelled, when recorded.
+	CancelReason string
+	// TemplateID is the timetable template the session was generated from, if any, and
+	// OccurrenceDate the date of the template it was generated for, as midnight UTC.
+	TemplateID     string
+	OccurrenceDate time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewClassSession(gymID, instructorID, title string, startTime, endTime time.Time, capacity int) (*ClassSession, error) {
+	now := time.Now()
+	classSession := &ClassSession{
+		GymID:     gymID,
+		Status:    StatusScheduled,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := classSession.Reschedule(instructorID, title, startTime, endTime, capacity, 0); err != nil {
+		return nil, err
+	}
+	return classSession, nil
+}
+
+// Reschedule replaces the session's details. enrolled is the number of members enrolled:
+// capacity cannot drop below it, and while anyone is enrolled the session's times are fixed,
+// since they are the times of their bookings.
+func (classSession *ClassSession) Reschedule(instructorID, title string, startTime, endTime time.Time, capacity, enrolled int) error {
+	if classSession.Status == StatusCancelled {
+		return ErrSessionCancelled
+	}
+	title = strings.TrimSpace(title)
+	switch {
+	case title == "":
+		return fmt.Errorf("%w: title is required", ErrInvalidSession)
+	case len([]rune(title)) > MaxTitleLength:
+		return fmt.Errorf("%w: title must be at most %d characters", ErrInvalidSession, MaxTitleLength)
+	case !startTime.Before(endTime):
+		return fmt.Errorf("%w: start time must be before end time", ErrInvalidSession)
+	case !startTime.Equal(classSession.StartTime) && startTime.Before(time.Now()):
+		return fmt.Errorf("%w: start time must be in the future", ErrInvalidSession)
+	case capacity < 1:
+		return fmt.Errorf("%w: capacity must be at least 1", ErrInvalidSession)
+	case capacity < enrolled:
+		return fmt.Errorf("%w: %d members are enrolled", ErrCapacityBelowEnrollment, enrolled)
+	case enrolled > 0 && (!startTime.Equal(classSession.StartTime) || !endTime.Equal(classSession.EndTime)):
+		return fmt.Errorf("%w: %d members are enrolled", ErrSessionHasEnrollments, enrolled)
+	}
+
+	classSession.InstructorID = instructorID
+	classSession.Title = title
+	classSession.StartTime = startTime
+	classSession.EndTime = endTime
+	classSession.Capacity = capacity
+	classSession.UpdatedAt = time.Now()
+	return nil
+}
+
+// Cancel calls the session off. Its enrollments have to be cancelled separately.
+func (classSession *ClassSession) Cancel(reason string) error {
+	if classSession.Status == StatusCancelled {
+		return ErrSessionCancelled
+	}
+	classSession.Status = StatusCancelled
+	classSession.CancelReason = reason
+	classSession.UpdatedAt = time.Now()
+	return nil
+}
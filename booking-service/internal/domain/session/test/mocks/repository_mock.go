@@ -0,0 +1,86 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+type MockRepository struct {
+	mu       sync.RWMutex
+	sessions map[string]*session.ClassSession
+}
+
+// NewMockRepository returns a repository holding sessions.
+func NewMockRepository(sessions ...*session.ClassSession) *MockRepository {
+	repo := &MockRepository{
+		sessions: make(map[string]*session.ClassSession),
+	}
+	for _, classSession := range sessions {
+		repo.Create(context.Background(), classSession)
+	}
+	return repo
+}
+
+func (repo *MockRepository) Create(ctx context.Context, classSession *session.ClassSession) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	stored := *classSession
+	repo.sessions[classSession.ID] = &stored
+	return nil
+}
+
+func (repo *MockRepository) GetByID(ctx context.Context, id string) (*session.ClassSession, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	classSession, exists := repo.sessions[id]
+	if !exists {
+		return nil, session.ErrSessionNotFound
+	}
+	copied := *classSession
+	return &copied, nil
+}
+
+func (repo *MockRepository) Update(ctx context.Context, classSession *session.ClassSession) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	stored := *classSession
+	repo.sessions[classSession.ID] = &stored
+	return nil
+}
+
+func (repo *MockRepository) ListByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*session.ClassSession, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*session.ClassSession
+	for _, classSession := range repo.sessions {
+		if classSession.GymID == gymID && !classSession.StartTime.Before(startTime) && classSession.StartTime.Before(endTime) {
+			copied := *classSession
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].StartTime.Before(result[j].StartTime) })
+	return result, nil
+}
+
+func (repo *MockRepository) ListByTemplateID(ctx context.Context, templateID string, firstDate time.Time) ([]*session.ClassSession, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*session.ClassSession
+	for _, classSession := range repo.sessions {
+		if classSession.TemplateID == templateID && !classSession.OccurrenceDate.Before(firstDate) {
+			copied := *classSession
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].OccurrenceDate.Before(result[j].OccurrenceDate) })
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+func TestNewClassSession(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		title    string
+		start    time.Time
+		end      time.Time
+		capacity int
+		err      error
+	}{
+		{name: "valid", title: "Spin", start: start, end: end, capacity: 12},
+		{name: "blank title", title: "  ", start: start, end: end, capacity: 12, err: session.ErrInvalidSession},
+		{name: "title too long", title: strings.Repeat("a", session.MaxTitleLength+1), start: start, end: end, capacity: 12, err: session.ErrInvalidSession},
+		{name: "end before start", title: "Spin", start: end, end: start, capacity: 12, err: session.ErrInvalidSession},
+		{name: "in the past", title: "Spin", start: time.Now().Add(-time.Hour), end: time.Now(), capacity: 12, err: session.ErrInvalidSession},
+		{name: "no capacity", title: "Spin", start: start, end: end, capacity: 0, err: session.ErrInvalidSession},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classSession, err := session.NewClassSession("gym1", "instructor1", tt.title, tt.start, tt.end, tt.capacity)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, session.StatusScheduled, classSession.Status)
+			assert.Equal(t, tt.capacity, classSession.Capacity)
+		})
+	}
+}
+
+func TestRescheduleWithEnrollments(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	end := start.Add(time.Hour)
+	classSession, err := session.NewClassSession("gym1", "instructor1", "Spin", start, end, 10)
+	require.NoError(t, err)
+
+	err = classSession.Reschedule("instructor1", "Spin", start.Add(time.Hour), end.Add(time.Hour), 10, 3)
+	assert.ErrorIs(t, err, session.ErrSessionHasEnrollments)
+
+	err = classSession.Reschedule("instructor1", "Spin", start, end, 2, 3)
+	assert.ErrorIs(t, err, session.ErrCapacityBelowEnrollment)
+
+	require.NoError(t, classSession.Reschedule("instructor2", "Spin Advanced", start, end, 3, 3))
+	assert.Equal(t, "instructor2", classSession.InstructorID)
+	assert.Equal(t, 3, classSession.Capacity)
+
+	require.NoError(t, classSession.Reschedule("instructor2", "Spin Advanced", start.Add(time.Hour), end.Add(time.Hour), 3, 0))
+	assert.Equal(t, start.Add(time.Hour), classSession.StartTime)
+}
+
+func TestCancel(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour)
+	classSession, err := session.NewClassSession("gym1", "", "Yoga", start, start.Add(time.Hour), 5)
+	require.NoError(t, err)
+
+	require.NoError(t, classSession.Cancel("instructor ill"))
+	assert.Equal(t, session.StatusCancelled, classSession.Status)
+	assert.Equal(t, "instructor ill", classSession.CancelReason)
+
+	assert.ErrorIs(t, classSession.Cancel("again"), session.ErrSessionCancelled)
+	assert.ErrorIs(t, classSession.Reschedule("", "Yoga", start, start.Add(time.Hour), 5, 0), session.ErrSessionCancelled)
+}
@@ -0,0 +1,23 @@
+package strike
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrBookingSuspended = errors.New("booking suspended")
+
+// SuspendedError reports that a member cannot book at a gym until Until. It matches
+// ErrBookingSuspended.
+type SuspendedError struct {
+	GymID string
+	Until time.Time
+}
+
+func (err *SuspendedError) Error() string {
+	return "booking suspended until " + err.Until.UTC().Format(time.RFC3339) + " after too many late cancellations and no-shows"
+}
+
+func (err *SuspendedError) Unwrap() error {
+	return ErrBookingSuspended
+}
@@ -0,0 +1,18 @@
+package strike
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Add records a strike. A booking earns at most one strike per reason; adding another
+	// is a no-op.
+	Add(ctx context.Context, strike *Strike) error
+	// ListActive returns the uncleared strikes of userID at gymID created at or after since,
+	// oldest first.
+	ListActive(ctx context.Context, userID, gymID string, since time.Time) ([]*Strike, error)
+	// Clear marks every uncleared strike of userID at gymID as cleared by clearedBy at at,
+	// and returns how many it cleared.
+	Clear(ctx context.Context, userID, gymID, clearedBy string, at time.Time) (int, error)
+}
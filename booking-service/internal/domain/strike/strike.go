@@ -0,0 +1,87 @@
+// Package strike records late cancellations and no-shows against members, and works out
+// when too many of them suspend a member from booking at a gym.
+package strike
+
+import (
+	"sort"
+	"time"
+)
+
+// Reason is what a member earned a strike for.
+type Reason string
+
+const (
+	ReasonLateCancellation Reason = "LATE_CANCELLATION"
+	ReasonNoShow           Reason = "NO_SHOW"
+)
+
+// Strike is one late cancellation or no-show of a member at a gym. Cleared strikes no longer
+// count.
+type Strike struct {
+	ID        string
+	UserID    string
+	GymID     string
+	BookingID string
+	Reason    Reason
+	CreatedAt time.Time
+	ClearedAt *time.Time
+	ClearedBy string
+}
+
+func NewStrike(userID, gymID, bookingID string, reason Reason, at time.Time) *Strike {
+	return &Strike{
+		UserID:    userID,
+		GymID:     gymID,
+		BookingID: bookingID,
+		Reason:    reason,
+		CreatedAt: at,
+	}
+}
+
+// Rules are a gym's limits on strikes. A member collecting more than MaxStrikes strikes
+// within Window is suspended for Suspension after the last of them. A zero MaxStrikes never
+// suspends anyone.
+type Rules struct {
+	MaxStrikes int
+	Window     time.Duration
+	Suspension time.Duration
+}
+
+// Enabled reports whether the rules can suspend members.
+func (rules Rules) Enabled() bool {
+	return rules.MaxStrikes > 0 && rules.Window > 0 && rules.Suspension > 0
+}
+
+// Lookback is how far back strikes can still affect whether a member is suspended now.
+func (rules Rules) Lookback() time.Duration {
+	return rules.Window + rules.Suspension
+}
+
+// SuspendedUntil returns the end of the latest suspension strikes earn under the rules, and
+// whether it is still running at now. strikes are the member's uncleared strikes at one gym
+// since now minus Lookback; older ones are ignored.
+func (rules Rules) SuspendedUntil(strikes []*Strike, now time.Time) (time.Time, bool) {
+	if !rules.Enabled() {
+		return time.Time{}, false
+	}
+
+	times := make([]time.Time, 0, len(strikes))
+	for _, strike := range strikes {
+		if strike.ClearedAt == nil && !strike.CreatedAt.Before(now.Add(-rules.Lookback())) {
+			times = append(times, strike.CreatedAt)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	var until time.Time
+	first := 0
+	for last, at := range times {
+		for !times[first].After(at.Add(-rules.Window)) {
+			first++
+		}
+		if last-first+1 > rules.MaxStrikes {
+			until = at.Add(rules.Suspension)
+		}
+	}
+	return until, until.After(now)
+}
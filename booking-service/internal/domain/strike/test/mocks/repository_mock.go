@@ -0,0 +1,72 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+)
+
+type MockRepository struct {
+	mu      sync.RWMutex
+	strikes []*strike.Strike
+}
+
+func NewMockRepository() *MockRepository {
+	return &MockRepository{}
+}
+
+func (repo *MockRepository) Add(ctx context.Context, record *strike.Strike) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	for _, existing := range repo.strikes {
+		if existing.BookingID == record.BookingID && existing.Reason == record.Reason {
+			return nil
+		}
+	}
+	stored := *record
+	repo.strikes = append(repo.strikes, &stored)
+	return nil
+}
+
+func (repo *MockRepository) ListActive(ctx context.Context, userID, gymID string, since time.Time) ([]*strike.Strike, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*strike.Strike
+	for _, record := range repo.strikes {
+		if record.UserID == userID && record.GymID == gymID && record.ClearedAt == nil && !record.CreatedAt.Before(since) {
+			stored := *record
+			result = append(result, &stored)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result, nil
+}
+
+func (repo *MockRepository) Clear(ctx context.Context, userID, gymID, clearedBy string, at time.Time) (int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	cleared := 0
+	for _, record := range repo.strikes {
+		if record.UserID == userID && record.GymID == gymID && record.ClearedAt == nil {
+			clearedAt := at
+			record.ClearedAt = &clearedAt
+			record.ClearedBy = clearedBy
+			cleared++
+		}
+	}
+	return cleared, nil
+}
+
+// All returns every strike recorded, cleared or not.
+func (repo *MockRepository) All() []*strike.Strike {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	return append([]*strike.Strike(nil), repo.strikes...)
+}
@@ -0,0 +1,49 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+)
+
+func TestRulesSuspendedUntil(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	rules := strike.Rules{MaxStrikes: 2, Window: 7 * 24 * time.Hour, Suspension: 3 * 24 * time.Hour}
+	daysAgo := func(days ...float64) []*strike.Strike {
+		strikes := make([]*strike.Strike, len(days))
+		for i, day := range days {
+			strikes[i] = strike.NewStrike("user1", "gym1", "booking", strike.ReasonNoShow, now.Add(-time.Duration(day*24)*time.Hour))
+		}
+		return strikes
+	}
+
+	tests := []struct {
+		name      string
+		rules     strike.Rules
+		strikes   []*strike.Strike
+		until     time.Time
+		suspended bool
+	}{
+		{name: "at the limit", rules: rules, strikes: daysAgo(1, 2)},
+		{name: "over the limit", rules: rules, strikes: daysAgo(2, 1, 3), until: now.Add(2 * 24 * time.Hour), suspended: true},
+		{name: "spread wider than the window", rules: rules, strikes: daysAgo(9, 5, 1)},
+		{name: "suspension over", rules: rules, strikes: daysAgo(6, 5, 4), until: now.Add(-24 * time.Hour)},
+		{name: "latest suspension wins", rules: rules, strikes: daysAgo(6, 5, 4, 1), until: now.Add(2 * 24 * time.Hour), suspended: true},
+		{name: "rules off", rules: strike.Rules{}, strikes: daysAgo(1, 1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until, suspended := tt.rules.SuspendedUntil(tt.strikes, now)
+			assert.Equal(t, tt.suspended, suspended)
+			assert.Equal(t, tt.until, until)
+		})
+	}
+
+	cleared := daysAgo(1, 2, 3)
+	cleared[0].ClearedAt = &now
+	_, suspended := rules.SuspendedUntil(cleared, now)
+	assert.False(t, suspended, "cleared strikes do not count")
+}
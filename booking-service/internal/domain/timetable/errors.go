@@ -0,0 +1,8 @@
+package timetable
+
+import "errors"
+
+var (
+	ErrTemplateNotFound = errors.New("timetable template not found")
+	ErrInvalidTemplate  = errors.New("invalid timetable template")
+)
@@ -0,0 +1,13 @@
+package timetable
+
+import "context"
+
+type Repository interface {
+	Create(ctx context.Context, template *Template) error
+	GetByID(ctx context.Context, id string) (*Template, error)
+	Update(ctx context.Context, template *Template) error
+	// ListByGymID returns the gym's templates ordered by weekday and start time.
+	ListByGymID(ctx context.Context, gymID string) ([]*Template, error)
+	// List returns every template of every gym, ended ones included.
+	List(ctx context.Context) ([]*Template, error)
+}
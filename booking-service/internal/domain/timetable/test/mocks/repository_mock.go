@@ -0,0 +1,87 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+type MockRepository struct {
+	mu        sync.RWMutex
+	templates map[string]*timetable.Template
+}
+
+// NewMockRepository returns a repository holding templates.
+func NewMockRepository(templates ...*timetable.Template) *MockRepository {
+	repo := &MockRepository{
+		templates: make(map[string]*timetable.Template),
+	}
+	for _, template := range templates {
+		repo.Create(context.Background(), template)
+	}
+	return repo
+}
+
+func (repo *MockRepository) Create(ctx context.Context, template *timetable.Template) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	stored := *template
+	repo.templates[template.ID] = &stored
+	return nil
+}
+
+func (repo *MockRepository) GetByID(ctx context.Context, id string) (*timetable.Template, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	template, exists := repo.templates[id]
+	if !exists {
+		return nil, timetable.ErrTemplateNotFound
+	}
+	copied := *template
+	return &copied, nil
+}
+
+func (repo *MockRepository) Update(ctx context.Context, template *timetable.Template) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if _, exists := repo.templates[template.ID]; !exists {
+		return timetable.ErrTemplateNotFound
+	}
+	stored := *template
+	repo.templates[template.ID] = &stored
+	return nil
+}
+
+func (repo *MockRepository) ListByGymID(ctx context.Context, gymID string) ([]*timetable.Template, error) {
+	templates, _ := repo.List(ctx)
+	var result []*timetable.Template
+	for _, template := range templates {
+		if template.GymID == gymID {
+			result = append(result, template)
+		}
+	}
+	return result, nil
+}
+
+func (repo *MockRepository) List(ctx context.Context) ([]*timetable.Template, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*timetable.Template
+	for _, template := range repo.templates {
+		copied := *template
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Weekday != result[j].Weekday {
+			return result[i].Weekday < result[j].Weekday
+		}
+		return result[i].StartOfDay < result[j].StartOfDay
+	})
+	return result, nil
+}
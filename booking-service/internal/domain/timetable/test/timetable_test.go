@@ -0,0 +1,91 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func validTemplate() *timetable.Template {
+	return &timetable.Template{
+		GymID:      "gym1",
+		Weekday:    time.Monday,
+		StartOfDay: 18 * time.Hour,
+		Duration:   time.Hour,
+		Title:      "Spin",
+		Capacity:   12,
+		ValidFrom:  date(2025, 3, 1),
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*timetable.Template)
+		valid  bool
+	}{
+		{name: "valid", modify: func(*timetable.Template) {}, valid: true},
+		{name: "start past midnight", modify: func(template *timetable.Template) { template.StartOfDay = 24 * time.Hour }},
+		{name: "start between minutes", modify: func(template *timetable.Template) { template.StartOfDay = 18*time.Hour + 30*time.Second }},
+		{name: "no duration", modify: func(template *timetable.Template) { template.Duration = 0 }},
+		{name: "blank title", modify: func(template *timetable.Template) { template.Title = " " }},
+		{name: "no capacity", modify: func(template *timetable.Template) { template.Capacity = 0 }},
+		{name: "no valid from", modify: func(template *timetable.Template) { template.ValidFrom = time.Time{} }},
+		{name: "ends before it starts", modify: func(template *timetable.Template) { template.ValidUntil = date(2025, 2, 28) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := validTemplate()
+			tt.modify(template)
+			err := template.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, timetable.ErrInvalidTemplate)
+			}
+		})
+	}
+}
+
+func TestDates(t *testing.T) {
+	template := validTemplate()
+	template.ValidUntil = date(2025, 3, 24)
+
+	// March 1st 2025 is a Saturday.
+	dates := template.Dates(date(2025, 2, 1), date(2025, 4, 30))
+	assert.Equal(t, []time.Time{date(2025, 3, 3), date(2025, 3, 10), date(2025, 3, 17), date(2025, 3, 24)}, dates)
+
+	assert.Equal(t, []time.Time{date(2025, 3, 10)}, template.Dates(date(2025, 3, 10), date(2025, 3, 16)))
+
+	template.End(date(2025, 3, 9))
+	assert.Equal(t, []time.Time{date(2025, 3, 3)}, template.Dates(date(2025, 3, 1), date(2025, 4, 30)))
+}
+
+func TestTimesKeepWallClockAcrossDaylightSaving(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	template := validTemplate()
+
+	// Clocks go forward on Sunday March 30th 2025 in Berlin.
+	before, _ := template.Times(date(2025, 3, 24), location)
+	after, end := template.Times(date(2025, 3, 31), location)
+
+	assert.Equal(t, time.Date(2025, 3, 24, 17, 0, 0, 0, time.UTC), before.UTC())
+	assert.Equal(t, time.Date(2025, 3, 31, 16, 0, 0, 0, time.UTC), after.UTC())
+	assert.Equal(t, time.Hour, end.Sub(after))
+}
+
+func TestDateOf(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	assert.Equal(t, date(2025, 3, 2), timetable.DateOf(time.Date(2025, 3, 3, 2, 0, 0, 0, time.UTC), location))
+}
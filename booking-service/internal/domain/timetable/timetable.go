@@ -0,0 +1,97 @@
+// Package timetable holds the weekly class timetables of gyms. Each template describes one
+// class held every week, from which concrete class sessions are generated ahead of time.
+package timetable
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+// Template is a class a gym holds every week on the same day at the same local time.
+type Template struct {
+	ID      string
+	GymID   string
+	Weekday time.Weekday
+	// StartOfDay is when its sessions start, as the wall-clock time past midnight in the
+	// gym's time zone.
+	StartOfDay   time.Duration
+	Duration     time.Duration
+	InstructorID string
+	Title        string
+	Capacity     int
+	// ValidFrom and ValidUntil are the first and last dates sessions are held on, as
+	// midnight UTC. A zero ValidUntil never ends.
+	ValidFrom  time.Time
+	ValidUntil time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validate reports the first detail sessions cannot be generated from.
+func (template *Template) Validate() error {
+	template.Title = strings.TrimSpace(template.Title)
+	switch {
+	case template.Weekday < time.Sunday || template.Weekday > time.Saturday:
+		return fmt.Errorf("%w: unknown weekday", ErrInvalidTemplate)
+	case template.StartOfDay < 0 || template.StartOfDay >= 24*time.Hour || template.StartOfDay%time.Minute != 0:
+		return fmt.Errorf("%w: start time must be a whole minute of the day", ErrInvalidTemplate)
+	case template.Duration <= 0 || template.Duration > 24*time.Hour || template.Duration%time.Minute != 0:
+		return fmt.Errorf("%w: duration must be whole minutes, at most a day", ErrInvalidTemplate)
+	case template.Title == "":
+		return fmt.Errorf("%w: title is required", ErrInvalidTemplate)
+	case len([]rune(template.Title)) > session.MaxTitleLength:
+		return fmt.Errorf("%w: title must be at most %d characters", ErrInvalidTemplate, session.MaxTitleLength)
+	case template.Capacity < 1:
+		return fmt.Errorf("%w: capacity must be at least 1", ErrInvalidTemplate)
+	case template.ValidFrom.IsZero():
+		return fmt.Errorf("%w: valid from is required", ErrInvalidTemplate)
+	case !template.ValidUntil.IsZero() && template.ValidUntil.Before(template.ValidFrom):
+		return fmt.Errorf("%w: valid until must not be before valid from", ErrInvalidTemplate)
+	}
+	return nil
+}
+
+// End stops the template after lastDate. Ending it before ValidFrom leaves it without
+// any dates.
+func (template *Template) End(lastDate time.Time) {
+	if template.ValidUntil.IsZero() || lastDate.Before(template.ValidUntil) {
+		template.ValidUntil = lastDate
+		template.UpdatedAt = time.Now()
+	}
+}
+
+// Dates returns the dates from first to last, inclusive, that the template holds a
+// session on.
+func (template *Template) Dates(first, last time.Time) []time.Time {
+	if first.Before(template.ValidFrom) {
+		first = template.ValidFrom
+	}
+	if !template.ValidUntil.IsZero() && last.After(template.ValidUntil) {
+		last = template.ValidUntil
+	}
+
+	offset := (int(template.Weekday) - int(first.Weekday()) + 7) % 7
+	var dates []time.Time
+	for date := first.AddDate(0, 0, offset); !date.After(last); date = date.AddDate(0, 0, 7) {
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// Times returns when the template's session on date starts and ends in location. The
+// wall-clock start stays the same across daylight saving changes.
+func (template *Template) Times(date time.Time, location *time.Location) (time.Time, time.Time) {
+	hour := int(template.StartOfDay / time.Hour)
+	minute := int(template.StartOfDay % time.Hour / time.Minute)
+	start := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, location)
+	return start, start.Add(template.Duration)
+}
+
+// DateOf returns the date of t in location, as midnight UTC.
+func DateOf(t time.Time, location *time.Location) time.Time {
+	local := t.In(location)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,16 @@
+package trainer
+
+import "errors"
+
+var (
+	ErrTrainerNotFound = errors.New("trainer not found")
+	ErrInvalidTrainer  = errors.New("invalid trainer")
+	ErrTimeOffNotFound = errors.New("trainer time off not found")
+	ErrInvalidTimeOff  = errors.New("invalid trainer time off")
+	// ErrTrainerUnavailable rejects appointments outside the trainer's weekly availability
+	// or during their time off.
+	ErrTrainerUnavailable = errors.New("trainer is not available at that time")
+	// ErrTrainerBooked rejects appointments, and time off, overlapping another appointment
+	// with the trainer.
+	ErrTrainerBooked = errors.New("trainer already has an appointment at that time")
+)
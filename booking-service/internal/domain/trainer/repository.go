@@ -0,0 +1,20 @@
+package trainer
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, trainer *Trainer) error
+	// GetByID returns the trainer with their weekly availability, or ErrTrainerNotFound.
+	GetByID(ctx context.Context, id string) (*Trainer, error)
+	// Update replaces the trainer's details and weekly availability.
+	Update(ctx context.Context, trainer *Trainer) error
+	AddTimeOff(ctx context.Context, timeOff *TimeOff) error
+	// DeleteTimeOff removes the trainer's time off, or returns ErrTimeOffNotFound.
+	DeleteTimeOff(ctx context.Context, trainerID, id string) error
+	// ListTimeOff returns the trainer's time off overlapping [startTime, endTime), earliest
+	// first.
+	ListTimeOff(ctx context.Context, trainerID string, startTime, endTime time.Time) ([]*TimeOff, error)
+}
@@ -0,0 +1,112 @@
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+type MockRepository struct {
+	mu       sync.RWMutex
+	trainers map[string]*trainer.Trainer
+	timeOff  map[string]*trainer.TimeOff
+}
+
+// NewMockRepository returns a repository holding trainers.
+func NewMockRepository(trainers ...*trainer.Trainer) *MockRepository {
+	repo := &MockRepository{
+		trainers: make(map[string]*trainer.Trainer),
+		timeOff:  make(map[string]*trainer.TimeOff),
+	}
+	for _, trainerRecord := range trainers {
+		repo.Create(context.Background(), trainerRecord)
+	}
+	return repo
+}
+
+// NewTrainer returns a trainer who works from start to end UTC every day.
+func NewTrainer(id string, start, end time.Duration) *trainer.Trainer {
+	trainerRecord := &trainer.Trainer{ID: id, Name: id, TimeZone: "UTC"}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		trainerRecord.Availability = append(trainerRecord.Availability, trainer.Window{Weekday: day, Start: start, End: end})
+	}
+	return trainerRecord
+}
+
+func (repo *MockRepository) Create(ctx context.Context, trainerRecord *trainer.Trainer) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	repo.trainers[trainerRecord.ID] = copyTrainer(trainerRecord)
+	return nil
+}
+
+func (repo *MockRepository) GetByID(ctx context.Context, id string) (*trainer.Trainer, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	trainerRecord, exists := repo.trainers[id]
+	if !exists {
+		return nil, trainer.ErrTrainerNotFound
+	}
+	return copyTrainer(trainerRecord), nil
+}
+
+func (repo *MockRepository) Update(ctx context.Context, trainerRecord *trainer.Trainer) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	if _, exists := repo.trainers[trainerRecord.ID]; !exists {
+		return trainer.ErrTrainerNotFound
+	}
+	repo.trainers[trainerRecord.ID] = copyTrainer(trainerRecord)
+	return nil
+}
+
+func (repo *MockRepository) AddTimeOff(ctx context.Context, timeOff *trainer.TimeOff) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	stored := *timeOff
+	repo.timeOff[timeOff.ID] = &stored
+	return nil
+}
+
+func (repo *MockRepository) DeleteTimeOff(ctx context.Context, trainerID, id string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	timeOff, exists := repo.timeOff[id]
+	if !exists || timeOff.TrainerID != trainerID {
+		return trainer.ErrTimeOffNotFound
+	}
+	delete(repo.timeOff, id)
+	return nil
+}
+
+func (repo *MockRepository) ListTimeOff(ctx context.Context, trainerID string, startTime, endTime time.Time) ([]*trainer.TimeOff, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var result []*trainer.TimeOff
+	for _, timeOff := range repo.timeOff {
+		if timeOff.TrainerID == trainerID && timeOff.StartTime.Before(endTime) && timeOff.EndTime.After(startTime) {
+			copied := *timeOff
+			result = append(result, &copied)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartTime.Before(result[j].StartTime)
+	})
+	return result, nil
+}
+
+func copyTrainer(trainerRecord *trainer.Trainer) *trainer.Trainer {
+	copied := *trainerRecord
+	copied.Availability = slices.Clone(trainerRecord.Availability)
+	return &copied
+}
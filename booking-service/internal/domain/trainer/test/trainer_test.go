@@ -0,0 +1,110 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+func validTrainer() *trainer.Trainer {
+	return &trainer.Trainer{
+		Name:     "Alex",
+		TimeZone: "Europe/Berlin",
+		Availability: []trainer.Window{
+			{Weekday: time.Tuesday, Start: 0, End: 2 * time.Hour},
+			{Weekday: time.Monday, Start: 9 * time.Hour, End: 12 * time.Hour},
+			{Weekday: time.Monday, Start: 22 * time.Hour, End: 24 * time.Hour},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*trainer.Trainer)
+		valid  bool
+	}{
+		{name: "valid", modify: func(*trainer.Trainer) {}, valid: true},
+		{name: "no availability", modify: func(trainerRecord *trainer.Trainer) { trainerRecord.Availability = nil }, valid: true},
+		{name: "blank name", modify: func(trainerRecord *trainer.Trainer) { trainerRecord.Name = " " }},
+		{name: "unknown time zone", modify: func(trainerRecord *trainer.Trainer) { trainerRecord.TimeZone = "Mars/Olympus" }},
+		{name: "no time zone", modify: func(trainerRecord *trainer.Trainer) { trainerRecord.TimeZone = "" }},
+		{name: "ends before it starts", modify: func(trainerRecord *trainer.Trainer) {
+			trainerRecord.Availability[0] = trainer.Window{Weekday: time.Friday, Start: 10 * time.Hour, End: 9 * time.Hour}
+		}},
+		{name: "ends past midnight", modify: func(trainerRecord *trainer.Trainer) {
+			trainerRecord.Availability[0] = trainer.Window{Weekday: time.Friday, Start: 22 * time.Hour, End: 25 * time.Hour}
+		}},
+		{name: "overlapping windows", modify: func(trainerRecord *trainer.Trainer) {
+			trainerRecord.Availability[0] = trainer.Window{Weekday: time.Monday, Start: 11 * time.Hour, End: 13 * time.Hour}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trainerRecord := validTrainer()
+			tt.modify(trainerRecord)
+			err := trainerRecord.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, trainer.ErrInvalidTrainer)
+			}
+		})
+	}
+}
+
+func TestAvailable(t *testing.T) {
+	trainerRecord := validTrainer()
+	require.NoError(t, trainerRecord.Validate())
+	berlin := trainerRecord.Location()
+
+	// Monday 10 March 2025 to Wednesday; the late Monday window runs on into Tuesday.
+	from := time.Date(2025, 3, 10, 10, 0, 0, 0, berlin)
+	to := time.Date(2025, 3, 12, 0, 0, 0, 0, berlin)
+	assert.Equal(t, []trainer.Interval{
+		{Start: from, End: time.Date(2025, 3, 10, 12, 0, 0, 0, berlin)},
+		{Start: time.Date(2025, 3, 10, 22, 0, 0, 0, berlin), End: time.Date(2025, 3, 11, 2, 0, 0, 0, berlin)},
+	}, trainerRecord.Available(from, to))
+
+	assert.True(t, trainerRecord.Covers(time.Date(2025, 3, 10, 23, 0, 0, 0, berlin), time.Date(2025, 3, 11, 1, 0, 0, 0, berlin)))
+	assert.False(t, trainerRecord.Covers(time.Date(2025, 3, 10, 11, 0, 0, 0, berlin), time.Date(2025, 3, 10, 13, 0, 0, 0, berlin)))
+}
+
+func TestAvailableKeepsWallClockAcrossDaylightSaving(t *testing.T) {
+	trainerRecord := validTrainer()
+	berlin := trainerRecord.Location()
+
+	// Clocks go forward on 30 March 2025; the Monday after still starts at 09:00 local.
+	intervals := trainerRecord.Available(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC))
+	require.Len(t, intervals, 1)
+	assert.Equal(t, time.Date(2025, 3, 31, 7, 0, 0, 0, time.UTC), intervals[0].Start.UTC())
+	assert.Equal(t, time.Date(2025, 3, 31, 12, 0, 0, 0, berlin), intervals[0].End)
+}
+
+func TestWithout(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2025, 3, 10, hour, 0, 0, 0, time.UTC) }
+	free := []trainer.Interval{{Start: at(9), End: at(12)}, {Start: at(14), End: at(18)}}
+
+	remaining := trainer.Without(free, []trainer.Interval{{Start: at(10), End: at(11)}, {Start: at(13), End: at(15)}})
+
+	assert.Equal(t, []trainer.Interval{
+		{Start: at(9), End: at(10)},
+		{Start: at(11), End: at(12)},
+		{Start: at(15), End: at(18)},
+	}, remaining)
+}
+
+func TestNewTimeOff(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+
+	timeOff, err := trainer.NewTimeOff("t1", start, start.Add(time.Hour), " holiday ")
+	require.NoError(t, err)
+	assert.Equal(t, "holiday", timeOff.Reason)
+
+	_, err = trainer.NewTimeOff("t1", start, start, "")
+	assert.ErrorIs(t, err, trainer.ErrInvalidTimeOff)
+}
@@ -0,0 +1,180 @@
+// Package trainer models personal trainers, who take 1:1 appointments with members. An
+// appointment is a booking that references its trainer. A trainer works across gyms, so
+// their appointments must not overlap wherever they are held.
+package trainer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxNameLength bounds a trainer's name, in characters.
+const MaxNameLength = 200
+
+// Window is a weekly stretch of time a trainer takes appointments in. Start and End are
+// wall-clock times past midnight in the trainer's time zone; an End of 24h runs to
+// midnight.
+type Window struct {
+	Weekday time.Weekday
+	Start   time.Duration
+	End     time.Duration
+}
+
+type Trainer struct {
+	ID   string
+	Name string
+	// TimeZone is the IANA name of the time zone the trainer's availability is set in.
+	TimeZone string
+	// Availability are the weekly windows the trainer takes appointments in, ordered by
+	// weekday and start.
+	Availability []Window
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Interval is a stretch of time from Start up to, but not including, End.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (interval Interval) Overlaps(start, end time.Time) bool {
+	return interval.Start.Before(end) && interval.End.After(start)
+}
+
+// Validate reports the first detail appointments cannot be taken with, and orders the
+// trainer's availability.
+func (trainer *Trainer) Validate() error {
+	trainer.Name = strings.TrimSpace(trainer.Name)
+	switch {
+	case trainer.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidTrainer)
+	case len([]rune(trainer.Name)) > MaxNameLength:
+		return fmt.Errorf("%w: name must be at most %d characters", ErrInvalidTrainer, MaxNameLength)
+	}
+	if _, err := time.LoadLocation(trainer.TimeZone); err != nil || trainer.TimeZone == "" {
+		return fmt.Errorf("%w: unknown time zone %q", ErrInvalidTrainer, trainer.TimeZone)
+	}
+
+	slices.SortFunc(trainer.Availability, func(a, b Window) int {
+		if a.Weekday != b.Weekday {
+			return int(a.Weekday - b.Weekday)
+		}
+		return int(a.Start - b.Start)
+	})
+	for i, window := range trainer.Availability {
+		switch {
+		case window.Weekday < time.Sunday || window.Weekday > time.Saturday:
+			return fmt.Errorf("%w: unknown weekday", ErrInvalidTrainer)
+		case window.Start < 0 || window.Start%time.Minute != 0 || window.End%time.Minute != 0:
+			return fmt.Errorf("%w: availability must start and end on whole minutes", ErrInvalidTrainer)
+		case window.Start >= window.End || window.End > 24*time.Hour:
+			return fmt.Errorf("%w: availability must end after it starts, by midnight", ErrInvalidTrainer)
+		case i > 0 && trainer.Availability[i-1].Weekday == window.Weekday && trainer.Availability[i-1].End > window.Start:
+			return fmt.Errorf("%w: availability on %s overlaps", ErrInvalidTrainer, window.Weekday)
+		}
+	}
+	return nil
+}
+
+// Location returns the trainer's time zone, or UTC if it is unknown.
+func (trainer *Trainer) Location() *time.Location {
+	location, err := time.LoadLocation(trainer.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Available returns the stretches of [from, to) the trainer's weekly availability covers,
+// earliest first. Windows that meet, including across midnight, are joined.
+func (trainer *Trainer) Available(from, to time.Time) []Interval {
+	location := trainer.Location()
+	local := from.In(location)
+	// Start a day early: the first day's windows are clipped to from anyway.
+	day := time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, location)
+
+	var intervals []Interval
+	for ; day.Before(to); day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, location) {
+		for _, window := range trainer.Availability {
+			if window.Weekday != day.Weekday() {
+				continue
+			}
+			start, end := clock(day, window.Start), clock(day, window.End)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if !start.Before(end) {
+				continue
+			}
+			if last := len(intervals) - 1; last >= 0 && !intervals[last].End.Before(start) {
+				intervals[last].End = end
+				continue
+			}
+			intervals = append(intervals, Interval{Start: start, End: end})
+		}
+	}
+	return intervals
+}
+
+// Covers reports whether the trainer is available for the whole of [start, end).
+func (trainer *Trainer) Covers(start, end time.Time) bool {
+	intervals := trainer.Available(start, end)
+	return len(intervals) == 1 && intervals[0].Start.Equal(start) && intervals[0].End.Equal(end)
+}
+
+// Without returns the parts of intervals that none of busy overlap.
+func Without(intervals []Interval, busy []Interval) []Interval {
+	for _, taken := range busy {
+		var remaining []Interval
+		for _, interval := range intervals {
+			if !interval.Overlaps(taken.Start, taken.End) {
+				remaining = append(remaining, interval)
+				continue
+			}
+			if interval.Start.Before(taken.Start) {
+				remaining = append(remaining, Interval{Start: interval.Start, End: taken.Start})
+			}
+			if interval.End.After(taken.End) {
+				remaining = append(remaining, Interval{Start: taken.End, End: interval.End})
+			}
+		}
+		intervals = remaining
+	}
+	return intervals
+}
+
+// clock returns the wall-clock time offset past midnight of day, in day's location.
+func clock(day time.Time, offset time.Duration) time.Time {
+	hour := int(offset / time.Hour)
+	minute := int(offset % time.Hour / time.Minute)
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+}
+
+// TimeOff is a stretch of time a trainer takes no appointments in, such as a holiday.
+type TimeOff struct {
+	ID        string
+	TrainerID string
+	StartTime time.Time
+	EndTime   time.Time
+	Reason    string
+	CreatedAt time.Time
+}
+
+func NewTimeOff(trainerID string, startTime, endTime time.Time, reason string) (*TimeOff, error) {
+	if !startTime.Before(endTime) {
+		return nil, fmt.Errorf("%w: start time must be before end time", ErrInvalidTimeOff)
+	}
+	return &TimeOff{
+		TrainerID: trainerID,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Reason:    strings.TrimSpace(reason),
+		CreatedAt: time.Now(),
+	}, nil
+}
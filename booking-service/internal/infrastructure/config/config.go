@@ -1,150 +1,249 @@
 package config
 
 import (
-	"fmt"
-	"os"
-	"strconv"
 	"time"
-
-	"github.com/joho/godotenv"
 )
 
+// Config is the effective service configuration. Each setting is read, in increasing order
+// of precedence, from Defaults, the YAML config file, the environment variable named by its
+// env tag and the command-line flag named after its yaml path (e.g. --server.port). Fields
+// tagged secret are redacted by Redacted.
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	Logging  LoggingConfig
-	App      AppConfig
+	Server     ServerConfig     `yaml:"server"`
+	GRPC       GRPCConfig       `yaml:"grpc"`
+	Database   DatabaseConfig   `yaml:"database"`
+	Redis      RedisConfig      `yaml:"redis"`
+	Auth       AuthConfig       `yaml:"auth"`
+	Logging    LoggingConfig    `yaml:"logging"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	Health     HealthConfig     `yaml:"health"`
+	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+	CORS       CORSConfig       `yaml:"cors"`
+	Quota      QuotaConfig      `yaml:"quota"`
+	Membership MembershipConfig `yaml:"membership"`
+	Timetable  TimetableConfig  `yaml:"timetable"`
+	App        AppConfig        `yaml:"app"`
 }
 
 type ServerConfig struct {
-	Port         int
-	Host         string
-	ReadTimeout  time.Duration
-	WriteTimeout time.Duration
+	Port             int           `yaml:"port" env:"BOOKING_SERVER_PORT"`
+	Host             string        `yaml:"host" env:"BOOKING_SERVER_HOST"`
+	ReadTimeout      time.Duration `yaml:"read_timeout" env:"BOOKING_SERVER_READ_TIMEOUT"`
+	WriteTimeout     time.Duration `yaml:"write_timeout" env:"BOOKING_SERVER_WRITE_TIMEOUT"`
+	ValidateRequests bool          `yaml:"validate_requests" env:"BOOKING_SERVER_VALIDATE_REQUESTS"`
+	// MaxBodyBytes limits request bodies; larger ones are rejected with 413.
+	MaxBodyBytes int `yaml:"max_body_bytes" env:"BOOKING_SERVER_MAX_BODY_BYTES"`
+	// RequestTimeout is the deadline of each request, and BatchRequestTimeout that of batch
+	// creation. Both must be shorter than WriteTimeout so the timeout response can be sent.
+	RequestTimeout      time.Duration `yaml:"request_timeout" env:"BOOKING_SERVER_REQUEST_TIMEOUT"`
+	BatchRequestTimeout time.Duration `yaml:"batch_request_timeout" env:"BOOKING_SERVER_BATCH_REQUEST_TIMEOUT"`
+	// ShutdownTimeout bounds how long shutdown waits for in-flight requests to drain and
+	// for every component to stop.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"BOOKING_SERVER_SHUTDOWN_TIMEOUT"`
+	// Compression gzips responses of 1 KiB or more for clients that accept it.
+	Compression bool `yaml:"compression" env:"BOOKING_SERVER_COMPRESSION"`
+}
+
+type GRPCConfig struct {
+	Port int `yaml:"port" env:"BOOKING_GRPC_PORT"`
 }
 
 type DatabaseConfig struct {
-	URL string
+	URL string `yaml:"url" env:"DB_URL" secret:"url"`
+	// ConnectTimeout bounds how long startup waits for the database to become reachable.
+	ConnectTimeout time.Duration `yaml:"connect_timeout" env:"BOOKING_DB_CONNECT_TIMEOUT"`
+	// MigrateOnStart applies pending migrations before the server starts serving.
+	MigrateOnStart bool `yaml:"migrate_on_start" env:"BOOKING_DB_MIGRATE_ON_START"`
+	MaxOpenConns   int  `yaml:"max_open_conns" env:"BOOKING_DB_MAX_OPEN_CONNS"`
+	// MaxIdleConns defaults to MaxOpenConns when zero.
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"BOOKING_DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"BOOKING_DB_CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"BOOKING_DB_CONN_MAX_IDLE_TIME"`
 }
 
 type RedisConfig struct {
-	URL string
+	// URL is optional unless rate_limit.backend is "redis".
+	URL string `yaml:"url" env:"REDIS_URL" secret:"url"`
 }
 
-type LoggingConfig struct {
-	Level  string
-	Format string
+type AuthConfig struct {
+	HS256Secret string `yaml:"hs256_secret" env:"BOOKING_AUTH_HS256_SECRET" secret:"true"`
+	JWKSFile    string `yaml:"jwks_file" env:"BOOKING_AUTH_JWKS_FILE"`
+	Issuer      string `yaml:"issuer" env:"BOOKING_AUTH_ISSUER"`
+	Audience    string `yaml:"audience" env:"BOOKING_AUTH_AUDIENCE"`
 }
 
-type AppConfig struct {
-	Env         string
-	ServiceName string
+type LoggingConfig struct {
+	Level  string `yaml:"level" env:"BOOKING_LOG_LEVEL"`
+	Format string `yaml:"format" env:"BOOKING_LOG_FORMAT"`
 }
 
-func Load() (*Config, error) {
-	err := godotenv.Load(".env")
-	if err != nil {
-		fmt.Println("Warning: .env file not found or could not be loaded")
-	}
-
-	portStr, err := mustGetEnv("BOOKING_SERVER_PORT")
-	if err != nil {
-		return nil, err
-	}
-	port, err := strconv.Atoi(portStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid port number: %w", err)
-	}
-
-	readTimeoutStr, err := mustGetEnv("BOOKING_SERVER_READ_TIMEOUT")
-	if err != nil {
-		return nil, err
-	}
-	readTimeout, err := time.ParseDuration(readTimeoutStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid read timeout: %w", err)
-	}
-
-	writeTimeoutStr, err := mustGetEnv("BOOKING_SERVER_WRITE_TIMEOUT")
-	if err != nil {
-		return nil, err
-	}
-	writeTimeout, err := time.ParseDuration(writeTimeoutStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid write timeout: %w", err)
-	}
+// TracingConfig selects where OpenTelemetry spans are exported: "none", "stdout" or "otlp".
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" env:"BOOKING_TRACING_EXPORTER"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint" env:"BOOKING_TRACING_OTLP_ENDPOINT"`
+	SampleRatio  float64 `yaml:"sample_ratio" env:"BOOKING_TRACING_SAMPLE_RATIO"`
+}
 
-	host, err := mustGetEnv("BOOKING_SERVER_HOST")
-	if err != nil {
-		return nil, err
-	}
+type HealthConfig struct {
+	// CheckTimeout bounds each dependency check made by the readiness endpoint.
+	CheckTimeout time.Duration `yaml:"check_timeout" env:"BOOKING_HEALTH_CHECK_TIMEOUT"`
+}
 
-	dbURL, err := mustGetEnv("DB_URL")
-	if err != nil {
-		return nil, err
-	}
+// RateLimitConfig sets the per-client token buckets applied to every endpoint but the public
+// ones, before authentication.
+// GET requests draw from the read bucket and every other method from the write bucket.
+// Backend "redis" shares buckets between instances and falls back to in-memory buckets
+// while Redis is unreachable.
+type RateLimitConfig struct {
+	Enabled        bool   `yaml:"enabled" env:"BOOKING_RATE_LIMIT_ENABLED"`
+	Backend        string `yaml:"backend" env:"BOOKING_RATE_LIMIT_BACKEND"`
+	ReadPerMinute  int    `yaml:"read_per_minute" env:"BOOKING_RATE_LIMIT_READ_PER_MINUTE"`
+	ReadBurst      int    `yaml:"read_burst" env:"BOOKING_RATE_LIMIT_READ_BURST"`
+	WritePerMinute int    `yaml:"write_per_minute" env:"BOOKING_RATE_LIMIT_WRITE_PER_MINUTE"`
+	WriteBurst     int    `yaml:"write_burst" env:"BOOKING_RATE_LIMIT_WRITE_BURST"`
+	// TrustProxyHeaders keys anonymous clients by the first X-Forwarded-For address instead
+	// of the connection's remote address. Enable it only behind a proxy that sets the header.
+	TrustProxyHeaders bool `yaml:"trust_proxy_headers" env:"BOOKING_RATE_LIMIT_TRUST_PROXY_HEADERS"`
+}
 
-	redisURL, err := mustGetEnv("REDIS_URL")
-	if err != nil {
-		return nil, err
-	}
+// CORSConfig allows browsers on other origins to call the HTTP API. No allowed origins
+// disables CORS; "*" allows every origin.
+type CORSConfig struct {
+	AllowedOrigins   []string      `yaml:"allowed_origins" env:"BOOKING_CORS_ALLOWED_ORIGINS"`
+	AllowCredentials bool          `yaml:"allow_credentials" env:"BOOKING_CORS_ALLOW_CREDENTIALS"`
+	MaxAge           time.Duration `yaml:"max_age" env:"BOOKING_CORS_MAX_AGE"`
+}
 
-	logLevel, err := mustGetEnv("BOOKING_LOG_LEVEL")
-	if err != nil {
-		return nil, err
-	}
+// QuotaConfig sets the booking quotas of the basic and premium membership tiers. Members get
+// the tier the membership service tells, or DefaultTier. A zero limit is unlimited.
+type QuotaConfig struct {
+	Enabled     bool   `yaml:"enabled" env:"BOOKING_QUOTA_ENABLED"`
+	DefaultTier string `yaml:"default_tier" env:"BOOKING_QUOTA_DEFAULT_TIER"`
+	// MaxActiveBookings caps the pending and confirmed bookings that have not started yet,
+	// MaxWeeklyDuration the booked time per week (Monday to Sunday, UTC) and MaxAdvance how
+	// far ahead a member may book.
+	BasicMaxActiveBookings   int           `yaml:"basic_max_active_bookings" env:"BOOKING_QUOTA_BASIC_MAX_ACTIVE_BOOKINGS"`
+	BasicMaxWeeklyDuration   time.Duration `yaml:"basic_max_weekly_duration" env:"BOOKING_QUOTA_BASIC_MAX_WEEKLY_DURATION"`
+	BasicMaxAdvance          time.Duration `yaml:"basic_max_advance" env:"BOOKING_QUOTA_BASIC_MAX_ADVANCE"`
+	PremiumMaxActiveBookings int           `yaml:"premium_max_active_bookings" env:"BOOKING_QUOTA_PREMIUM_MAX_ACTIVE_BOOKINGS"`
+	PremiumMaxWeeklyDuration time.Duration `yaml:"premium_max_weekly_duration" env:"BOOKING_QUOTA_PREMIUM_MAX_WEEKLY_DURATION"`
+	PremiumMaxAdvance        time.Duration `yaml:"premium_max_advance" env:"BOOKING_QUOTA_PREMIUM_MAX_ADVANCE"`
+}
 
-	logFormat, err := mustGetEnv("BOOKING_LOG_FORMAT")
-	if err != nil {
-		return nil, err
-	}
+// MembershipConfig points at the membership service, which decides who may book which gym.
+// Without a URL every user may book every gym.
+type MembershipConfig struct {
+	URL   string `yaml:"url" env:"BOOKING_MEMBERSHIP_URL"`
+	Token string `yaml:"token" env:"BOOKING_MEMBERSHIP_TOKEN" secret:"true"`
+	// Timeout bounds each request to the membership service and CacheTTL how long its
+	// answers are reused.
+	Timeout  time.Duration `yaml:"timeout" env:"BOOKING_MEMBERSHIP_TIMEOUT"`
+	CacheTTL time.Duration `yaml:"cache_ttl" env:"BOOKING_MEMBERSHIP_CACHE_TTL"`
+	// After BreakerFailures consecutive failures, requests fail at once for BreakerCooldown.
+	BreakerFailures int           `yaml:"breaker_failures" env:"BOOKING_MEMBERSHIP_BREAKER_FAILURES"`
+	BreakerCooldown time.Duration `yaml:"breaker_cooldown" env:"BOOKING_MEMBERSHIP_BREAKER_COOLDOWN"`
+}
 
-	env, err := mustGetEnv("BOOKING_ENV")
-	if err != nil {
-		return nil, err
-	}
+// TimetableConfig controls the job that generates class sessions from timetable templates.
+// Templates still generate their sessions when saved while the job is disabled.
+type TimetableConfig struct {
+	Enabled bool `yaml:"enabled" env:"BOOKING_TIMETABLE_ENABLED"`
+	// WeeksAhead is how many weeks, starting today, sessions are generated for, and
+	// Interval how often the job runs.
+	WeeksAhead int           `yaml:"weeks_ahead" env:"BOOKING_TIMETABLE_WEEKS_AHEAD"`
+	Interval   time.Duration `yaml:"interval" env:"BOOKING_TIMETABLE_INTERVAL"`
+}
 
-	serviceName, err := mustGetEnv("BOOKING_SERVICE_NAME")
-	if err != nil {
-		return nil, err
-	}
+type AppConfig struct {
+	Env         string `yaml:"env" env:"BOOKING_ENV"`
+	ServiceName string `yaml:"service_name" env:"BOOKING_SERVICE_NAME"`
+}
 
+// Defaults returns the configuration used for every setting that no source overrides.
+// DB_URL has no default and must always be provided.
+func Defaults() *Config {
 	return &Config{
 		Server: ServerConfig{
-			Port:         port,
-			Host:         host,
-			ReadTimeout:  readTimeout,
-			WriteTimeout: writeTimeout,
+			Port:                8080,
+			Host:                "0.0.0.0",
+			ReadTimeout:         5 * time.Second,
+			WriteTimeout:        10 * time.Second,
+			MaxBodyBytes:        1 << 20,
+			RequestTimeout:      5 * time.Second,
+			BatchRequestTimeout: 9 * time.Second,
+			ShutdownTimeout:     10 * time.Second,
+			Compression:         true,
 		},
-		Database: DatabaseConfig{
-			URL: dbURL,
+		GRPC: GRPCConfig{
+			Port: 9090,
 		},
-		Redis: RedisConfig{
-			URL: redisURL,
+		Database: DatabaseConfig{
+			ConnectTimeout:  30 * time.Second,
+			MaxOpenConns:    25,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
 		},
 		Logging: LoggingConfig{
-			Level:  logLevel,
-			Format: logFormat,
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter:     "none",
+			OTLPEndpoint: "http://localhost:4318",
+			SampleRatio:  1,
+		},
+		Health: HealthConfig{
+			CheckTimeout: 2 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:        true,
+			Backend:        "memory",
+			ReadPerMinute:  300,
+			ReadBurst:      60,
+			WritePerMinute: 30,
+			WriteBurst:     10,
+		},
+		CORS: CORSConfig{
+			MaxAge: 10 * time.Minute,
+		},
+		Quota: QuotaConfig{
+			Enabled:                  true,
+			DefaultTier:              "basic",
+			BasicMaxActiveBookings:   3,
+			BasicMaxWeeklyDuration:   5 * time.Hour,
+			BasicMaxAdvance:          14 * 24 * time.Hour,
+			PremiumMaxActiveBookings: 10,
+			PremiumMaxWeeklyDuration: 20 * time.Hour,
+			PremiumMaxAdvance:        30 * 24 * time.Hour,
+		},
+		Membership: MembershipConfig{
+			Timeout:         time.Second,
+			CacheTTL:        time.Minute,
+			BreakerFailures: 5,
+			BreakerCooldown: 30 * time.Second,
+		},
+		Timetable: TimetableConfig{
+			Enabled:    true,
+			WeeksAhead: 4,
+			Interval:   time.Hour,
 		},
 		App: AppConfig{
-			Env:         env,
-			ServiceName: serviceName,
+			Env:         "development",
+			ServiceName: "booking-service",
 		},
-	}, nil
-}
-
-func mustGetEnv(key string) (string, error) {
-	if value, exists := os.LookupEnv(key); exists {
-		return value, nil
 	}
-	return "", fmt.Errorf("environment variable %s is required but not set", key)
 }
 
 func (config *DatabaseConfig) GetDSN() string {
 	return config.URL
 }
 
+// Enabled reports whether requests must carry a bearer token.
+func (config *AuthConfig) Enabled() bool {
+	return config.HS256Secret != "" || config.JWKSFile != ""
+}
+
 func (config *RedisConfig) GetRedisAddr() string {
 	return config.URL
 }
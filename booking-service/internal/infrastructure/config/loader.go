@@ -0,0 +1,250 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "REDACTED"
+
+// ValidationError lists every problem found while loading the configuration.
+type ValidationError struct {
+	Problems []string
+}
+
+func (err *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(err.Problems, "\n  - ")
+}
+
+// Load reads the configuration from a .env file in the working directory, if any, the
+// process environment and the command-line args.
+func Load(args []string) (*Config, error) {
+	// .env is a development convenience; it is fine for it not to exist.
+	_ = godotenv.Load(".env")
+	return LoadFrom(args, os.LookupEnv)
+}
+
+// LoadFrom layers, in increasing precedence, Defaults, the YAML file named by --config or
+// BOOKING_CONFIG_FILE, the environment and flags. An environment variable NAME can instead
+// be given as NAME_FILE, the path of a file holding the value, which suits mounted secrets.
+// Every invalid value and failed validation rule is reported in one *ValidationError.
+func LoadFrom(args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	config := Defaults()
+	settings := config.settings()
+
+	flagSet := flag.NewFlagSet("booking-service", flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+	configFile := flagSet.String("config", "", "path of a YAML config file (env BOOKING_CONFIG_FILE)")
+	for _, setting := range settings {
+		flagSet.String(setting.path, "", fmt.Sprintf("%s (env %s)", setting.path, setting.env))
+	}
+	if err := flagSet.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			var usage bytes.Buffer
+			flagSet.SetOutput(&usage)
+			flagSet.PrintDefaults()
+			return nil, fmt.Errorf("flags:\n%s", usage.String())
+		}
+		return nil, err
+	}
+	if flagSet.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(flagSet.Args(), " "))
+	}
+
+	var problems []string
+
+	path := *configFile
+	if path == "" {
+		path, _ = lookupEnv("BOOKING_CONFIG_FILE")
+	}
+	if path != "" {
+		if err := config.loadFile(path); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	for _, setting := range settings {
+		value, source, err := lookupSetting(setting.env, lookupEnv)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		if source == "" {
+			continue
+		}
+		if err := setting.set(value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", source, err))
+		}
+	}
+
+	flagSet.Visit(func(visited *flag.Flag) {
+		for _, setting := range settings {
+			if setting.path == visited.Name {
+				if err := setting.set(visited.Value.String()); err != nil {
+					problems = append(problems, fmt.Sprintf("--%s: %v", setting.path, err))
+				}
+			}
+		}
+	})
+
+	problems = append(problems, config.validate()...)
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	return config, nil
+}
+
+// lookupSetting returns the value of env, or of the file named by env_FILE, together with a
+// description of where it came from. An empty source means the variable is not set.
+func lookupSetting(env string, lookupEnv func(string) (string, bool)) (string, string, error) {
+	if path, exists := lookupEnv(env + "_FILE"); exists && path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", "", fmt.Errorf("%s_FILE: %v", env, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), env + "_FILE", nil
+	}
+	if value, exists := lookupEnv(env); exists && value != "" {
+		return value, env, nil
+	}
+	return "", "", nil
+}
+
+func (config *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %v", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %v", path, err)
+	}
+	return nil
+}
+
+// Redacted returns a copy of config with secrets masked, suitable for printing. Secret URLs
+// keep everything but their password.
+func (config *Config) Redacted() *Config {
+	copied := *config
+	for _, setting := range copied.settings() {
+		if setting.value.String() == "" {
+			continue
+		}
+		switch setting.secret {
+		case "true":
+			setting.value.SetString(redacted)
+		case "url":
+			parsed, err := url.Parse(setting.value.String())
+			if err != nil || parsed.Host == "" {
+				setting.value.SetString(redacted)
+				continue
+			}
+			if _, hasPassword := parsed.User.Password(); hasPassword {
+				parsed.User = url.UserPassword(parsed.User.Username(), redacted)
+			}
+			setting.value.SetString(parsed.String())
+		}
+	}
+	return &copied
+}
+
+// WriteYAML writes config in the config file format.
+func (config *Config) WriteYAML(output io.Writer) error {
+	encoder := yaml.NewEncoder(output)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(config); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// setting is one configurable leaf field of Config.
+type setting struct {
+	path   string
+	env    string
+	secret string
+	value  reflect.Value
+}
+
+func (config *Config) settings() []setting {
+	var settings []setting
+	collectSettings(reflect.ValueOf(config).Elem(), "", &settings)
+	return settings
+}
+
+func collectSettings(value reflect.Value, prefix string, settings *[]setting) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		if field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Duration(0)) {
+			collectSettings(value.Field(i), path, settings)
+			continue
+		}
+		*settings = append(*settings, setting{
+			path:   path,
+			env:    field.Tag.Get("env"),
+			secret: field.Tag.Get("secret"),
+			value:  value.Field(i),
+		})
+	}
+}
+
+func (setting setting) set(raw string) error {
+	switch setting.value.Interface().(type) {
+	case string:
+		setting.value.SetString(raw)
+	case time.Duration:
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		setting.value.SetInt(int64(parsed))
+	case int:
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		setting.value.SetInt(int64(parsed))
+	case bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		setting.value.SetBool(parsed)
+	case float64:
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		setting.value.SetFloat(parsed)
+	case []string:
+		// Lists are comma-separated outside YAML, e.g. "https://a.example,https://b.example".
+		var values []string
+		for _, value := range strings.Split(raw, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		setting.value.Set(reflect.ValueOf(values))
+	default:
+		return fmt.Errorf("unsupported setting type %s", setting.value.Type())
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/config"
+)
+
+func env(values map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, exists := values[key]
+		return value, exists
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := config.LoadFrom(nil, env(map[string]string{"DB_URL": "postgres://localhost/bookings"}))
+	require.NoError(t, err)
+
+	assert.Equal(t, 8080, cfg.Server.Port)
+	assert.Equal(t, 9090, cfg.GRPC.Port)
+	assert.Equal(t, 25, cfg.Database.MaxOpenConns)
+	assert.Equal(t, 30*time.Minute, cfg.Database.ConnMaxLifetime)
+	assert.Equal(t, "", cfg.Redis.URL)
+	assert.Equal(t, "info", cfg.Logging.Level)
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	file := writeFile(t, "booking.yaml", `
+server:
+  port: 8000
+  read_timeout: 7s
+database:
+  url: postgres://file/bookings
+  max_open_conns: 10
+logging:
+  level: warn
+`)
+
+	cfg, err := config.LoadFrom(
+		[]string{"--config", file, "--logging.level", "debug"},
+		env(map[string]string{
+			"BOOKING_SERVER_PORT": "8100",
+			"BOOKING_LOG_LEVEL":   "error",
+		}),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, 8100, cfg.Server.Port, "env overrides the file")
+	assert.Equal(t, 7*time.Second, cfg.Server.ReadTimeout, "file overrides defaults")
+	assert.Equal(t, 10, cfg.Database.MaxOpenConns)
+	assert.Equal(t, "postgres://file/bookings", cfg.Database.URL)
+	assert.Equal(t, "debug", cfg.Logging.Level, "flags override env")
+}
+
+func TestLoadSecretFiles(t *testing.T) {
+	dbURL := writeFile(t, "db_url", "postgres://app:hunter2@db/bookings\n")
+	secret := writeFile(t, "hs256", "token-secret")
+
+	cfg, err := config.LoadFrom(nil, env(map[string]string{
+		"DB_URL":                         "postgres://ignored/bookings",
+		"DB_URL_FILE":                    dbURL,
+		"BOOKING_AUTH_HS256_SECRET_FILE": secret,
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, "postgres://app:hunter2@db/bookings", cfg.Database.URL)
+	assert.Equal(t, "token-secret", cfg.Auth.HS256Secret)
+}
+
+func TestLoadReportsEveryProblem(t *testing.T) {
+	_, err := config.LoadFrom(nil, env(map[string]string{
+		"BOOKING_SERVER_PORT":       "eighty",
+		"BOOKING_LOG_FORMAT":        "xml",
+		"BOOKING_DB_MAX_IDLE_CONNS": "50",
+		"BOOKING_ENV":               "production",
+		"DB_URL_FILE":               "/does/not/exist",
+	}))
+
+	var validationErr *config.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Len(t, validationErr.Problems, 6)
+	assert.Contains(t, err.Error(), "BOOKING_SERVER_PORT")
+	assert.Contains(t, err.Error(), "DB_URL_FILE")
+	assert.Contains(t, err.Error(), "database.url")
+	assert.Contains(t, err.Error(), "database.max_idle_conns")
+	assert.Contains(t, err.Error(), "logging.format")
+	assert.Contains(t, err.Error(), "required in production")
+}
+
+func TestLoadLists(t *testing.T) {
+	file := writeFile(t, "booking.yaml", `
+cors:
+  allowed_origins:
+    - https://app.example.com
+`)
+
+	cfg, err := config.LoadFrom([]string{"--config", file}, env(map[string]string{"DB_URL": "postgres://db/bookings"}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://app.example.com"}, cfg.CORS.AllowedOrigins)
+
+	cfg, err = config.LoadFrom([]string{"--config", file}, env(map[string]string{
+		"DB_URL":                       "postgres://db/bookings",
+		"BOOKING_CORS_ALLOWED_ORIGINS": "https://a.example.com, https://b.example.com,",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://a.example.com", "https://b.example.com"}, cfg.CORS.AllowedOrigins)
+
+	_, err = config.LoadFrom(nil, env(map[string]string{
+		"DB_URL":                         "postgres://db/bookings",
+		"BOOKING_CORS_ALLOWED_ORIGINS":   "app.example.com",
+		"BOOKING_SERVER_REQUEST_TIMEOUT": "30s",
+	}))
+	assert.ErrorContains(t, err, "cors.allowed_origins")
+	assert.ErrorContains(t, err, "server.request_timeout")
+}
+
+func TestLoadRejectsUnknownFileKeys(t *testing.T) {
+	file := writeFile(t, "booking.yaml", "server:\n  prot: 8000\n")
+	_, err := config.LoadFrom([]string{"--config", file}, env(map[string]string{"DB_URL": "postgres://db/bookings"}))
+	assert.ErrorContains(t, err, "prot")
+}
+
+func TestRedacted(t *testing.T) {
+	cfg, err := config.LoadFrom(nil, env(map[string]string{
+		"DB_URL":                    "postgres://app:hunter2@db/bookings",
+		"REDIS_URL":                 "redis://:hunter3@cache:6379",
+		"BOOKING_AUTH_HS256_SECRET": "token-secret",
+	}))
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	require.NoError(t, cfg.Redacted().WriteYAML(&output))
+
+	printed := output.String()
+	assert.NotContains(t, printed, "hunter2")
+	assert.NotContains(t, printed, "hunter3")
+	assert.NotContains(t, printed, "token-secret")
+	assert.Contains(t, printed, "postgres://app:REDACTED@db/bookings")
+	assert.Equal(t, "token-secret", cfg.Auth.HS256Secret, "the original is left untouched")
+
+	// The printed configuration is a valid config file.
+	reloaded, err := config.LoadFrom([]string{"--config", writeFile(t, "printed.yaml", printed)}, env(nil))
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Database.ConnMaxIdleTime, reloaded.Database.ConnMaxIdleTime)
+}
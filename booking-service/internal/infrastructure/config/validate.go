@@ -0,0 +1,173 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// validate checks the whole configuration and returns every problem found.
+func (config *Config) validate() []string {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if !validPort(config.Server.Port) {
+		addf("server.port: must be between 1 and 65535, got %d", config.Server.Port)
+	}
+	if !validPort(config.GRPC.Port) {
+		addf("grpc.port: must be between 1 and 65535, got %d", config.GRPC.Port)
+	}
+	if config.Server.Port == config.GRPC.Port {
+		addf("grpc.port: must differ from server.port (%d)", config.Server.Port)
+	}
+	if config.Server.ReadTimeout <= 0 {
+		addf("server.read_timeout: must be positive")
+	}
+	if config.Server.WriteTimeout <= 0 {
+		addf("server.write_timeout: must be positive")
+	}
+	if config.Server.ShutdownTimeout <= 0 {
+		addf("server.shutdown_timeout: must be positive")
+	}
+	if config.Server.MaxBodyBytes <= 0 {
+		addf("server.max_body_bytes: must be positive")
+	}
+	if config.Server.RequestTimeout <= 0 || config.Server.RequestTimeout >= config.Server.WriteTimeout {
+		addf("server.request_timeout: must be positive and shorter than server.write_timeout (%s)", config.Server.WriteTimeout)
+	}
+	if config.Server.BatchRequestTimeout <= 0 || config.Server.BatchRequestTimeout >= config.Server.WriteTimeout {
+		addf("server.batch_request_timeout: must be positive and shorter than server.write_timeout (%s)", config.Server.WriteTimeout)
+	}
+
+	if config.Database.URL == "" {
+		addf("database.url: is required (DB_URL or DB_URL_FILE)")
+	}
+	if config.Database.ConnectTimeout <= 0 {
+		addf("database.connect_timeout: must be positive")
+	}
+	if config.Database.MaxOpenConns < 0 {
+		addf("database.max_open_conns: must not be negative")
+	}
+	if config.Database.MaxIdleConns < 0 {
+		addf("database.max_idle_conns: must not be negative")
+	}
+	if config.Database.MaxOpenConns > 0 && config.Database.MaxIdleConns > config.Database.MaxOpenConns {
+		addf("database.max_idle_conns: must not exceed database.max_open_conns (%d)", config.Database.MaxOpenConns)
+	}
+	if config.Database.ConnMaxLifetime < 0 {
+		addf("database.conn_max_lifetime: must not be negative")
+	}
+	if config.Database.ConnMaxIdleTime < 0 {
+		addf("database.conn_max_idle_time: must not be negative")
+	}
+
+	if config.Redis.URL != "" {
+		if parsed, err := url.Parse(config.Redis.URL); err != nil || parsed.Scheme != "redis" || parsed.Host == "" {
+			addf("redis.url: must look like redis://host:port")
+		}
+	}
+
+	if config.App.Env == "production" && !config.Auth.Enabled() {
+		addf("auth: hs256_secret or jwks_file is required in production")
+	}
+
+	switch config.Logging.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		addf("logging.level: must be debug, info, warn or error, got %q", config.Logging.Level)
+	}
+	switch config.Logging.Format {
+	case "json", "text":
+	default:
+		addf("logging.format: must be json or text, got %q", config.Logging.Format)
+	}
+
+	switch config.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if parsed, err := url.Parse(config.Tracing.OTLPEndpoint); err != nil || parsed.Host == "" {
+			addf("tracing.otlp_endpoint: must be a URL such as http://localhost:4318")
+		}
+	default:
+		addf("tracing.exporter: must be none, stdout or otlp, got %q", config.Tracing.Exporter)
+	}
+	if config.Tracing.SampleRatio < 0 || config.Tracing.SampleRatio > 1 {
+		addf("tracing.sample_ratio: must be between 0 and 1")
+	}
+
+	if config.Health.CheckTimeout <= 0 {
+		addf("health.check_timeout: must be positive")
+	}
+	if config.RateLimit.Enabled {
+		switch config.RateLimit.Backend {
+		case "memory":
+		case "redis":
+			if config.Redis.URL == "" {
+				addf("rate_limit.backend: redis requires redis.url (REDIS_URL)")
+			}
+		default:
+			addf("rate_limit.backend: must be memory or redis, got %q", config.RateLimit.Backend)
+		}
+		if config.RateLimit.ReadPerMinute <= 0 || config.RateLimit.ReadBurst <= 0 {
+			addf("rate_limit: read_per_minute and read_burst must be positive")
+		}
+		if config.RateLimit.WritePerMinute <= 0 || config.RateLimit.WriteBurst <= 0 {
+			addf("rate_limit: write_per_minute and write_burst must be positive")
+		}
+	}
+
+	for _, origin := range config.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		if parsed, err := url.Parse(origin); err != nil || parsed.Host == "" || parsed.Path != "" {
+			addf("cors.allowed_origins: %q must be \"*\" or an origin such as https://app.example.com", origin)
+		}
+	}
+	if config.CORS.MaxAge < 0 {
+		addf("cors.max_age: must not be negative")
+	}
+
+	switch config.Quota.DefaultTier {
+	case "basic", "premium":
+	default:
+		addf("quota.default_tier: must be basic or premium, got %q", config.Quota.DefaultTier)
+	}
+	if config.Quota.BasicMaxActiveBookings < 0 || config.Quota.BasicMaxWeeklyDuration < 0 || config.Quota.BasicMaxAdvance < 0 ||
+		config.Quota.PremiumMaxActiveBookings < 0 || config.Quota.PremiumMaxWeeklyDuration < 0 || config.Quota.PremiumMaxAdvance < 0 {
+		addf("quota: limits must not be negative")
+	}
+
+	if config.Membership.URL != "" {
+		if parsed, err := url.Parse(config.Membership.URL); err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			addf("membership.url: must be an http(s) URL such as http://membership:8080")
+		}
+	}
+	if config.Membership.Timeout <= 0 || config.Membership.Timeout >= config.Server.RequestTimeout {
+		addf("membership.timeout: must be positive and shorter than server.request_timeout (%s)", config.Server.RequestTimeout)
+	}
+	if config.Membership.CacheTTL < 0 {
+		addf("membership.cache_ttl: must not be negative")
+	}
+	if config.Membership.BreakerFailures <= 0 || config.Membership.BreakerCooldown <= 0 {
+		addf("membership: breaker_failures and breaker_cooldown must be positive")
+	}
+
+	if config.Timetable.WeeksAhead < 1 || config.Timetable.WeeksAhead > 52 {
+		addf("timetable.weeks_ahead: must be between 1 and 52")
+	}
+	if config.Timetable.Interval <= 0 {
+		addf("timetable.interval: must be positive")
+	}
+
+	if config.App.ServiceName == "" {
+		addf("app.service_name: is required")
+	}
+
+	return problems
+}
+
+func validPort(port int) bool {
+	return port > 0 && port <= 65535
+}
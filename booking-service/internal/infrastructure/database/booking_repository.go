@@ -3,94 +3,355 @@ package database
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
 )
 
 type BookingRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	logger *slog.Logger
 }
 
-func NewBookingRepository(db *sql.DB) *BookingRepository {
+func NewBookingRepository(db *sql.DB, logger *slog.Logger) *BookingRepository {
 	return &BookingRepository{
-		db: db,
+		db:     db,
+		logger: logger,
 	}
 }
 
-func (repo *BookingRepository) Create(ctx context.Context, b *booking.Booking) error {
+// bookingColumns are the columns of a booking, in the order scanBooking reads them.
+const bookingColumns = `id, user_id, gym_id, start_time, end_time, status, status_reason, cancelled_late, session_id, trainer_id, resource_id,
+	flag_reason, created_at, updated_at`
+
+// rowScanner is implemented by *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanBooking reads a row selected with bookingColumns.
+func scanBooking(row rowScanner) (*booking.Booking, error) {
+	var b booking.Booking
+	var sessionID, trainerID, resourceID sql.NullString
+	err := row.Scan(
+		&b.ID,
+		&b.UserID,
+		&b.GymID,
+		&b.StartTime,
+		&b.EndTime,
+		&b.Status,
+		&b.StatusReason,
+		&b.CancelledLate,
+		&sessionID,
+		&trainerID,
+		&resourceID,
+		&b.FlagReason,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	b.SessionID = sessionID.String
+	b.TrainerID = trainerID.String
+	b.ResourceID = resourceID.String
+	return &b, nil
+}
+
+// nullString stores an empty string as NULL, for optional references to other tables.
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// nullDate stores a zero time as NULL and any other as the date it falls on, for optional
+// DATE columns.
+func nullDate(value time.Time) sql.NullString {
+	if value.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: value.Format(time.DateOnly), Valid: true}
+}
+
+// dateOnly returns the date of a DATE column as midnight UTC, whatever location the driver
+// returned it in.
+func dateOnly(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (repo *BookingRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "BookingRepository", operation, query, booking.ErrBookingNotFound)
+}
+
+func (repo *BookingRepository) Create(ctx context.Context, b *booking.Booking) (err error) {
 	query := `
-		INSERT INTO bookings (id, user_id, gym_id, start_time, end_time, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO bookings (` + bookingColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
+	ctx, call := repo.startCall(ctx, "Create", query)
+	defer func() { call.end(err) }()
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := reservePlace(ctx, tx, b); err != nil {
+		return err
+	}
 
 	now := time.Now()
-	_, err := repo.db.ExecContext(ctx, query,
+	result, err := tx.ExecContext(ctx, query,
 		b.ID,
 		b.UserID,
 		b.GymID,
-		b.StartTime,
-		b.EndTime,
+		// The columns hold no zone, so times are stored in UTC, as scanBooking reads them.
+		b.StartTime.UTC(),
+		b.EndTime.UTC(),
 		b.Status,
+		b.StatusReason,
+		b.CancelledLate,
+		nullString(b.SessionID),
+		nullString(b.TrainerID),
+		nullString(b.ResourceID),
+		b.FlagReason,
 		now,
 		now,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return tx.Commit()
 }
 
-func (repo *BookingRepository) GetByID(ctx context.Context, id string) (*booking.Booking, error) {
+func (repo *BookingRepository) CreateMany(ctx context.Context, bookings []*booking.Booking) (err error) {
 	query := `
-		SELECT id, user_id, gym_id, start_time, end_time, status, created_at, updated_at
+		INSERT INTO bookings (` + bookingColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+	ctx, call := repo.startCall(ctx, "CreateMany", query)
+	defer func() { call.end(err) }()
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	for _, b := range bookings {
+		// Earlier bookings of the batch are already written in tx, so they count too.
+		if err := reservePlace(ctx, tx, b); err != nil {
+			return err
+		}
+		result, err := stmt.ExecContext(ctx,
+			b.ID,
+			b.UserID,
+			b.GymID,
+			b.StartTime.UTC(),
+			b.EndTime.UTC(),
+			b.Status,
+			b.StatusReason,
+			b.CancelledLate,
+			nullString(b.SessionID),
+			nullString(b.TrainerID),
+			nullString(b.ResourceID),
+			b.FlagReason,
+			now,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+		call.addRowsAffected(result)
+	}
+
+	return tx.Commit()
+}
+
+// reservePlace makes sure the class session or resource b takes a place in still has one
+// free for it, and holds a lock on the session or resource until tx ends.
+// sessions.EnrollmentCheck and resources.Capacity check before, but without the lock two
+// bookings could both take the last place.
+func reservePlace(ctx context.Context, tx *sql.Tx, b *booking.Booking) error {
+	if b.Status != booking.StatusPending && b.Status != booking.StatusConfirmed {
+		return nil
+	}
+	switch {
+	case b.SessionID != "":
+		return reserveSessionPlace(ctx, tx, b)
+	case b.ResourceID != "":
+		return reserveResourcePlace(ctx, tx, b)
+	}
+	return nil
+}
+
+func reserveSessionPlace(ctx context.Context, tx *sql.Tx, b *booking.Booking) error {
+	var capacity int
+	err := tx.QueryRowContext(ctx, `SELECT capacity FROM class_sessions WHERE id = $1 FOR UPDATE`, b.SessionID).Scan(&capacity)
+	if err == sql.ErrNoRows {
+		return session.ErrSessionNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	var enrolled, enrolledUser int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE user_id = $3)
+		FROM bookings
+		WHERE session_id = $1 AND id <> $2 AND status IN ($4, $5)
+	`, b.SessionID, b.ID, b.UserID, booking.StatusPending, booking.StatusConfirmed).Scan(&enrolled, &enrolledUser)
+	if err != nil {
+		return err
+	}
+	if enrolledUser > 0 {
+		return session.ErrAlreadyEnrolled
+	}
+	if enrolled >= capacity {
+		return fmt.Errorf("%w: all %d places are taken", session.ErrSessionFull, capacity)
+	}
+	return nil
+}
+
+func reserveResourcePlace(ctx context.Context, tx *sql.Tx, b *booking.Booking) error {
+	resourceRecord, err := scanResource(tx.QueryRowContext(ctx, `SELECT `+resourceColumns+` FROM resources WHERE id = $1 FOR UPDATE`, b.ResourceID))
+	if err == sql.ErrNoRows {
+		return resource.ErrResourceNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !resourceRecord.Active {
+		return resource.ErrResourceOutOfService
+	}
+
+	blockedStart, blockedEnd := resourceRecord.Buffer().Blocks(b)
+	rows, err := tx.QueryContext(ctx, `
+		SELECT `+bookingColumns+`
+		FROM bookings
+		WHERE resource_id = $1 AND id <> $2 AND start_time < $4 AND end_time > $3 AND status IN ($5, $6)
+	`, b.ResourceID, b.ID, blockedStart.UTC(), blockedEnd.UTC(), booking.StatusPending, booking.StatusConfirmed)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var others []*booking.Booking
+	for rows.Next() {
+		other, err := scanBooking(rows)
+		if err != nil {
+			return err
+		}
+		others = append(others, other)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if !resourceRecord.Fits(others, b.StartTime, b.EndTime) {
+		return resource.ErrResourceFull
+	}
+	return nil
+}
+
+func (repo *BookingRepository) GetByID(ctx context.Context, id string) (_ *booking.Booking, err error) {
+	query := `
+		SELECT ` + bookingColumns + `
 		FROM bookings
 		WHERE id = $1
 	`
-	var b booking.Booking
-	var createdAt, updatedAt time.Time
-	err := repo.db.QueryRowContext(ctx, query, id).Scan(
-		&b.ID,
-		&b.UserID,
-		&b.GymID,
-		&b.StartTime,
-		&b.EndTime,
-		&b.Status,
-		&createdAt,
-		&updatedAt,
-	)
+	ctx, call := repo.startCall(ctx, "GetByID", query)
+	defer func() { call.end(err) }()
+
+	b, err := scanBooking(repo.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, booking.ErrBookingNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
-	return &b, nil
+	call.rows = 1
+	return b, nil
 }
 
-func (repo *BookingRepository) Update(ctx context.Context, b *booking.Booking) error {
+func (repo *BookingRepository) Update(ctx context.Context, b *booking.Booking) (err error) {
 	query := `
 		UPDATE bookings
-		SET user_id = $1, gym_id = $2, start_time = $3, end_time = $4, status = $5, updated_at = $6
-		WHERE id = $7
+		SET user_id = $1, gym_id = $2, start_time = $3, end_time = $4, status = $5, status_reason = $6,
+			cancelled_late = $7, session_id = $8, trainer_id = $9, resource_id = $10, flag_reason = $11,
+			updated_at = $12
+		WHERE id = $13
 	`
-	_, err := repo.db.ExecContext(ctx, query,
+	ctx, call := repo.startCall(ctx, "Update", query)
+	defer func() { call.end(err) }()
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Only new times can take a place the resource does not have free, e.g. when the
+	// booking is rescheduled.
+	if b.ResourceID != "" {
+		var moved bool
+		err := tx.QueryRowContext(ctx, `SELECT start_time <> $2 OR end_time <> $3 FROM bookings WHERE id = $1 FOR UPDATE`,
+			b.ID, b.StartTime.UTC(), b.EndTime.UTC()).Scan(&moved)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if moved {
+			if err := reservePlace(ctx, tx, b); err != nil {
+				return err
+			}
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, query,
 		b.UserID,
 		b.GymID,
-		b.StartTime,
-		b.EndTime,
+		// The columns hold no zone, so times are stored in UTC, as scanBooking reads them.
+		b.StartTime.UTC(),
+		b.EndTime.UTC(),
 		b.Status,
+		b.StatusReason,
+		b.CancelledLate,
+		nullString(b.SessionID),
+		nullString(b.TrainerID),
+		nullString(b.ResourceID),
+		b.FlagReason,
 		time.Now(),
 		b.ID,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return tx.Commit()
 }
 
-func (repo *BookingRepository) ListByUserID(ctx context.Context, userID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+func (repo *BookingRepository) ListByUserID(ctx context.Context, userID string, startTime, endTime time.Time) (_ []*booking.Booking, err error) {
 	query := `
-		SELECT id, user_id, gym_id, start_time, end_time, status, created_at, updated_at
+		SELECT ` + bookingColumns + `
 		FROM bookings
 		WHERE user_id = $1 AND start_time >= $2 AND end_time <= $3
 		ORDER BY start_time ASC
 	`
-	rows, err := repo.db.QueryContext(ctx, query, userID, startTime, endTime)
+	ctx, call := repo.startCall(ctx, "ListByUserID", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, userID, startTime.UTC(), endTime.UTC())
 	if err != nil {
 		return nil, err
 	}
@@ -98,33 +359,53 @@ func (repo *BookingRepository) ListByUserID(ctx context.Context, userID string,
 
 	var bookings []*booking.Booking
 	for rows.Next() {
-		var b booking.Booking
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(
-			&b.ID,
-			&b.UserID,
-			&b.GymID,
-			&b.StartTime,
-			&b.EndTime,
-			&b.Status,
-			&createdAt,
-			&updatedAt,
-		); err != nil {
+		b, err := scanBooking(rows)
+		if err != nil {
 			return nil, err
 		}
-		bookings = append(bookings, &b)
+		bookings = append(bookings, b)
 	}
+	call.rows = int64(len(bookings))
 	return bookings, rows.Err()
 }
 
-func (repo *BookingRepository) ListByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+func (repo *BookingRepository) CountActiveByUserID(ctx context.Context, userID string, after time.Time) (_ int, err error) {
+	query := `
+		SELECT COUNT(*)
+		FROM bookings
+		WHERE user_id = $1 AND start_time > $2 AND status IN ($3, $4)
+	`
+	ctx, call := repo.startCall(ctx, "CountActiveByUserID", query)
+	defer func() { call.end(err) }()
+
+	var count int
+	if err := repo.db.QueryRowContext(ctx, query, userID, after.UTC(), booking.StatusPending, booking.StatusConfirmed).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (repo *BookingRepository) ListActiveByUserID(ctx context.Context, userID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	query := `
+		SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE user_id = $1 AND ($3::timestamp IS NULL OR start_time < $3) AND end_time > $2 AND status IN ($4, $5)
+		ORDER BY start_time ASC
+	`
+	return repo.listActive(ctx, "ListActiveByUserID", query, userID, startTime, endTime)
+}
+
+func (repo *BookingRepository) ListByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) (_ []*booking.Booking, err error) {
 	query := `
-		SELECT id, user_id, gym_id, start_time, end_time, status, created_at, updated_at
+		SELECT ` + bookingColumns + `
 		FROM bookings
 		WHERE gym_id = $1 AND start_time >= $2 AND end_time <= $3
 		ORDER BY start_time ASC
 	`
-	rows, err := repo.db.QueryContext(ctx, query, gymID, startTime, endTime)
+	ctx, call := repo.startCall(ctx, "ListByGymID", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, gymID, startTime.UTC(), endTime.UTC())
 	if err != nil {
 		return nil, err
 	}
@@ -132,30 +413,145 @@ func (repo *BookingRepository) ListByGymID(ctx context.Context, gymID string, st
 
 	var bookings []*booking.Booking
 	for rows.Next() {
-		var b booking.Booking
-		var createdAt, updatedAt time.Time
-		if err := rows.Scan(
-			&b.ID,
-			&b.UserID,
-			&b.GymID,
-			&b.StartTime,
-			&b.EndTime,
-			&b.Status,
-			&createdAt,
-			&updatedAt,
-		); err != nil {
+		b, err := scanBooking(rows)
+		if err != nil {
 			return nil, err
 		}
-		bookings = append(bookings, &b)
+		bookings = append(bookings, b)
 	}
+	call.rows = int64(len(bookings))
 	return bookings, rows.Err()
 }
 
-func (repo *BookingRepository) DeleteByID(ctx context.Context, id string) error {
+func (repo *BookingRepository) ListActiveByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	query := `
+		SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE gym_id = $1 AND ($3::timestamp IS NULL OR start_time < $3) AND end_time > $2 AND status IN ($4, $5)
+		ORDER BY start_time ASC
+	`
+	return repo.listActive(ctx, "ListActiveByGymID", query, gymID, startTime, endTime)
+}
+
+func (repo *BookingRepository) ListActiveByTrainerID(ctx context.Context, trainerID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	query := `
+		SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE trainer_id = $1 AND ($3::timestamp IS NULL OR start_time < $3) AND end_time > $2 AND status IN ($4, $5)
+		ORDER BY start_time ASC
+	`
+	return repo.listActive(ctx, "ListActiveByTrainerID", query, trainerID, startTime, endTime)
+}
+
+func (repo *BookingRepository) ListActiveByResourceID(ctx context.Context, resourceID string, startTime, endTime time.Time) ([]*booking.Booking, error) {
+	query := `
+		SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE resource_id = $1 AND ($3::timestamp IS NULL OR start_time < $3) AND end_time > $2 AND status IN ($4, $5)
+		ORDER BY start_time ASC
+	`
+	return repo.listActive(ctx, "ListActiveByResourceID", query, resourceID, startTime, endTime)
+}
+
+// listActive runs a query selecting the pending and confirmed bookings of one user, gym,
+// trainer or resource that overlap [startTime, endTime). A zero endTime is passed as NULL.
+func (repo *BookingRepository) listActive(ctx context.Context, operation, query string, id string, startTime, endTime time.Time) (_ []*booking.Booking, err error) {
+	ctx, call := repo.startCall(ctx, operation, query)
+	defer func() { call.end(err) }()
+
+	end := sql.NullTime{Time: endTime.UTC(), Valid: !endTime.IsZero()}
+	rows, err := repo.db.QueryContext(ctx, query, id, startTime.UTC(), end, booking.StatusPending, booking.StatusConfirmed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bookings []*booking.Booking
+	for rows.Next() {
+		b, err := scanBooking(rows)
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, b)
+	}
+	call.rows = int64(len(bookings))
+	return bookings, rows.Err()
+}
+
+func (repo *BookingRepository) ListBySessionID(ctx context.Context, sessionID string) (_ []*booking.Booking, err error) {
+	query := `
+		SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE session_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+	ctx, call := repo.startCall(ctx, "ListBySessionID", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bookings []*booking.Booking
+	for rows.Next() {
+		b, err := scanBooking(rows)
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, b)
+	}
+	call.rows = int64(len(bookings))
+	return bookings, rows.Err()
+}
+
+func (repo *BookingRepository) DeleteByID(ctx context.Context, id string) (err error) {
 	query := `
 		DELETE FROM bookings
 		WHERE id = $1
 	`
-	_, err := repo.db.ExecContext(ctx, query, id)
-	return err
+	ctx, call := repo.startCall(ctx, "DeleteByID", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *BookingRepository) ListStatusChanges(ctx context.Context, bookingID string) (_ []*booking.StatusChange, err error) {
+	query := `
+		SELECT booking_id, COALESCE(from_status, ''), to_status, reason, changed_at
+		FROM booking_status_history
+		WHERE booking_id = $1
+		ORDER BY changed_at ASC, id ASC
+	`
+	ctx, call := repo.startCall(ctx, "ListStatusChanges", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*booking.StatusChange
+	for rows.Next() {
+		var change booking.StatusChange
+		if err := rows.Scan(
+			&change.BookingID,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.Reason,
+			&change.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		changes = append(changes, &change)
+	}
+	call.rows = int64(len(changes))
+	return changes, rows.Err()
 }
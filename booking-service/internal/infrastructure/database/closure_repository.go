@@ -0,0 +1,153 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+)
+
+// ClosureRepository stores the periods gyms are closed.
+type ClosureRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewClosureRepository(db *sql.DB, logger *slog.Logger) *ClosureRepository {
+	return &ClosureRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// closureColumns are the columns of a closure, in the order scanClosure reads them.
+const closureColumns = `id, gym_id, start_time, end_time, reason, recurring, created_at`
+
+func scanClosure(row rowScanner) (*closure.Closure, error) {
+	var c closure.Closure
+	err := row.Scan(
+		&c.ID,
+		&c.GymID,
+		&c.StartTime,
+		&c.EndTime,
+		&c.Reason,
+		&c.Recurring,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (repo *ClosureRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "ClosureRepository", operation, query, closure.ErrClosureNotFound)
+}
+
+func (repo *ClosureRepository) CreateMany(ctx context.Context, closures []*closure.Closure) (err error) {
+	query := `
+		INSERT INTO gym_closures (` + closureColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	ctx, call := repo.startCall(ctx, "CreateMany", query)
+	defer func() { call.end(err) }()
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, c := range closures {
+		result, err := stmt.ExecContext(ctx,
+			c.ID,
+			c.GymID,
+			// The columns hold no zone, so times are stored in UTC, as scanClosure reads them.
+			c.StartTime.UTC(),
+			c.EndTime.UTC(),
+			c.Reason,
+			c.Recurring,
+			c.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+		call.addRowsAffected(result)
+	}
+
+	return tx.Commit()
+}
+
+func (repo *ClosureRepository) GetByID(ctx context.Context, id string) (_ *closure.Closure, err error) {
+	query := `
+		SELECT ` + closureColumns + `
+		FROM gym_closures
+		WHERE id = $1
+	`
+	ctx, call := repo.startCall(ctx, "GetByID", query)
+	defer func() { call.end(err) }()
+
+	c, err := scanClosure(repo.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, closure.ErrClosureNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	call.rows = 1
+	return c, nil
+}
+
+func (repo *ClosureRepository) Delete(ctx context.Context, id string) (err error) {
+	query := `
+		DELETE FROM gym_closures
+		WHERE id = $1
+	`
+	ctx, call := repo.startCall(ctx, "Delete", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	if call.rows == 0 {
+		return closure.ErrClosureNotFound
+	}
+	return nil
+}
+
+func (repo *ClosureRepository) ListByGymID(ctx context.Context, gymID string) (_ []*closure.Closure, err error) {
+	query := `
+		SELECT ` + closureColumns + `
+		FROM gym_closures
+		WHERE gym_id = $1
+		ORDER BY start_time ASC, id ASC
+	`
+	ctx, call := repo.startCall(ctx, "ListByGymID", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, gymID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var closures []*closure.Closure
+	for rows.Next() {
+		c, err := scanClosure(rows)
+		if err != nil {
+			return nil, err
+		}
+		closures = append(closures, c)
+	}
+	call.rows = int64(len(closures))
+	return closures, rows.Err()
+}
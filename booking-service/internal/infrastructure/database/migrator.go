@@ -0,0 +1,326 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrChecksumMismatch = errors.New("applied migration differs from its file")
+	ErrUnknownMigration = errors.New("database has a migration this binary does not know")
+	ErrNothingToRevert  = errors.New("no migration has been applied")
+)
+
+// migrationLockID is the key of the Postgres advisory lock held while migrating, so that
+// instances deployed at the same time apply each migration once.
+const migrationLockID = 7_240_613_001
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change. Checksum covers the up script, so an applied
+// migration that was edited afterwards is detected.
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// MigrationStatus describes a known migration and, when applied, when that happened.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// LoadMigrations reads NNNN_name.up.sql / NNNN_name.down.sql pairs from fsys, ordered by
+// version. Every version must have both scripts.
+func LoadMigrations(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected file %s in migrations", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(data)
+			sum := sha256.Sum256(data)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(data)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down script", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator applies and reverts migrations, recording them in the schema_migrations table.
+type Migrator struct {
+	db         *sql.DB
+	migrations []*Migration
+	logger     *slog.Logger
+}
+
+func NewMigrator(db *sql.DB, migrations []*Migration, logger *slog.Logger) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     logger,
+	}
+}
+
+type appliedMigration struct {
+	checksum  string
+	appliedAt time.Time
+}
+
+// Up applies every pending migration in order and returns the versions it applied.
+func (migrator *Migrator) Up(ctx context.Context) ([]int, error) {
+	var applied []int
+	err := migrator.withLock(ctx, func(conn *sql.Conn) error {
+		state, err := migrator.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrator.migrations {
+			if _, done := state[migration.Version]; done {
+				continue
+			}
+			if err := migrator.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			applied = append(applied, migration.Version)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the most recently applied migration and returns its version.
+func (migrator *Migrator) Down(ctx context.Context) (int, error) {
+	var reverted int
+	err := migrator.withLock(ctx, func(conn *sql.Conn) error {
+		migration, err := migrator.latestApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := migrator.revert(ctx, conn, migration); err != nil {
+			return err
+		}
+		reverted = migration.Version
+		return nil
+	})
+	return reverted, err
+}
+
+// Redo reverts the most recently applied migration and applies it again.
+func (migrator *Migrator) Redo(ctx context.Context) (int, error) {
+	var redone int
+	err := migrator.withLock(ctx, func(conn *sql.Conn) error {
+		migration, err := migrator.latestApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := migrator.revert(ctx, conn, migration); err != nil {
+			return err
+		}
+		if err := migrator.apply(ctx, conn, migration); err != nil {
+			return err
+		}
+		redone = migration.Version
+		return nil
+	})
+	return redone, err
+}
+
+// Status lists every known migration and whether it has been applied.
+func (migrator *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := migrator.withLock(ctx, func(conn *sql.Conn) error {
+		state, err := migrator.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrator.migrations {
+			status := MigrationStatus{Migration: *migration}
+			if record, done := state[migration.Version]; done {
+				appliedAt := record.appliedAt
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// withLock runs fn on a single connection holding the migration advisory lock.
+func (migrator *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := migrator.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		if _, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID); unlockErr != nil && err == nil {
+			err = fmt.Errorf("failed to release migration lock: %w", unlockErr)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			checksum CHAR(64) NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+// applied returns the recorded migrations after checking that each one is known and
+// unchanged.
+func (migrator *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int]appliedMigration, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	state := make(map[int]appliedMigration)
+	for rows.Next() {
+		var version int
+		var record appliedMigration
+		if err := rows.Scan(&version, &record.checksum, &record.appliedAt); err != nil {
+			return nil, err
+		}
+		state[version] = record
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	known := make(map[int]*Migration, len(migrator.migrations))
+	for _, migration := range migrator.migrations {
+		known[migration.Version] = migration
+	}
+	for version, record := range state {
+		migration, exists := known[version]
+		if !exists {
+			return nil, fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+		}
+		if migration.Checksum != record.checksum {
+			return nil, fmt.Errorf("%w: %04d_%s", ErrChecksumMismatch, migration.Version, migration.Name)
+		}
+	}
+	return state, nil
+}
+
+func (migrator *Migrator) latestApplied(ctx context.Context, conn *sql.Conn) (*Migration, error) {
+	state, err := migrator.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(migrator.migrations) - 1; i >= 0; i-- {
+		if _, done := state[migrator.migrations[i].Version]; done {
+			return migrator.migrations[i], nil
+		}
+	}
+	return nil, ErrNothingToRevert
+}
+
+func (migrator *Migrator) apply(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+			migration.Version, migration.Name, migration.Checksum,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply migration %04d_%s: %w", migration.Version, migration.Name, err)
+	}
+	migrator.logger.InfoContext(ctx, "migration applied",
+		slog.Int("version", migration.Version),
+		slog.String("name", migration.Name),
+	)
+	return nil
+}
+
+func (migrator *Migrator) revert(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revert migration %04d_%s: %w", migration.Version, migration.Name, err)
+	}
+	migrator.logger.InfoContext(ctx, "migration reverted",
+		slog.Int("version", migration.Version),
+		slog.String("name", migration.Name),
+	)
+	return nil
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+)
+
+// PolicyRepository stores gym booking policies. Durations are kept as whole seconds.
+type PolicyRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPolicyRepository(db *sql.DB, logger *slog.Logger) *PolicyRepository {
+	return &PolicyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *PolicyRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "PolicyRepository", operation, query, policy.ErrPolicyNotFound)
+}
+
+func (repo *PolicyRepository) Get(ctx context.Context, gymID string) (_ *policy.Policy, err error) {
+	query := `
+		SELECT gym_id, min_lead_time_seconds, max_advance_seconds, min_duration_seconds, max_duration_seconds,
+			slot_alignment_seconds, max_bookings_per_day, time_zone, cancellation_cutoff_seconds, max_strikes,
+			strike_window_seconds, suspension_seconds, buffer_before_seconds, buffer_after_seconds, updated_at
+		FROM gym_booking_policies
+		WHERE gym_id = $1
+	`
+	ctx, call := repo.startCall(ctx, "Get", query)
+	defer func() { call.end(err) }()
+
+	var p policy.Policy
+	var minLeadTime, maxAdvance, minDuration, maxDuration, slotAlignment int64
+	var cancellationCutoff, strikeWindow, suspension, bufferBefore, bufferAfter int64
+	err = repo.db.QueryRowContext(ctx, query, gymID).Scan(
+		&p.GymID,
+		&minLeadTime,
+		&maxAdvance,
+		&minDuration,
+		&maxDuration,
+		&slotAlignment,
+		&p.MaxBookingsPerDay,
+		&p.TimeZone,
+		&cancellationCutoff,
+		&p.MaxStrikes,
+		&strikeWindow,
+		&suspension,
+		&bufferBefore,
+		&bufferAfter,
+		&p.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, policy.ErrPolicyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.MinLeadTime = time.Duration(minLeadTime) * time.Second
+	p.MaxAdvance = time.Duration(maxAdvance) * time.Second
+	p.MinDuration = time.Duration(minDuration) * time.Second
+	p.MaxDuration = time.Duration(maxDuration) * time.Second
+	p.SlotAlignment = time.Duration(slotAlignment) * time.Second
+	p.CancellationCutoff = time.Duration(cancellationCutoff) * time.Second
+	p.StrikeWindow = time.Duration(strikeWindow) * time.Second
+	p.SuspensionPeriod = time.Duration(suspension) * time.Second
+	p.BufferBefore = time.Duration(bufferBefore) * time.Second
+	p.BufferAfter = time.Duration(bufferAfter) * time.Second
+	call.rows = 1
+	return &p, nil
+}
+
+func (repo *PolicyRepository) Save(ctx context.Context, p *policy.Policy) (err error) {
+	query := `
+		INSERT INTO gym_booking_policies (gym_id, min_lead_time_seconds, max_advance_seconds, min_duration_seconds,
+			max_duration_seconds, slot_alignment_seconds, max_bookings_per_day, time_zone, cancellation_cutoff_seconds,
+			max_strikes, strike_window_seconds, suspension_seconds, buffer_before_seconds, buffer_after_seconds, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (gym_id) DO UPDATE SET
+			min_lead_time_seconds = EXCLUDED.min_lead_time_seconds,
+			max_advance_seconds = EXCLUDED.max_advance_seconds,
+			min_duration_seconds = EXCLUDED.min_duration_seconds,
+			max_duration_seconds = EXCLUDED.max_duration_seconds,
+			slot_alignment_seconds = EXCLUDED.slot_alignment_seconds,
+			max_bookings_per_day = EXCLUDED.max_bookings_per_day,
+			time_zone = EXCLUDED.time_zone,
+			cancellation_cutoff_seconds = EXCLUDED.cancellation_cutoff_seconds,
+			max_strikes = EXCLUDED.max_strikes,
+			strike_window_seconds = EXCLUDED.strike_window_seconds,
+			suspension_seconds = EXCLUDED.suspension_seconds,
+			buffer_before_seconds = EXCLUDED.buffer_before_seconds,
+			buffer_after_seconds = EXCLUDED.buffer_after_seconds,
+			updated_at = EXCLUDED.updated_at
+	`
+	ctx, call := repo.startCall(ctx, "Save", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		p.GymID,
+		int64(p.MinLeadTime/time.Second),
+		int64(p.MaxAdvance/time.Second),
+		int64(p.MinDuration/time.Second),
+		int64(p.MaxDuration/time.Second),
+		int64(p.SlotAlignment/time.Second),
+		p.MaxBookingsPerDay,
+		p.TimeZone,
+		int64(p.CancellationCutoff/time.Second),
+		p.MaxStrikes,
+		int64(p.StrikeWindow/time.Second),
+		int64(p.SuspensionPeriod/time.Second),
+		int64(p.BufferBefore/time.Second),
+		int64(p.BufferAfter/time.Second),
+		p.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
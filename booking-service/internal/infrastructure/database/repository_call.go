@@ -0,0 +1,83 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/yourusername/fitbook/booking-service/internal/infrastructure/database")
+
+// repositoryCall traces and logs one repository method. The span records the SQL statement
+// and the number of rows read or written.
+type repositoryCall struct {
+	logger     *slog.Logger
+	ctx        context.Context
+	span       trace.Span
+	repository string
+	operation  string
+	notFound   error
+	start      time.Time
+	rows       int64
+}
+
+// startCall starts a call to operation of the named repository. notFound is the
+// repository's not-found error, which is an expected outcome rather than a failure.
+func startCall(ctx context.Context, logger *slog.Logger, repository, operation, query string, notFound error) (context.Context, *repositoryCall) {
+	ctx, span := tracer.Start(ctx, repository+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.operation.name", operation),
+			attribute.String("db.query.text", strings.Join(strings.Fields(query), " ")),
+		),
+	)
+	return ctx, &repositoryCall{
+		logger:     logger,
+		ctx:        ctx,
+		span:       span,
+		repository: repository,
+		operation:  operation,
+		notFound:   notFound,
+		start:      time.Now(),
+	}
+}
+
+func (call *repositoryCall) addRowsAffected(result sql.Result) {
+	if affected, err := result.RowsAffected(); err == nil {
+		call.rows += affected
+	}
+}
+
+// end finishes the call. Use it deferred with the method's named error result.
+func (call *repositoryCall) end(err error) {
+	defer call.span.End()
+	duration := time.Since(call.start)
+	call.span.SetAttributes(attribute.Int64("db.response.rows", call.rows))
+
+	if err != nil && !errors.Is(err, call.notFound) {
+		call.span.RecordError(err)
+		call.span.SetStatus(codes.Error, err.Error())
+		call.logger.ErrorContext(call.ctx, "repository call failed",
+			slog.String("repository", call.repository),
+			slog.String("operation", call.operation),
+			slog.Duration("duration", duration),
+			slog.Any("error", err),
+		)
+		return
+	}
+	call.logger.DebugContext(call.ctx, "repository call",
+		slog.String("repository", call.repository),
+		slog.String("operation", call.operation),
+		slog.Duration("duration", duration),
+		slog.Int64("rows", call.rows),
+	)
+}
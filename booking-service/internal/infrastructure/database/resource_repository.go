@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+)
+
+// ResourceRepository stores the bookable resources of gyms. Buffers are kept as whole
+// seconds.
+type ResourceRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewResourceRepository(db *sql.DB, logger *slog.Logger) *ResourceRepository {
+	return &ResourceRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// resourceColumns are the columns of a resource, in the order scanResource reads them.
+const resourceColumns = `id, gym_id, name, type, capacity, buffer_before_seconds, buffer_after_seconds, active,
+	out_of_service_reason, created_at, updated_at`
+
+func scanResource(row rowScanner) (*resource.Resource, error) {
+	var r resource.Resource
+	var bufferBefore, bufferAfter int64
+	err := row.Scan(
+		&r.ID,
+		&r.GymID,
+		&r.Name,
+		&r.Type,
+		&r.Capacity,
+		&bufferBefore,
+		&bufferAfter,
+		&r.Active,
+		&r.OutOfServiceReason,
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	r.BufferBefore = time.Duration(bufferBefore) * time.Second
+	r.BufferAfter = time.Duration(bufferAfter) * time.Second
+	return &r, nil
+}
+
+func (repo *ResourceRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "ResourceRepository", operation, query, resource.ErrResourceNotFound)
+}
+
+func (repo *ResourceRepository) Create(ctx context.Context, r *resource.Resource) (err error) {
+	query := `
+		INSERT INTO resources (` + resourceColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	ctx, call := repo.startCall(ctx, "Create", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		r.ID,
+		r.GymID,
+		r.Name,
+		r.Type,
+		r.Capacity,
+		int64(r.BufferBefore/time.Second),
+		int64(r.BufferAfter/time.Second),
+		r.Active,
+		r.OutOfServiceReason,
+		r.CreatedAt,
+		r.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *ResourceRepository) GetByID(ctx context.Context, id string) (_ *resource.Resource, err error) {
+	query := `
+		SELECT ` + resourceColumns + `
+		FROM resources
+		WHERE id = $1
+	`
+	ctx, call := repo.startCall(ctx, "GetByID", query)
+	defer func() { call.end(err) }()
+
+	r, err := scanResource(repo.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, resource.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	call.rows = 1
+	return r, nil
+}
+
+func (repo *ResourceRepository) Update(ctx context.Context, r *resource.Resource) (err error) {
+	query := `
+		UPDATE resources
+		SET name = $1, type = $2, capacity = $3, buffer_before_seconds = $4, buffer_after_seconds = $5, active = $6,
+			out_of_service_reason = $7, updated_at = $8
+		WHERE id = $9
+	`
+	ctx, call := repo.startCall(ctx, "Update", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		r.Name,
+		r.Type,
+		r.Capacity,
+		int64(r.BufferBefore/time.Second),
+		int64(r.BufferAfter/time.Second),
+		r.Active,
+		r.OutOfServiceReason,
+		r.UpdatedAt,
+		r.ID,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *ResourceRepository) ListByGymID(ctx context.Context, gymID, resourceType string) (_ []*resource.Resource, err error) {
+	query := `
+		SELECT ` + resourceColumns + `
+		FROM resources
+		WHERE gym_id = $1 AND ($2 = '' OR type = $2)
+		ORDER BY type ASC, name ASC, id ASC
+	`
+	ctx, call := repo.startCall(ctx, "ListByGymID", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, gymID, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resources []*resource.Resource
+	for rows.Next() {
+		r, err := scanResource(rows)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+	call.rows = int64(len(resources))
+	return resources, rows.Err()
+}
@@ -0,0 +1,195 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+)
+
+// SessionRepository stores class sessions.
+type SessionRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewSessionRepository(db *sql.DB, logger *slog.Logger) *SessionRepository {
+	return &SessionRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// sessionColumns are the columns of a class session, in the order scanSession reads them.
+const sessionColumns = `id, gym_id, instructor_id, title, start_time, end_time, capacity, status, cancel_reason,
+	template_id, occurrence_date, created_at, updated_at`
+
+func scanSession(row rowScanner) (*session.ClassSession, error) {
+	var s session.ClassSession
+	var templateID sql.NullString
+	var occurrenceDate sql.NullTime
+	err := row.Scan(
+		&s.ID,
+		&s.GymID,
+		&s.InstructorID,
+		&s.Title,
+		&s.StartTime,
+		&s.EndTime,
+		&s.Capacity,
+		&s.Status,
+		&s.CancelReason,
+		&templateID,
+		&occurrenceDate,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.TemplateID = templateID.String
+	if occurrenceDate.Valid {
+		s.OccurrenceDate = dateOnly(occurrenceDate.Time)
+	}
+	return &s, nil
+}
+
+func (repo *SessionRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "SessionRepository", operation, query, session.ErrSessionNotFound)
+}
+
+func (repo *SessionRepository) Create(ctx context.Context, s *session.ClassSession) (err error) {
+	query := `
+		INSERT INTO class_sessions (` + sessionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+	ctx, call := repo.startCall(ctx, "Create", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		s.ID,
+		s.GymID,
+		s.InstructorID,
+		s.Title,
+		// The columns hold no zone, so times are stored in UTC, as scanSession reads them.
+		s.StartTime.UTC(),
+		s.EndTime.UTC(),
+		s.Capacity,
+		s.Status,
+		s.CancelReason,
+		nullString(s.TemplateID),
+		nullDate(s.OccurrenceDate),
+		s.CreatedAt,
+		s.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *SessionRepository) GetByID(ctx context.Context, id string) (_ *session.ClassSession, err error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM class_sessions
+		WHERE id = $1
+	`
+	ctx, call := repo.startCall(ctx, "GetByID", query)
+	defer func() { call.end(err) }()
+
+	s, err := scanSession(repo.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, session.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	call.rows = 1
+	return s, nil
+}
+
+func (repo *SessionRepository) Update(ctx context.Context, s *session.ClassSession) (err error) {
+	query := `
+		UPDATE class_sessions
+		SET instructor_id = $1, title = $2, start_time = $3, end_time = $4, capacity = $5, status = $6,
+			cancel_reason = $7, updated_at = $8
+		WHERE id = $9
+	`
+	ctx, call := repo.startCall(ctx, "Update", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		s.InstructorID,
+		s.Title,
+		s.StartTime.UTC(),
+		s.EndTime.UTC(),
+		s.Capacity,
+		s.Status,
+		s.CancelReason,
+		s.UpdatedAt,
+		s.ID,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *SessionRepository) ListByGymID(ctx context.Context, gymID string, startTime, endTime time.Time) (_ []*session.ClassSession, err error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM class_sessions
+		WHERE gym_id = $1 AND start_time >= $2 AND start_time < $3
+		ORDER BY start_time ASC, id ASC
+	`
+	ctx, call := repo.startCall(ctx, "ListByGymID", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, gymID, startTime.UTC(), endTime.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*session.ClassSession
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	call.rows = int64(len(sessions))
+	return sessions, rows.Err()
+}
+
+func (repo *SessionRepository) ListByTemplateID(ctx context.Context, templateID string, firstDate time.Time) (_ []*session.ClassSession, err error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM class_sessions
+		WHERE template_id = $1 AND occurrence_date >= $2
+		ORDER BY occurrence_date ASC
+	`
+	ctx, call := repo.startCall(ctx, "ListByTemplateID", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, templateID, firstDate.Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*session.ClassSession
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	call.rows = int64(len(sessions))
+	return sessions, rows.Err()
+}
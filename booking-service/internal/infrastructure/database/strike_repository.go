@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+)
+
+// StrikeRepository stores the strikes members earn for late cancellations and no-shows.
+type StrikeRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewStrikeRepository(db *sql.DB, logger *slog.Logger) *StrikeRepository {
+	return &StrikeRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *StrikeRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "StrikeRepository", operation, query, nil)
+}
+
+func (repo *StrikeRepository) Add(ctx context.Context, s *strike.Strike) (err error) {
+	query := `
+		INSERT INTO booking_strikes (id, user_id, gym_id, booking_id, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (booking_id, reason) DO NOTHING
+	`
+	ctx, call := repo.startCall(ctx, "Add", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query, s.ID, s.UserID, s.GymID, s.BookingID, s.Reason, s.CreatedAt)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *StrikeRepository) ListActive(ctx context.Context, userID, gymID string, since time.Time) (_ []*strike.Strike, err error) {
+	query := `
+		SELECT id, user_id, gym_id, booking_id, reason, created_at
+		FROM booking_strikes
+		WHERE user_id = $1 AND gym_id = $2 AND created_at >= $3 AND cleared_at IS NULL
+		ORDER BY created_at ASC, id ASC
+	`
+	ctx, call := repo.startCall(ctx, "ListActive", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, userID, gymID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var strikes []*strike.Strike
+	for rows.Next() {
+		var s strike.Strike
+		if err := rows.Scan(&s.ID, &s.UserID, &s.GymID, &s.BookingID, &s.Reason, &s.CreatedAt); err != nil {
+			return nil, err
+		}
+		strikes = append(strikes, &s)
+	}
+	call.rows = int64(len(strikes))
+	return strikes, rows.Err()
+}
+
+func (repo *StrikeRepository) Clear(ctx context.Context, userID, gymID, clearedBy string, at time.Time) (_ int, err error) {
+	query := `
+		UPDATE booking_strikes
+		SET cleared_at = $1, cleared_by = $2
+		WHERE user_id = $3 AND gym_id = $4 AND cleared_at IS NULL
+	`
+	ctx, call := repo.startCall(ctx, "Clear", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query, at, clearedBy, userID, gymID)
+	if err != nil {
+		return 0, err
+	}
+	call.addRowsAffected(result)
+	return int(call.rows), nil
+}
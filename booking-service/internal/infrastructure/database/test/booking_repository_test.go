@@ -0,0 +1,186 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/database"
+	"github.com/yourusername/fitbook/booking-service/migrations"
+)
+
+// openTestDB connects to the disposable database in BOOKING_TEST_DB_URL with every
+// migration applied, and rolls them back when the test ends. Without the variable, the
+// test is skipped.
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("BOOKING_TEST_DB_URL")
+	if dsn == "" {
+		t.Skip("BOOKING_TEST_DB_URL not set")
+	}
+	ctx := context.Background()
+	db, err := database.Connect(ctx, "postgres", dsn, database.PoolOptions{ConnectTimeout: 5 * time.Second}, mocks.NewLogger())
+	require.NoError(t, err)
+
+	loaded, err := database.LoadMigrations(migrations.FS)
+	require.NoError(t, err)
+	migrator := database.NewMigrator(db, loaded, mocks.NewLogger())
+	t.Cleanup(func() {
+		for {
+			if _, err := migrator.Down(ctx); err != nil {
+				break
+			}
+		}
+		db.Exec(`DROP TABLE IF EXISTS schema_migrations`)
+		db.Close()
+	})
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	return db
+}
+
+func TestBookingRepositoryHoldsSessionCapacity(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	sessions := database.NewSessionRepository(db, mocks.NewLogger())
+	bookings := database.NewBookingRepository(db, mocks.NewLogger())
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour).UTC()
+	classSession, err := session.NewClassSession("gym1", "", "Spin", start, start.Add(time.Hour), 2)
+	require.NoError(t, err)
+	classSession.ID = uuid.New().String()
+	require.NoError(t, sessions.Create(ctx, classSession))
+
+	newEnrollment := func(userID string) *booking.Booking {
+		enrollment, err := booking.NewBooking(userID, "gym1", classSession.StartTime, classSession.EndTime)
+		require.NoError(t, err)
+		enrollment.ID = uuid.New().String()
+		enrollment.SessionID = classSession.ID
+		return enrollment
+	}
+	enroll := func(userID string) error {
+		return bookings.Create(ctx, newEnrollment(userID))
+	}
+
+	// Concurrent enrollments cannot take more places than the session has.
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		enrollment := newEnrollment(fmt.Sprintf("user%d", i))
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = bookings.Create(ctx, enrollment)
+		}(i)
+	}
+	wg.Wait()
+
+	enrolled := 0
+	for _, err := range errs {
+		if err == nil {
+			enrolled++
+			continue
+		}
+		assert.ErrorIs(t, err, session.ErrSessionFull)
+	}
+	assert.Equal(t, 2, enrolled)
+
+	stored, err := bookings.ListBySessionID(ctx, classSession.ID)
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+
+	require.NoError(t, stored[0].Cancel())
+	require.NoError(t, bookings.Update(ctx, stored[0]))
+	assert.ErrorIs(t, enroll(stored[1].UserID), session.ErrAlreadyEnrolled)
+	assert.NoError(t, enroll("user9"), "cancelled enrollments free their place")
+}
+
+func TestBookingRepositoryHoldsResourceCapacity(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	resources := database.NewResourceRepository(db, mocks.NewLogger())
+	bookings := database.NewBookingRepository(db, mocks.NewLogger())
+
+	court := &resource.Resource{ID: uuid.New().String(), GymID: "gym1", Name: "Court 1", Type: "court", Capacity: 1, Active: true}
+	require.NoError(t, resources.Create(ctx, court))
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour).UTC()
+	newBooking := func(userID string, start time.Time) *booking.Booking {
+		created, err := booking.NewBooking(userID, "gym1", start, start.Add(time.Hour))
+		require.NoError(t, err)
+		created.ID = uuid.New().String()
+		created.ResourceID = court.ID
+		return created
+	}
+
+	// Concurrent bookings cannot take more places than the resource has.
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		created := newBooking(fmt.Sprintf("user%d", i), start)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = bookings.Create(ctx, created)
+		}(i)
+	}
+	wg.Wait()
+
+	booked := 0
+	for _, err := range errs {
+		if err == nil {
+			booked++
+			continue
+		}
+		assert.ErrorIs(t, err, resource.ErrResourceFull)
+	}
+	assert.Equal(t, 1, booked)
+
+	later := newBooking("user9", start.Add(2*time.Hour))
+	require.NoError(t, bookings.Create(ctx, later))
+	require.NoError(t, later.Reschedule(start.Add(30*time.Minute), start.Add(90*time.Minute), time.Now()))
+	assert.ErrorIs(t, bookings.Update(ctx, later), resource.ErrResourceFull, "moving into a full stretch is refused")
+
+	require.NoError(t, later.Confirm())
+	later.StartTime, later.EndTime = start.Add(2*time.Hour), start.Add(3*time.Hour)
+	assert.NoError(t, bookings.Update(ctx, later), "updates that keep the times do not count the places again")
+}
+
+func TestBookingRepositoryKeepsTimeZones(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	bookings := database.NewBookingRepository(db, mocks.NewLogger())
+
+	// Clients send times with any offset; they must come back as the same instants.
+	zone := time.FixedZone("+02:00", 2*60*60)
+	day := time.Now().AddDate(0, 0, 7)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, zone)
+	created, err := booking.NewBooking("user1", "gym1", start, start.Add(time.Hour))
+	require.NoError(t, err)
+	created.ID = uuid.New().String()
+	require.NoError(t, bookings.Create(ctx, created))
+
+	stored, err := bookings.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.StartTime.Equal(start), "stored %s, want %s", stored.StartTime, start)
+	assert.True(t, stored.EndTime.Equal(start.Add(time.Hour)))
+
+	overlapping, err := bookings.ListActiveByGymID(ctx, "gym1", start.Add(30*time.Minute).UTC(), start.Add(2*time.Hour).UTC())
+	require.NoError(t, err)
+	assert.Len(t, overlapping, 1, "windows in other zones find the booking too")
+
+	overlapping, err = bookings.ListActiveByGymID(ctx, "gym1", start.Add(time.Hour).UTC(), start.Add(2*time.Hour).UTC())
+	require.NoError(t, err)
+	assert.Empty(t, overlapping)
+}
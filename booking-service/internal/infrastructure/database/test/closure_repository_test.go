@@ -0,0 +1,35 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/database"
+)
+
+func TestClosureRepositoryKeepsTimeZones(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	closures := database.NewClosureRepository(db, mocks.NewLogger())
+
+	// An all-day closure imported for a gym in Berlin starts at midnight there, which is
+	// 23:00 UTC the day before.
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	start := time.Date(time.Now().Year()+1, time.December, 25, 0, 0, 0, 0, berlin)
+	closureRecord, err := closure.NewClosure("gym1", start, start.AddDate(0, 0, 1), "Christmas", true)
+	require.NoError(t, err)
+	closureRecord.ID = uuid.New().String()
+	require.NoError(t, closures.CreateMany(ctx, []*closure.Closure{closureRecord}))
+
+	stored, err := closures.GetByID(ctx, closureRecord.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.StartTime.Equal(start), "stored %s, want %s", stored.StartTime, start)
+	assert.True(t, stored.EndTime.Equal(start.AddDate(0, 0, 1)))
+}
@@ -0,0 +1,46 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/database"
+)
+
+func TestSessionRepositoryKeepsTimeZones(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	sessions := database.NewSessionRepository(db, mocks.NewLogger())
+
+	// Generated sessions carry the gym's zone, which the columns cannot hold.
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	day := time.Now().AddDate(0, 0, 7)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 18, 0, 0, 0, berlin)
+	classSession, err := session.NewClassSession("gym1", "", "Spin", start, start.Add(time.Hour), 10)
+	require.NoError(t, err)
+	classSession.ID = uuid.New().String()
+	require.NoError(t, sessions.Create(ctx, classSession))
+
+	stored, err := sessions.GetByID(ctx, classSession.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.StartTime.Equal(start), "stored %s, want %s", stored.StartTime, start)
+	assert.True(t, stored.EndTime.Equal(start.Add(time.Hour)))
+
+	listed, err := sessions.ListByGymID(ctx, "gym1", start, start.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, listed, 1, "windows in other zones find the session too")
+
+	moved := start.Add(2 * time.Hour)
+	require.NoError(t, stored.Reschedule("", "Spin", moved, moved.Add(time.Hour), 10, 0))
+	require.NoError(t, sessions.Update(ctx, stored))
+	stored, err = sessions.GetByID(ctx, classSession.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.StartTime.Equal(moved), "stored %s, want %s", stored.StartTime, moved)
+}
@@ -0,0 +1,38 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/database"
+)
+
+func TestTrainerRepositoryKeepsTimeOffZones(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	trainers := database.NewTrainerRepository(db, mocks.NewLogger())
+
+	trainerRecord := &trainer.Trainer{ID: uuid.New().String(), Name: "Alex", TimeZone: "Europe/Berlin", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	require.NoError(t, trainers.Create(ctx, trainerRecord))
+
+	// Time off sent with an offset must come back as the same instants.
+	zone := time.FixedZone("+02:00", 2*60*60)
+	day := time.Now().AddDate(0, 0, 7)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, zone)
+	timeOff, err := trainer.NewTimeOff(trainerRecord.ID, start, start.Add(3*time.Hour), "Dentist")
+	require.NoError(t, err)
+	timeOff.ID = uuid.New().String()
+	require.NoError(t, trainers.AddTimeOff(ctx, timeOff))
+
+	listed, err := trainers.ListTimeOff(ctx, trainerRecord.ID, start.Add(2*time.Hour+30*time.Minute), start.Add(4*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, listed, 1, "windows in other zones find the time off too")
+	assert.True(t, listed[0].StartTime.Equal(start), "stored %s, want %s", listed[0].StartTime, start)
+	assert.True(t, listed[0].EndTime.Equal(start.Add(3*time.Hour)))
+}
@@ -0,0 +1,181 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+)
+
+// TimetableRepository stores timetable templates. Start times and durations are kept as
+// whole seconds.
+type TimetableRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewTimetableRepository(db *sql.DB, logger *slog.Logger) *TimetableRepository {
+	return &TimetableRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// templateColumns are the columns of a template, in the order scanTemplate reads them.
+const templateColumns = `id, gym_id, weekday, start_seconds, duration_seconds, instructor_id, title, capacity,
+	valid_from, valid_until, created_at, updated_at`
+
+func scanTemplate(row rowScanner) (*timetable.Template, error) {
+	var t timetable.Template
+	var startOfDay, duration int64
+	var validUntil sql.NullTime
+	err := row.Scan(
+		&t.ID,
+		&t.GymID,
+		&t.Weekday,
+		&startOfDay,
+		&duration,
+		&t.InstructorID,
+		&t.Title,
+		&t.Capacity,
+		&t.ValidFrom,
+		&validUntil,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	t.StartOfDay = time.Duration(startOfDay) * time.Second
+	t.Duration = time.Duration(duration) * time.Second
+	t.ValidFrom = dateOnly(t.ValidFrom)
+	if validUntil.Valid {
+		t.ValidUntil = dateOnly(validUntil.Time)
+	}
+	return &t, nil
+}
+
+func (repo *TimetableRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "TimetableRepository", operation, query, timetable.ErrTemplateNotFound)
+}
+
+func (repo *TimetableRepository) Create(ctx context.Context, t *timetable.Template) (err error) {
+	query := `
+		INSERT INTO timetable_templates (` + templateColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	ctx, call := repo.startCall(ctx, "Create", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		t.ID,
+		t.GymID,
+		int(t.Weekday),
+		int64(t.StartOfDay/time.Second),
+		int64(t.Duration/time.Second),
+		t.InstructorID,
+		t.Title,
+		t.Capacity,
+		nullDate(t.ValidFrom),
+		nullDate(t.ValidUntil),
+		t.CreatedAt,
+		t.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *TimetableRepository) GetByID(ctx context.Context, id string) (_ *timetable.Template, err error) {
+	query := `
+		SELECT ` + templateColumns + `
+		FROM timetable_templates
+		WHERE id = $1
+	`
+	ctx, call := repo.startCall(ctx, "GetByID", query)
+	defer func() { call.end(err) }()
+
+	t, err := scanTemplate(repo.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, timetable.ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	call.rows = 1
+	return t, nil
+}
+
+func (repo *TimetableRepository) Update(ctx context.Context, t *timetable.Template) (err error) {
+	query := `
+		UPDATE timetable_templates
+		SET weekday = $1, start_seconds = $2, duration_seconds = $3, instructor_id = $4, title = $5,
+			capacity = $6, valid_from = $7, valid_until = $8, updated_at = $9
+		WHERE id = $10
+	`
+	ctx, call := repo.startCall(ctx, "Update", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		int(t.Weekday),
+		int64(t.StartOfDay/time.Second),
+		int64(t.Duration/time.Second),
+		t.InstructorID,
+		t.Title,
+		t.Capacity,
+		nullDate(t.ValidFrom),
+		nullDate(t.ValidUntil),
+		t.UpdatedAt,
+		t.ID,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *TimetableRepository) ListByGymID(ctx context.Context, gymID string) ([]*timetable.Template, error) {
+	query := `
+		SELECT ` + templateColumns + `
+		FROM timetable_templates
+		WHERE gym_id = $1
+		ORDER BY weekday ASC, start_seconds ASC, id ASC
+	`
+	return repo.list(ctx, "ListByGymID", query, gymID)
+}
+
+func (repo *TimetableRepository) List(ctx context.Context) ([]*timetable.Template, error) {
+	query := `
+		SELECT ` + templateColumns + `
+		FROM timetable_templates
+		ORDER BY gym_id ASC, weekday ASC, start_seconds ASC, id ASC
+	`
+	return repo.list(ctx, "List", query)
+}
+
+func (repo *TimetableRepository) list(ctx context.Context, operation, query string, args ...interface{}) (_ []*timetable.Template, err error) {
+	ctx, call := repo.startCall(ctx, operation, query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*timetable.Template
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	call.rows = int64(len(templates))
+	return templates, rows.Err()
+}
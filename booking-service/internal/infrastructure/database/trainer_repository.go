@@ -0,0 +1,223 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+)
+
+// TrainerRepository stores trainers, their weekly availability and their time off.
+// Availability windows are kept as whole seconds past midnight.
+type TrainerRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewTrainerRepository(db *sql.DB, logger *slog.Logger) *TrainerRepository {
+	return &TrainerRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (repo *TrainerRepository) startCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "TrainerRepository", operation, query, trainer.ErrTrainerNotFound)
+}
+
+func (repo *TrainerRepository) startTimeOffCall(ctx context.Context, operation, query string) (context.Context, *repositoryCall) {
+	return startCall(ctx, repo.logger, "TrainerRepository", operation, query, trainer.ErrTimeOffNotFound)
+}
+
+func (repo *TrainerRepository) Create(ctx context.Context, t *trainer.Trainer) (err error) {
+	query := `
+		INSERT INTO trainers (id, name, time_zone, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	ctx, call := repo.startCall(ctx, "Create", query)
+	defer func() { call.end(err) }()
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, t.ID, t.Name, t.TimeZone, t.CreatedAt, t.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	if err := insertAvailability(ctx, tx, call, t); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (repo *TrainerRepository) GetByID(ctx context.Context, id string) (_ *trainer.Trainer, err error) {
+	query := `
+		SELECT id, name, time_zone, created_at, updated_at
+		FROM trainers
+		WHERE id = $1
+	`
+	availabilityQuery := `
+		SELECT weekday, start_seconds, end_seconds
+		FROM trainer_availability
+		WHERE trainer_id = $1
+		ORDER BY weekday ASC, start_seconds ASC
+	`
+	ctx, call := repo.startCall(ctx, "GetByID", query)
+	defer func() { call.end(err) }()
+
+	var t trainer.Trainer
+	err = repo.db.QueryRowContext(ctx, query, id).Scan(&t.ID, &t.Name, &t.TimeZone, &t.CreatedAt, &t.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, trainer.ErrTrainerNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	call.rows = 1
+
+	rows, err := repo.db.QueryContext(ctx, availabilityQuery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var window trainer.Window
+		var start, end int64
+		if err := rows.Scan(&window.Weekday, &start, &end); err != nil {
+			return nil, err
+		}
+		window.Start = time.Duration(start) * time.Second
+		window.End = time.Duration(end) * time.Second
+		t.Availability = append(t.Availability, window)
+	}
+	call.rows += int64(len(t.Availability))
+	return &t, rows.Err()
+}
+
+// Update replaces the trainer's details and availability in one transaction.
+func (repo *TrainerRepository) Update(ctx context.Context, t *trainer.Trainer) (err error) {
+	query := `
+		UPDATE trainers
+		SET name = $1, time_zone = $2, updated_at = $3
+		WHERE id = $4
+	`
+	ctx, call := repo.startCall(ctx, "Update", query)
+	defer func() { call.end(err) }()
+
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, t.Name, t.TimeZone, t.UpdatedAt, t.ID)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	if _, err := tx.ExecContext(ctx, `DELETE FROM trainer_availability WHERE trainer_id = $1`, t.ID); err != nil {
+		return err
+	}
+	if err := insertAvailability(ctx, tx, call, t); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func insertAvailability(ctx context.Context, tx *sql.Tx, call *repositoryCall, t *trainer.Trainer) error {
+	query := `
+		INSERT INTO trainer_availability (trainer_id, weekday, start_seconds, end_seconds)
+		VALUES ($1, $2, $3, $4)
+	`
+	for _, window := range t.Availability {
+		result, err := tx.ExecContext(ctx, query,
+			t.ID,
+			int(window.Weekday),
+			int64(window.Start/time.Second),
+			int64(window.End/time.Second),
+		)
+		if err != nil {
+			return err
+		}
+		call.addRowsAffected(result)
+	}
+	return nil
+}
+
+func (repo *TrainerRepository) AddTimeOff(ctx context.Context, timeOff *trainer.TimeOff) (err error) {
+	query := `
+		INSERT INTO trainer_time_off (id, trainer_id, start_time, end_time, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	ctx, call := repo.startTimeOffCall(ctx, "AddTimeOff", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query,
+		timeOff.ID,
+		timeOff.TrainerID,
+		// The columns hold no zone, so times are stored in UTC, as ListTimeOff reads them.
+		timeOff.StartTime.UTC(),
+		timeOff.EndTime.UTC(),
+		timeOff.Reason,
+		timeOff.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	return nil
+}
+
+func (repo *TrainerRepository) DeleteTimeOff(ctx context.Context, trainerID, id string) (err error) {
+	query := `
+		DELETE FROM trainer_time_off
+		WHERE id = $1 AND trainer_id = $2
+	`
+	ctx, call := repo.startTimeOffCall(ctx, "DeleteTimeOff", query)
+	defer func() { call.end(err) }()
+
+	result, err := repo.db.ExecContext(ctx, query, id, trainerID)
+	if err != nil {
+		return err
+	}
+	call.addRowsAffected(result)
+	if call.rows == 0 {
+		return trainer.ErrTimeOffNotFound
+	}
+	return nil
+}
+
+func (repo *TrainerRepository) ListTimeOff(ctx context.Context, trainerID string, startTime, endTime time.Time) (_ []*trainer.TimeOff, err error) {
+	query := `
+		SELECT id, trainer_id, start_time, end_time, reason, created_at
+		FROM trainer_time_off
+		WHERE trainer_id = $1 AND start_time < $3 AND end_time > $2
+		ORDER BY start_time ASC
+	`
+	ctx, call := repo.startTimeOffCall(ctx, "ListTimeOff", query)
+	defer func() { call.end(err) }()
+
+	rows, err := repo.db.QueryContext(ctx, query, trainerID, startTime.UTC(), endTime.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var timeOff []*trainer.TimeOff
+	for rows.Next() {
+		var t trainer.TimeOff
+		if err := rows.Scan(&t.ID, &t.TrainerID, &t.StartTime, &t.EndTime, &t.Reason, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		timeOff = append(timeOff, &t)
+	}
+	call.rows = int64(len(timeOff))
+	return timeOff, rows.Err()
+}
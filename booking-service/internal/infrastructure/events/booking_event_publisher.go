@@ -1,30 +1,38 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
 )
 
 type BookingEventPublisher struct {
-	logger *log.Logger
+	logger *slog.Logger
 }
 
-func NewBookingEventPublisher(logger *log.Logger) *BookingEventPublisher {
+func NewBookingEventPublisher(logger *slog.Logger) *BookingEventPublisher {
 	return &BookingEventPublisher{
 		logger: logger,
 	}
 }
 
-func (publisher *BookingEventPublisher) Publish(event booking.Event) error {
-	data, err := json.Marshal(event)
+func (publisher *BookingEventPublisher) Publish(ctx context.Context, event booking.Event) error {
+	envelope, err := NewEnvelope(ctx, event)
 	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
+		return err
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event envelope: %w", err)
 	}
 
-	publisher.logger.Printf("Publishing event: %s, data: %s", event.EventName(), string(data))
+	publisher.logger.DebugContext(ctx, "publishing event",
+		slog.String("event", event.EventName()),
+		slog.Int("size", len(data)),
+	)
 
 	// TODO: Implement actual event publishing logic
 	// For now, we'll just log the event
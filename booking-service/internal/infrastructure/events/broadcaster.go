@@ -0,0 +1,85 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// Broadcaster forwards every event to the wrapped publisher and fans it out to in-process
+// subscribers such as streaming API clients. Subscribers that fall behind miss events
+// rather than blocking the command that published them.
+type Broadcaster struct {
+	next        booking.EventPublisher
+	mu          sync.RWMutex
+	subscribers map[int]chan booking.Event
+	nextID      int
+	closed      bool
+}
+
+func NewBroadcaster(next booking.EventPublisher) *Broadcaster {
+	return &Broadcaster{
+		next:        next,
+		subscribers: make(map[int]chan booking.Event),
+	}
+}
+
+func (broadcaster *Broadcaster) Publish(ctx context.Context, event booking.Event) error {
+	err := broadcaster.next.Publish(ctx, event)
+
+	broadcaster.mu.RLock()
+	defer broadcaster.mu.RUnlock()
+	for _, subscriber := range broadcaster.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+
+	return err
+}
+
+// Subscribe registers a new subscriber and returns its event channel together with a
+// function that unregisters it and closes the channel. After Close the channel is returned
+// already closed.
+func (broadcaster *Broadcaster) Subscribe(bufferSize int) (<-chan booking.Event, func()) {
+	broadcaster.mu.Lock()
+	defer broadcaster.mu.Unlock()
+
+	subscriber := make(chan booking.Event, bufferSize)
+	if broadcaster.closed {
+		close(subscriber)
+		return subscriber, func() {}
+	}
+	id := broadcaster.nextID
+	broadcaster.nextID++
+	broadcaster.subscribers[id] = subscriber
+
+	unsubscribe := func() {
+		broadcaster.mu.Lock()
+		defer broadcaster.mu.Unlock()
+		if _, subscribed := broadcaster.subscribers[id]; subscribed {
+			delete(broadcaster.subscribers, id)
+			close(subscriber)
+		}
+	}
+	return subscriber, unsubscribe
+}
+
+// Close unregisters every subscriber and closes their channels, which ends the streams
+// reading them. Events published afterwards still reach the wrapped publisher.
+func (broadcaster *Broadcaster) Close() {
+	broadcaster.mu.Lock()
+	defer broadcaster.mu.Unlock()
+
+	broadcaster.closed = true
+	for id, subscriber := range broadcaster.subscribers {
+		delete(broadcaster.subscribers, id)
+		close(subscriber)
+	}
+}
+
+func (broadcaster *Broadcaster) Ping(ctx context.Context) error {
+	return ping(ctx, broadcaster.next)
+}
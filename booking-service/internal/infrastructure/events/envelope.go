@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Envelope is the wire form of a published event. Headers carry the W3C trace context
+// (traceparent, tracestate) of the operation that produced the event.
+type Envelope struct {
+	Name       string            `json:"name"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Payload    json.RawMessage   `json:"payload"`
+}
+
+func NewEnvelope(ctx context.Context, event booking.Event) (*Envelope, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	headers := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, headers)
+
+	return &Envelope{
+		Name:       event.EventName(),
+		OccurredAt: event.OccurredAt(),
+		Headers:    headers,
+		Payload:    payload,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/yourusername/fitbook/booking-service/internal/infrastructure/events")
+
+// InstrumentedPublisher traces every publish and counts publish attempts and the status
+// transitions the events describe. Events are only published once the change is
+// persisted, so every event is a transition that happened, whether or not publishing it
+// succeeded.
+type InstrumentedPublisher struct {
+	next    booking.EventPublisher
+	metrics *metrics.Metrics
+}
+
+func NewInstrumentedPublisher(next booking.EventPublisher, metrics *metrics.Metrics) *InstrumentedPublisher {
+	return &InstrumentedPublisher{
+		next:    next,
+		metrics: metrics,
+	}
+}
+
+func (publisher *InstrumentedPublisher) Publish(ctx context.Context, event booking.Event) error {
+	ctx, span := tracer.Start(ctx, "EventPublisher.Publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.String("messaging.message.type", event.EventName())),
+	)
+	defer span.End()
+
+	if status, ok := eventStatus(event); ok {
+		publisher.metrics.RecordTransition(string(status))
+	}
+	err := publisher.next.Publish(ctx, event)
+	publisher.metrics.RecordPublish(event.EventName(), err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+func eventStatus(event booking.Event) (booking.BookingStatus, bool) {
+	switch typed := event.(type) {
+	case booking.BookingCreatedEvent:
+		return typed.Status, true
+	case booking.BookingCancelledEvent:
+		return typed.Status, true
+	case booking.BookingConfirmedEvent:
+		return typed.Status, true
+	case booking.BookingCompletedEvent:
+		return typed.Status, true
+	case booking.BookingNoShowEvent:
+		return typed.Status, true
+	case booking.BookingStatusChangedEvent:
+		return typed.Status, true
+	default:
+		return "", false
+	}
+}
+
+func (publisher *InstrumentedPublisher) Ping(ctx context.Context) error {
+	return ping(ctx, publisher.next)
+}
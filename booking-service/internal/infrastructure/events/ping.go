@@ -0,0 +1,21 @@
+package events
+
+import (
+	"context"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+// Pinger is implemented by publishers that can check their connection to the broker.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// ping checks publisher if it supports it. Publishers without a broker connection are
+// always reachable.
+func ping(ctx context.Context, publisher booking.EventPublisher) error {
+	if pinger, ok := publisher.(Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
@@ -1,21 +1,38 @@
 package events
 
 import (
-	"log"
+	"context"
+	"log/slog"
 
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
 )
 
 type EventPublisher struct {
 	// TODO: Add event bus or message queue integration
+	logger *slog.Logger
 }
 
-func NewEventPublisher() *EventPublisher {
-	return &EventPublisher{}
+func NewEventPublisher(logger *slog.Logger) *EventPublisher {
+	return &EventPublisher{
+		logger: logger,
+	}
 }
 
-func (publisher *EventPublisher) Publish(event booking.Event) error {
+func (publisher *EventPublisher) Publish(ctx context.Context, event booking.Event) error {
 	// TODO: Implement proper event publishing
-	log.Printf("Event published: %s", event.EventName())
+	envelope, err := NewEnvelope(ctx, event)
+	if err != nil {
+		return err
+	}
+	publisher.logger.InfoContext(ctx, "event published",
+		slog.String("event", envelope.Name),
+		slog.String("traceparent", envelope.Headers["traceparent"]),
+	)
 	return nil
 }
+
+// Ping reports whether events can be published. The publisher has no broker yet, so it is
+// always ready.
+func (publisher *EventPublisher) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
@@ -0,0 +1,81 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+)
+
+// CheckFunc reports whether a dependency is reachable. It must honour ctx cancellation.
+type CheckFunc func(ctx context.Context) error
+
+// Component is a dependency checked for readiness.
+type Component struct {
+	Name     string
+	Required bool
+	Check    CheckFunc
+}
+
+// Checker runs every component's check concurrently, each bounded by the same timeout.
+type Checker struct {
+	timeout    time.Duration
+	components []Component
+}
+
+func NewChecker(timeout time.Duration, components ...Component) *Checker {
+	return &Checker{
+		timeout:    timeout,
+		components: components,
+	}
+}
+
+// Check reports the status of every component. The overall status is down when a required
+// component is down, degraded when only optional ones are, and ok otherwise.
+func (checker *Checker) Check(ctx context.Context) *dtos.HealthDTO {
+	results := make([]*dtos.ComponentHealthDTO, len(checker.components))
+
+	var wg sync.WaitGroup
+	for i, component := range checker.components {
+		wg.Add(1)
+		go func(i int, component Component) {
+			defer wg.Done()
+			results[i] = checker.checkComponent(ctx, component)
+		}(i, component)
+	}
+	wg.Wait()
+
+	status := dtos.HealthStatusOK
+	for _, result := range results {
+		if result.Status == dtos.HealthStatusOK {
+			continue
+		}
+		if result.Required {
+			status = dtos.HealthStatusDown
+			break
+		}
+		status = dtos.HealthStatusDegraded
+	}
+
+	return &dtos.HealthDTO{Status: status, Components: results}
+}
+
+func (checker *Checker) checkComponent(ctx context.Context, component Component) *dtos.ComponentHealthDTO {
+	ctx, cancel := context.WithTimeout(ctx, checker.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := component.Check(ctx)
+	result := &dtos.ComponentHealthDTO{
+		Name:      component.Name,
+		Status:    dtos.HealthStatusOK,
+		Required:  component.Required,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = dtos.HealthStatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+package health
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// PingRedis returns a check that opens a connection to the server at redisURL
+// (redis://[[user]:password@]host[:port]) and sends PING, authenticating first when the URL
+// has a password.
+func PingRedis(redisURL string) (CheckFunc, error) {
+	parsed, err := url.Parse(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	if parsed.Scheme != "redis" || parsed.Hostname() == "" {
+		return nil, fmt.Errorf("invalid redis URL: expected redis://host:port")
+	}
+	address := parsed.Host
+	if parsed.Port() == "" {
+		address = net.JoinHostPort(parsed.Hostname(), "6379")
+	}
+
+	var authCommand []string
+	if password, hasPassword := parsed.User.Password(); hasPassword {
+		authCommand = []string{"AUTH", password}
+		if username := parsed.User.Username(); username != "" {
+			authCommand = []string{"AUTH", username, password}
+		}
+	}
+
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		if deadline, ok := ctx.Deadline(); ok {
+			conn.SetDeadline(deadline)
+		}
+
+		reader := bufio.NewReader(conn)
+		if authCommand != nil {
+			if _, err := roundTrip(conn, reader, authCommand...); err != nil {
+				return fmt.Errorf("redis AUTH failed: %w", err)
+			}
+		}
+		reply, err := roundTrip(conn, reader, "PING")
+		if err != nil {
+			return err
+		}
+		if reply != "PONG" {
+			return fmt.Errorf("unexpected redis reply %q", reply)
+		}
+		return nil
+	}, nil
+}
+
+// roundTrip sends a command in the RESP protocol and reads a simple-string reply.
+func roundTrip(conn net.Conn, reader *bufio.Reader, args ...string) (string, error) {
+	var command strings.Builder
+	fmt.Fprintf(&command, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&command, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := conn.Write([]byte(command.String())); err != nil {
+		return "", err
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	switch {
+	case strings.HasPrefix(line, "+"):
+		return line[1:], nil
+	case strings.HasPrefix(line, "-"):
+		return "", fmt.Errorf("redis error: %s", line[1:])
+	default:
+		return "", fmt.Errorf("unexpected redis reply %q", line)
+	}
+}
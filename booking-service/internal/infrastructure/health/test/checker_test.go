@@ -0,0 +1,180 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/health"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+)
+
+func up(context.Context) error { return nil }
+
+func down(context.Context) error { return errors.New("connection refused") }
+
+func hang(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestChecker(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []health.Component
+		expected   string
+	}{
+		{
+			name: "all up",
+			components: []health.Component{
+				{Name: "postgres", Required: true, Check: up},
+				{Name: "redis", Check: up},
+			},
+			expected: dtos.HealthStatusOK,
+		},
+		{
+			name: "optional component down",
+			components: []health.Component{
+				{Name: "postgres", Required: true, Check: up},
+				{Name: "redis", Check: down},
+			},
+			expected: dtos.HealthStatusDegraded,
+		},
+		{
+			name: "required component down",
+			components: []health.Component{
+				{Name: "postgres", Required: true, Check: down},
+				{Name: "redis", Check: up},
+			},
+			expected: dtos.HealthStatusDown,
+		},
+		{
+			name: "required component times out",
+			components: []health.Component{
+				{Name: "postgres", Required: true, Check: hang},
+			},
+			expected: dtos.HealthStatusDown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start := time.Now()
+			report := health.NewChecker(50*time.Millisecond, test.components...).Check(context.Background())
+
+			assert.Less(t, time.Since(start), time.Second)
+			assert.Equal(t, test.expected, report.Status)
+			require.Len(t, report.Components, len(test.components))
+			for i, component := range report.Components {
+				assert.Equal(t, test.components[i].Name, component.Name)
+				assert.Equal(t, test.components[i].Required, component.Required)
+			}
+		})
+	}
+}
+
+func TestReadyEndpoint(t *testing.T) {
+	checker := health.NewChecker(time.Second, health.Component{Name: "postgres", Required: true, Check: down})
+	handler := handlers.NewHealthHandler(checker)
+
+	recorder := httptest.NewRecorder()
+	handler.Ready(recorder, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	var report dtos.HealthDTO
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&report))
+	assert.Equal(t, dtos.HealthStatusDown, report.Status)
+	require.Len(t, report.Components, 1)
+	assert.Equal(t, "connection refused", report.Components[0].Error)
+
+	recorder = httptest.NewRecorder()
+	handler.Live(recorder, httptest.NewRequest(http.MethodGet, "/health/live", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+// fakeRedis answers PING with PONG and AUTH with OK when the password matches.
+func fakeRedis(t *testing.T, password string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				for {
+					args, err := readCommand(reader)
+					if err != nil {
+						return
+					}
+					switch strings.ToUpper(args[0]) {
+					case "AUTH":
+						if args[len(args)-1] == password {
+							conn.Write([]byte("+OK\r\n"))
+						} else {
+							conn.Write([]byte("-WRONGPASS invalid password\r\n"))
+						}
+					case "PING":
+						conn.Write([]byte("+PONG\r\n"))
+					}
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	var count int
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fmt.Sscanf(header, "*%d", &count); err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		if _, err := reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.TrimRight(arg, "\r\n"))
+	}
+	return args, nil
+}
+
+func TestPingRedis(t *testing.T) {
+	address := fakeRedis(t, "secret")
+
+	check, err := health.PingRedis("redis://:secret@" + address)
+	require.NoError(t, err)
+	assert.NoError(t, check(context.Background()))
+
+	check, err = health.PingRedis("redis://:wrong@" + address)
+	require.NoError(t, err)
+	assert.Error(t, check(context.Background()))
+
+	_, err = health.PingRedis("http://" + address)
+	assert.Error(t, err)
+}
@@ -0,0 +1,140 @@
+// Package lifecycle starts the service's long-lived components in order and stops them in
+// reverse when the process is asked to shut down or one of them fails.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Hook is a component with a lifetime. Start must return once the component is running;
+// components that keep working in the background report a fatal error with Manager.Fail.
+// Stop must release the component's resources before its context expires, and must also
+// work if Start was never called. Either may be nil.
+type Hook struct {
+	Name  string
+	Start func(ctx context.Context) error
+	Stop  func(ctx context.Context) error
+}
+
+// Manager runs hooks in the order they were appended, so a component should be appended
+// after the components it depends on.
+type Manager struct {
+	hooks           []Hook
+	failures        chan error
+	shutdownTimeout time.Duration
+	logger          *slog.Logger
+}
+
+// NewManager returns a Manager that gives all hooks together shutdownTimeout to stop.
+func NewManager(shutdownTimeout time.Duration, logger *slog.Logger) *Manager {
+	return &Manager{
+		failures:        make(chan error, 1),
+		shutdownTimeout: shutdownTimeout,
+		logger:          logger,
+	}
+}
+
+func (manager *Manager) Append(hook Hook) {
+	manager.hooks = append(manager.hooks, hook)
+}
+
+// Fail reports that a running component can no longer work, which makes Run shut down and
+// return err. Only the first failure is kept.
+func (manager *Manager) Fail(err error) {
+	select {
+	case manager.failures <- err:
+	default:
+	}
+}
+
+// Run starts every hook, waits until ctx is done or a component fails, then stops the
+// started hooks in reverse order under one shared deadline. It returns the start or
+// component failure, if any, joined with every error returned while stopping.
+func (manager *Manager) Run(ctx context.Context) error {
+	var err error
+	started := 0
+	for _, hook := range manager.hooks {
+		if hook.Start != nil {
+			manager.logger.Debug("starting component", slog.String("component", hook.Name))
+			if err = hook.Start(ctx); err != nil {
+				err = fmt.Errorf("failed to start %s: %w", hook.Name, err)
+				break
+			}
+		}
+		started++
+	}
+
+	if err == nil {
+		select {
+		case <-ctx.Done():
+			manager.logger.Info("shutting down")
+		case err = <-manager.failures:
+			manager.logger.Error("shutting down after a component failed", slog.Any("error", err))
+		}
+	}
+
+	return errors.Join(err, manager.stop(manager.hooks[:started]))
+}
+
+// Abort stops every appended hook in reverse order, for setup errors that happen before
+// Run, and returns err joined with any error returned while stopping.
+func (manager *Manager) Abort(err error) error {
+	return errors.Join(err, manager.stop(manager.hooks))
+}
+
+func (manager *Manager) stop(hooks []Hook) error {
+	ctx, cancel := context.WithTimeout(context.Background(), manager.shutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hook := hooks[i]
+		if hook.Stop == nil {
+			continue
+		}
+		manager.logger.Debug("stopping component", slog.String("component", hook.Name))
+		if err := hook.Stop(ctx); err != nil {
+			manager.logger.Error("failed to stop component", slog.String("component", hook.Name), slog.Any("error", err))
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", hook.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Worker returns a hook that runs run in its own goroutine, for relay loops and schedulers.
+// Stopping the hook cancels run's context and waits for it to return. If run returns an
+// error before that, the manager shuts down.
+func (manager *Manager) Worker(name string, run func(ctx context.Context) error) Hook {
+	var cancel context.CancelFunc
+	done := make(chan struct{})
+	return Hook{
+		Name: name,
+		Start: func(ctx context.Context) error {
+			var workerCtx context.Context
+			workerCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+			go func() {
+				defer close(done)
+				if err := run(workerCtx); err != nil && workerCtx.Err() == nil {
+					manager.Fail(fmt.Errorf("%s: %w", name, err))
+				}
+			}()
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			if cancel == nil {
+				return nil
+			}
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	}
+}
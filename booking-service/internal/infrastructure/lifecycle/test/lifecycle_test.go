@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/lifecycle"
+)
+
+var logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// recordingHook appends "start <name>" and "stop <name>" to calls.
+func recordingHook(name string, calls *[]string, startErr error) lifecycle.Hook {
+	return lifecycle.Hook{
+		Name: name,
+		Start: func(context.Context) error {
+			*calls = append(*calls, "start "+name)
+			return startErr
+		},
+		Stop: func(context.Context) error {
+			*calls = append(*calls, "stop "+name)
+			return nil
+		},
+	}
+}
+
+func TestRunStopsInReverseOrder(t *testing.T) {
+	var calls []string
+	manager := lifecycle.NewManager(time.Second, logger)
+	manager.Append(recordingHook("database", &calls, nil))
+	manager.Append(recordingHook("server", &calls, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, manager.Run(ctx))
+
+	assert.Equal(t, []string{"start database", "start server", "stop server", "stop database"}, calls)
+}
+
+func TestRunStopsStartedHooksWhenStartFails(t *testing.T) {
+	var calls []string
+	manager := lifecycle.NewManager(time.Second, logger)
+	manager.Append(recordingHook("database", &calls, nil))
+	manager.Append(recordingHook("server", &calls, errors.New("address in use")))
+	manager.Append(recordingHook("worker", &calls, nil))
+
+	err := manager.Run(context.Background())
+	assert.ErrorContains(t, err, "failed to start server: address in use")
+	assert.Equal(t, []string{"start database", "start server", "stop database"}, calls)
+}
+
+func TestRunShutsDownWhenAComponentFails(t *testing.T) {
+	manager := lifecycle.NewManager(time.Second, logger)
+	failure := errors.New("listener closed")
+	manager.Append(manager.Worker("relay", func(context.Context) error {
+		return failure
+	}))
+
+	assert.ErrorIs(t, manager.Run(context.Background()), failure)
+}
+
+func TestRunSharesTheShutdownDeadline(t *testing.T) {
+	manager := lifecycle.NewManager(50*time.Millisecond, logger)
+	var secondDeadline time.Time
+	manager.Append(lifecycle.Hook{
+		Name: "second",
+		Stop: func(ctx context.Context) error {
+			secondDeadline, _ = ctx.Deadline()
+			return nil
+		},
+	})
+	manager.Append(lifecycle.Hook{
+		Name: "slow",
+		Stop: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	err := manager.Run(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "failed to stop slow")
+	assert.WithinDuration(t, start.Add(50*time.Millisecond), secondDeadline, 25*time.Millisecond)
+}
+
+func TestWorkerStopsOnShutdown(t *testing.T) {
+	manager := lifecycle.NewManager(time.Second, logger)
+	running := make(chan struct{})
+	stopped := false
+	manager.Append(manager.Worker("scheduler", func(ctx context.Context) error {
+		close(running)
+		<-ctx.Done()
+		stopped = true
+		return ctx.Err()
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-running
+		cancel()
+	}()
+	require.NoError(t, manager.Run(ctx))
+	assert.True(t, stopped)
+}
+
+func TestAbortStopsEveryHook(t *testing.T) {
+	var calls []string
+	manager := lifecycle.NewManager(time.Second, logger)
+	manager.Append(recordingHook("tracing", &calls, nil))
+	manager.Append(recordingHook("database", &calls, nil))
+
+	setupErr := errors.New("bad config")
+	assert.ErrorIs(t, manager.Abort(setupErr), setupErr)
+	assert.Equal(t, []string{"stop database", "stop tracing"}, calls)
+}
@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// New builds the service logger. level is one of debug, info, warn or error and format is
+// json or text. Attributes added to a request's context with AddAttrs, and the trace and
+// span IDs of its active span, are appended to every record logged with that context.
+func New(output io.Writer, level, format string) (*slog.Logger, error) {
+	var slogLevel slog.Level
+	if err := slogLevel.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: %w", level, err)
+	}
+
+	options := &slog.HandlerOptions{Level: slogLevel}
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case "json":
+		handler = slog.NewJSONHandler(output, options)
+	case "text":
+		handler = slog.NewTextHandler(output, options)
+	default:
+		return nil, fmt.Errorf("invalid log format %q: must be json or text", format)
+	}
+
+	return slog.New(&contextHandler{Handler: handler}), nil
+}
+
+// scope collects the attributes of one request. It is mutable so that middleware running
+// inside the logging middleware, such as authentication, can contribute to the request log.
+type scope struct {
+	mu    sync.Mutex
+	attrs []slog.Attr
+}
+
+type scopeKey struct{}
+
+// WithScope returns a context that request-scoped attributes can be added to.
+func WithScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, &scope{})
+}
+
+// AddAttrs attaches attrs to the request scope of ctx. It does nothing outside a scope.
+func AddAttrs(ctx context.Context, attrs ...slog.Attr) {
+	requestScope, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return
+	}
+	requestScope.mu.Lock()
+	defer requestScope.mu.Unlock()
+	requestScope.attrs = append(requestScope.attrs, attrs...)
+}
+
+func scopeAttrs(ctx context.Context) []slog.Attr {
+	requestScope, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return nil
+	}
+	requestScope.mu.Lock()
+	defer requestScope.mu.Unlock()
+	return append([]slog.Attr(nil), requestScope.attrs...)
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (handler *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx != nil {
+		record.AddAttrs(scopeAttrs(ctx)...)
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+			record.AddAttrs(
+				slog.String("trace_id", spanContext.TraceID().String()),
+				slog.String("span_id", spanContext.SpanID().String()),
+			)
+		}
+	}
+	return handler.Handler.Handle(ctx, record)
+}
+
+func (handler *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: handler.Handler.WithAttrs(attrs)}
+}
+
+func (handler *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: handler.Handler.WithGroup(name)}
+}
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/logging"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("rejects unknown level", func(t *testing.T) {
+		_, err := logging.New(&bytes.Buffer{}, "verbose", "json")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects unknown format", func(t *testing.T) {
+		_, err := logging.New(&bytes.Buffer{}, "info", "xml")
+		assert.Error(t, err)
+	})
+
+	t.Run("filters below level", func(t *testing.T) {
+		var output bytes.Buffer
+		logger, err := logging.New(&output, "warn", "text")
+		require.NoError(t, err)
+
+		logger.Info("hidden")
+		logger.Warn("shown")
+
+		assert.NotContains(t, output.String(), "hidden")
+		assert.Contains(t, output.String(), "shown")
+	})
+}
+
+func TestScopeAttributes(t *testing.T) {
+	var output bytes.Buffer
+	logger, err := logging.New(&output, "info", "json")
+	require.NoError(t, err)
+
+	ctx := logging.WithScope(context.Background())
+	logging.AddAttrs(ctx, slog.String("request_id", "req-1"))
+	logging.AddAttrs(ctx, slog.String("user_id", "user-1"))
+	logger.InfoContext(ctx, "http request")
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(output.Bytes(), &record))
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.Equal(t, "user-1", record["user_id"])
+
+	output.Reset()
+	logging.AddAttrs(context.Background(), slog.String("ignored", "x"))
+	logger.Info("no scope")
+	assert.NotContains(t, output.String(), "request_id")
+}
@@ -0,0 +1,84 @@
+package membershipclient
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker is a circuit breaker. It opens after threshold consecutive failures and then
+// refuses calls for cooldown, after which it lets one trial call through: its success
+// closes the circuit and its failure opens it again.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	open      bool
+	openedAt  time.Time
+	probing   bool
+	now       func() time.Time
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow reports whether a call may be made. Every allowed call must be followed by
+// success, failure or release.
+func (breaker *breaker) allow() bool {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	if !breaker.open {
+		return true
+	}
+	if breaker.probing || breaker.now().Sub(breaker.openedAt) < breaker.cooldown {
+		return false
+	}
+	breaker.probing = true
+	return true
+}
+
+// success records a successful call and reports whether it closed the circuit.
+func (breaker *breaker) success() bool {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	closed := breaker.open
+	breaker.failures = 0
+	breaker.open = false
+	breaker.probing = false
+	return closed
+}
+
+// failure records a failed call and reports whether it opened the circuit.
+func (breaker *breaker) failure() bool {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	if breaker.probing {
+		breaker.probing = false
+		breaker.openedAt = breaker.now()
+		return false
+	}
+	breaker.failures++
+	if breaker.open || breaker.failures < breaker.threshold {
+		return false
+	}
+	breaker.open = true
+	breaker.openedAt = breaker.now()
+	return true
+}
+
+// release records a call that ended without telling whether the service works, such as
+// one its caller cancelled.
+func (breaker *breaker) release() {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	breaker.probing = false
+}
@@ -0,0 +1,72 @@
+package membershipclient
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxCacheEntries bounds the cache; when it is full, expired entries are dropped and, if
+// that is not enough, the whole cache.
+const maxCacheEntries = 10000
+
+type cacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// cache remembers the membership service's answers for ttl.
+type cache[V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry[V]
+	now     func() time.Time
+}
+
+func newCache[V any](ttl time.Duration) *cache[V] {
+	return &cache[V]{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry[V]),
+		now:     time.Now,
+	}
+}
+
+func cacheKey(userID, gymID string, at time.Time) string {
+	return userID + "\x00" + gymID + "\x00" + strconv.FormatInt(at.Unix(), 10)
+}
+
+func (cache *cache[V]) get(key string) (V, bool) {
+	var zero V
+	if cache.ttl <= 0 {
+		return zero, false
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	entry, found := cache.entries[key]
+	if !found || !cache.now().Before(entry.expires) {
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (cache *cache[V]) put(key string, value V) {
+	if cache.ttl <= 0 {
+		return
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	now := cache.now()
+	if len(cache.entries) >= maxCacheEntries {
+		for existing, entry := range cache.entries {
+			if !now.Before(entry.expires) {
+				delete(cache.entries, existing)
+			}
+		}
+		if len(cache.entries) >= maxCacheEntries {
+			cache.entries = make(map[string]cacheEntry[V])
+		}
+	}
+	cache.entries[key] = cacheEntry[V]{value: value, expires: now.Add(cache.ttl)}
+}
@@ -0,0 +1,186 @@
+// Package membershipclient connects the booking service to the membership service over HTTP.
+package membershipclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+)
+
+// maxResponseBytes bounds the membership service's answers, which are tiny.
+const maxResponseBytes = 64 << 10
+
+// HTTPOptions configures HTTPChecker.
+type HTTPOptions struct {
+	// BaseURL is the membership service's address, e.g. http://membership:8080.
+	BaseURL string
+	// Token is sent as a bearer token when set.
+	Token string
+	// Timeout bounds each request to the membership service.
+	Timeout time.Duration
+	// CacheTTL is how long an answer is reused for the same user, gym and time. Zero
+	// disables caching.
+	CacheTTL time.Duration
+	// FailureThreshold consecutive failures open the circuit: calls then fail at once
+	// for Cooldown, after which a single trial call decides whether it closes again.
+	FailureThreshold int
+	Cooldown         time.Duration
+	// Client makes the requests. Nil means http.DefaultClient.
+	Client *http.Client
+}
+
+// HTTPChecker implements membership.Checker with the membership service's entitlement
+// endpoint, GET /v1/entitlements?user_id=&gym_id=&at=, which answers {"entitled": bool}, and
+// membership.TierSource with its tier endpoint, GET /v1/tiers?user_id=, which answers
+// {"tier": string}. Failures are reported as membership.ErrUnavailable.
+type HTTPChecker struct {
+	base    *url.URL
+	token   string
+	timeout time.Duration
+	client  *http.Client
+	cache   *cache[bool]
+	tiers   *cache[string]
+	breaker *breaker
+	logger  *slog.Logger
+}
+
+func NewHTTPChecker(options HTTPOptions, logger *slog.Logger) (*HTTPChecker, error) {
+	base, err := url.Parse(options.BaseURL)
+	if err != nil || base.Host == "" || (base.Scheme != "http" && base.Scheme != "https") {
+		return nil, fmt.Errorf("invalid membership service url %q", options.BaseURL)
+	}
+	if options.Timeout <= 0 || options.FailureThreshold <= 0 || options.Cooldown <= 0 {
+		return nil, errors.New("membership client needs a positive timeout, failure threshold and cooldown")
+	}
+	client := options.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &HTTPChecker{
+		base:    base,
+		token:   options.Token,
+		timeout: options.Timeout,
+		client:  client,
+		cache:   newCache[bool](options.CacheTTL),
+		tiers:   newCache[string](options.CacheTTL),
+		breaker: newBreaker(options.FailureThreshold, options.Cooldown),
+		logger:  logger,
+	}, nil
+}
+
+func (checker *HTTPChecker) HasAccess(ctx context.Context, userID, gymID string, at time.Time) (bool, error) {
+	key := cacheKey(userID, gymID, at)
+	if entitled, found := checker.cache.get(key); found {
+		return entitled, nil
+	}
+
+	var body struct {
+		Entitled *bool `json:"entitled"`
+	}
+	query := url.Values{
+		"user_id": {userID},
+		"gym_id":  {gymID},
+		"at":      {at.UTC().Format(time.RFC3339)},
+	}
+	err := checker.call(ctx, "entitlements", query, &body, func() error {
+		if body.Entitled == nil {
+			return errors.New("invalid entitlements response: entitled is missing")
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	checker.cache.put(key, *body.Entitled)
+	return *body.Entitled, nil
+}
+
+func (checker *HTTPChecker) Tier(ctx context.Context, userID string) (string, error) {
+	if tier, found := checker.tiers.get(userID); found {
+		return tier, nil
+	}
+
+	var body struct {
+		Tier *string `json:"tier"`
+	}
+	err := checker.call(ctx, "tiers", url.Values{"user_id": {userID}}, &body, func() error {
+		if body.Tier == nil {
+			return errors.New("invalid tiers response: tier is missing")
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	checker.tiers.put(userID, *body.Tier)
+	return *body.Tier, nil
+}
+
+// call requests GET /v1/<resource>?<query> through the circuit breaker and decodes the
+// answer into body, which validate then checks.
+func (checker *HTTPChecker) call(ctx context.Context, resource string, query url.Values, body any, validate func() error) error {
+	if !checker.breaker.allow() {
+		return fmt.Errorf("%w: circuit open", membership.ErrUnavailable)
+	}
+
+	err := checker.fetch(ctx, resource, query, body)
+	if err == nil {
+		err = validate()
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			// The caller gave up, which says nothing about the membership service.
+			checker.breaker.release()
+			return ctx.Err()
+		}
+		if checker.breaker.failure() {
+			checker.logger.WarnContext(ctx, "membership service circuit opened", slog.Any("error", err))
+		}
+		return fmt.Errorf("%w: %v", membership.ErrUnavailable, err)
+	}
+
+	if checker.breaker.success() {
+		checker.logger.InfoContext(ctx, "membership service circuit closed")
+	}
+	return nil
+}
+
+func (checker *HTTPChecker) fetch(ctx context.Context, resource string, query url.Values, body any) error {
+	ctx, cancel := context.WithTimeout(ctx, checker.timeout)
+	defer cancel()
+
+	endpoint := checker.base.JoinPath("v1", resource).String() + "?" + query.Encode()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if checker.token != "" {
+		request.Header.Set("Authorization", "Bearer "+checker.token)
+	}
+
+	response, err := checker.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s request returned %s", resource, response.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(response.Body, maxResponseBytes)).Decode(body); err != nil {
+		return fmt.Errorf("invalid %s response: %v", resource, err)
+	}
+	return nil
+}
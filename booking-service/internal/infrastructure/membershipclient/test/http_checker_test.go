@@ -0,0 +1,185 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/membershipclient"
+)
+
+// membershipService answers entitlement requests, granting user1 access to gym1 only, and
+// tier requests, putting user1 on premium, or fails them while failing is set.
+type membershipService struct {
+	*httptest.Server
+	calls   atomic.Int32
+	failing atomic.Bool
+	delay   atomic.Int64
+}
+
+func newMembershipService(t *testing.T) *membershipService {
+	service := &membershipService{}
+	service.Server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		service.calls.Add(1)
+		time.Sleep(time.Duration(service.delay.Load()))
+		if service.failing.Load() {
+			writer.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		assert.Equal(t, "Bearer service-token", request.Header.Get("Authorization"))
+		if request.URL.Path == "/v1/tiers" {
+			tier := ""
+			if request.URL.Query().Get("user_id") == "user1" {
+				tier = "premium"
+			}
+			json.NewEncoder(writer).Encode(map[string]string{"tier": tier})
+			return
+		}
+
+		assert.Equal(t, "/v1/entitlements", request.URL.Path)
+		_, err := time.Parse(time.RFC3339, request.URL.Query().Get("at"))
+		assert.NoError(t, err)
+
+		query := request.URL.Query()
+		entitled := query.Get("user_id") == "user1" && query.Get("gym_id") == "gym1"
+		json.NewEncoder(writer).Encode(map[string]bool{"entitled": entitled})
+	}))
+	t.Cleanup(service.Close)
+	return service
+}
+
+func newChecker(t *testing.T, baseURL string, cacheTTL time.Duration) *membershipclient.HTTPChecker {
+	checker, err := membershipclient.NewHTTPChecker(membershipclient.HTTPOptions{
+		BaseURL:          baseURL,
+		Token:            "service-token",
+		Timeout:          100 * time.Millisecond,
+		CacheTTL:         cacheTTL,
+		FailureThreshold: 2,
+		Cooldown:         50 * time.Millisecond,
+	}, mocks.NewLogger())
+	require.NoError(t, err)
+	return checker
+}
+
+func TestHTTPCheckerAnswersAndCaches(t *testing.T) {
+	service := newMembershipService(t)
+	checker := newChecker(t, service.URL, time.Minute)
+	ctx := context.Background()
+	at := time.Now().Add(time.Hour)
+
+	hasAccess, err := checker.HasAccess(ctx, "user1", "gym1", at)
+	require.NoError(t, err)
+	assert.True(t, hasAccess)
+
+	hasAccess, err = checker.HasAccess(ctx, "user1", "gym2", at)
+	require.NoError(t, err)
+	assert.False(t, hasAccess)
+
+	for i := 0; i < 3; i++ {
+		_, err = checker.HasAccess(ctx, "user1", "gym1", at)
+		require.NoError(t, err)
+		_, err = checker.HasAccess(ctx, "user1", "gym2", at)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), service.calls.Load(), "answers are cached, refusals included")
+
+	uncached := newChecker(t, service.URL, 0)
+	_, err = uncached.HasAccess(ctx, "user1", "gym1", at)
+	require.NoError(t, err)
+	_, err = uncached.HasAccess(ctx, "user1", "gym1", at)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), service.calls.Load())
+}
+
+func TestHTTPCheckerTier(t *testing.T) {
+	service := newMembershipService(t)
+	checker := newChecker(t, service.URL, time.Minute)
+	ctx := context.Background()
+
+	tier, err := checker.Tier(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "premium", tier)
+
+	tier, err = checker.Tier(ctx, "user2")
+	require.NoError(t, err)
+	assert.Empty(t, tier, "user2 has no membership")
+
+	_, err = checker.Tier(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), service.calls.Load(), "tiers are cached")
+
+	service.failing.Store(true)
+	_, err = checker.Tier(ctx, "user3")
+	assert.ErrorIs(t, err, membership.ErrUnavailable)
+}
+
+func TestHTTPCheckerCircuitBreaker(t *testing.T) {
+	service := newMembershipService(t)
+	checker := newChecker(t, service.URL, 0)
+	ctx := context.Background()
+	at := time.Now().Add(time.Hour)
+
+	service.failing.Store(true)
+	for i := 0; i < 2; i++ {
+		_, err := checker.HasAccess(ctx, "user1", "gym1", at)
+		assert.ErrorIs(t, err, membership.ErrUnavailable)
+	}
+	require.Equal(t, int32(2), service.calls.Load())
+
+	_, err := checker.HasAccess(ctx, "user1", "gym1", at)
+	assert.ErrorIs(t, err, membership.ErrUnavailable)
+	assert.Equal(t, int32(2), service.calls.Load(), "an open circuit fails without calling the service")
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = checker.HasAccess(ctx, "user1", "gym1", at)
+	assert.ErrorIs(t, err, membership.ErrUnavailable)
+	assert.Equal(t, int32(3), service.calls.Load(), "a failed trial call opens the circuit again")
+
+	_, err = checker.HasAccess(ctx, "user1", "gym1", at)
+	assert.ErrorIs(t, err, membership.ErrUnavailable)
+	assert.Equal(t, int32(3), service.calls.Load())
+
+	service.failing.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	hasAccess, err := checker.HasAccess(ctx, "user1", "gym1", at)
+	require.NoError(t, err)
+	assert.True(t, hasAccess, "a successful trial call closes the circuit")
+
+	_, err = checker.HasAccess(ctx, "user1", "gym2", at)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), service.calls.Load())
+}
+
+func TestHTTPCheckerTimeout(t *testing.T) {
+	service := newMembershipService(t)
+	service.delay.Store(int64(300 * time.Millisecond))
+	checker := newChecker(t, service.URL, 0)
+
+	start := time.Now()
+	_, err := checker.HasAccess(context.Background(), "user1", "gym1", time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, membership.ErrUnavailable)
+	assert.Less(t, time.Since(start), 250*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = checker.HasAccess(ctx, "user1", "gym1", time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, context.Canceled, "the caller giving up is not the service's failure")
+	assert.NotErrorIs(t, err, membership.ErrUnavailable)
+}
+
+func TestNewHTTPCheckerRejectsInvalidOptions(t *testing.T) {
+	_, err := membershipclient.NewHTTPChecker(membershipclient.HTTPOptions{BaseURL: "membership:8080", Timeout: time.Second, FailureThreshold: 1, Cooldown: time.Second}, mocks.NewLogger())
+	assert.Error(t, err)
+
+	_, err = membershipclient.NewHTTPChecker(membershipclient.HTTPOptions{BaseURL: "http://membership:8080"}, mocks.NewLogger())
+	assert.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "booking"
+
+// Metrics owns the service's Prometheus registry. A nil *Metrics is valid and records
+// nothing, so components can be constructed without metrics in tests.
+type Metrics struct {
+	registry        *prometheus.Registry
+	httpDuration    *prometheus.HistogramVec
+	grpcDuration    *prometheus.HistogramVec
+	transitions     *prometheus.CounterVec
+	errors          *prometheus.CounterVec
+	eventsPublished *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	metrics := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of HTTP requests by method, route pattern and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Duration of gRPC calls by full method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "status_transitions_total",
+			Help:      "Bookings that entered each status, counted from published domain events.",
+		}, []string{"status"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Error responses by API error code and transport.",
+		}, []string{"code", "transport"}),
+		eventsPublished: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "events_published_total",
+			Help:      "Domain event publish attempts by event name and result.",
+		}, []string{"event", "result"}),
+	}
+
+	metrics.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.httpDuration,
+		metrics.grpcDuration,
+		metrics.transitions,
+		metrics.errors,
+		metrics.eventsPublished,
+	)
+	return metrics
+}
+
+// Handler serves the registry in the Prometheus text exposition format.
+func (metrics *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{})
+}
+
+// RegisterDB exports the connection pool statistics of db.
+func (metrics *Metrics) RegisterDB(db *sql.DB, name string) {
+	if metrics == nil {
+		return
+	}
+	metrics.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+func (metrics *Metrics) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	if metrics == nil {
+		return
+	}
+	metrics.httpDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func (metrics *Metrics) ObserveGRPCRequest(method, code string, duration time.Duration) {
+	if metrics == nil {
+		return
+	}
+	metrics.grpcDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+}
+
+// RecordError counts an error response carrying the API error code, e.g. OVERLAPPING_BOOKING.
+func (metrics *Metrics) RecordError(code, transport string) {
+	if metrics == nil {
+		return
+	}
+	metrics.errors.WithLabelValues(code, transport).Inc()
+}
+
+func (metrics *Metrics) RecordTransition(status string) {
+	if metrics == nil {
+		return
+	}
+	metrics.transitions.WithLabelValues(status).Inc()
+}
+
+func (metrics *Metrics) RecordPublish(event string, err error) {
+	if metrics == nil {
+		return
+	}
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	metrics.eventsPublished.WithLabelValues(event, result).Inc()
+}
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/events"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/metrics"
+)
+
+type failingPublisher struct{}
+
+func (failingPublisher) Publish(context.Context, booking.Event) error {
+	return errors.New("broker unavailable")
+}
+
+func scrape(t *testing.T, serviceMetrics *metrics.Metrics) string {
+	recorder := httptest.NewRecorder()
+	serviceMetrics.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, 200, recorder.Code)
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestInstrumentedPublisher(t *testing.T) {
+	serviceMetrics := metrics.New()
+	record := &booking.Booking{ID: "booking-1", Status: booking.StatusConfirmed}
+
+	succeeding := events.NewInstrumentedPublisher(mocks.NewMockEventPublisher(), serviceMetrics)
+	require.NoError(t, succeeding.Publish(context.Background(), booking.NewBookingEvent(record, "confirmed")))
+
+	failing := events.NewInstrumentedPublisher(failingPublisher{}, serviceMetrics)
+	assert.Error(t, failing.Publish(context.Background(), booking.NewBookingEvent(record, "confirmed")))
+
+	body := scrape(t, serviceMetrics)
+	assert.Contains(t, body, `booking_status_transitions_total{status="CONFIRMED"} 2`)
+	assert.Contains(t, body, `booking_events_published_total{event="booking.confirmed",result="success"} 1`)
+	assert.Contains(t, body, `booking_events_published_total{event="booking.confirmed",result="failure"} 1`)
+}
+
+func TestRequestMetrics(t *testing.T) {
+	serviceMetrics := metrics.New()
+	serviceMetrics.ObserveHTTPRequest("GET", "/bookings/{id}", 404, 20*time.Millisecond)
+	serviceMetrics.RecordError("BOOKING_NOT_FOUND", "http")
+
+	body := scrape(t, serviceMetrics)
+	assert.Contains(t, body, `booking_http_request_duration_seconds_count{method="GET",route="/bookings/{id}",status="404"} 1`)
+	assert.Contains(t, body, `booking_errors_total{code="BOOKING_NOT_FOUND",transport="http"} 1`)
+}
+
+func TestNilMetricsRecordNothing(t *testing.T) {
+	var serviceMetrics *metrics.Metrics
+	assert.NotPanics(t, func() {
+		serviceMetrics.ObserveHTTPRequest("GET", "/health", 200, time.Millisecond)
+		serviceMetrics.RecordTransition("PENDING")
+		serviceMetrics.RecordPublish("booking.created", nil)
+		serviceMetrics.RecordError("INTERNAL_ERROR", "grpc")
+	})
+}
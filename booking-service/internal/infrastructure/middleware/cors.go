@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	defaultCORSMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+	defaultCORSHeaders = []string{"Authorization", "Content-Type", "X-API-Key", "X-Request-ID", "traceparent"}
+	defaultCORSExposed = []string{
+		"X-Request-ID", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Retry-After",
+	}
+)
+
+// CORSOptions configures cross-origin access. An empty AllowedOrigins disables CORS, and
+// "*" allows any origin. Empty method and header lists default to what the API uses.
+type CORSOptions struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// CORS answers preflight requests itself, since the router's patterns include methods and
+// would reject OPTIONS, and adds the CORS headers to responses for allowed origins.
+// Requests from other origins pass through without CORS headers, so browsers block them.
+func CORS(options CORSOptions) Middleware {
+	if len(options.AllowedMethods) == 0 {
+		options.AllowedMethods = defaultCORSMethods
+	}
+	if len(options.AllowedHeaders) == 0 {
+		options.AllowedHeaders = defaultCORSHeaders
+	}
+	if len(options.ExposedHeaders) == 0 {
+		options.ExposedHeaders = defaultCORSExposed
+	}
+	allowAny := slices.Contains(options.AllowedOrigins, "*")
+	methods := strings.Join(options.AllowedMethods, ", ")
+	headers := strings.Join(options.AllowedHeaders, ", ")
+	exposed := strings.Join(options.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(options.MaxAge.Seconds()))
+
+	return func(next http.Handler) http.Handler {
+		if len(options.AllowedOrigins) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			origin := request.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			header := writer.Header()
+			header.Add("Vary", "Origin")
+			if !allowAny && !slices.Contains(options.AllowedOrigins, origin) {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			if allowAny && !options.AllowCredentials {
+				header.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				header.Set("Access-Control-Allow-Origin", origin)
+			}
+			if options.AllowCredentials {
+				header.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			if request.Method == http.MethodOptions && request.Header.Get("Access-Control-Request-Method") != "" {
+				header.Add("Vary", "Access-Control-Request-Method")
+				header.Add("Vary", "Access-Control-Request-Headers")
+				header.Set("Access-Control-Allow-Methods", methods)
+				header.Set("Access-Control-Allow-Headers", headers)
+				if options.MaxAge > 0 {
+					header.Set("Access-Control-Max-Age", maxAge)
+				}
+				writer.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			if exposed != "" {
+				header.Set("Access-Control-Expose-Headers", exposed)
+			}
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// minCompressSize is the smallest response worth compressing; gzip's framing outweighs the
+// savings below it.
+const minCompressSize = 1024
+
+var gzipWriters = sync.Pool{
+	New: func() any { return gzip.NewWriter(nil) },
+}
+
+// Gzip compresses responses of at least minCompressSize bytes for clients that accept gzip.
+// Responses that already set a Content-Encoding, such as the metrics endpoint's, are left
+// alone.
+func Gzip() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.Header().Add("Vary", "Accept-Encoding")
+			if !acceptsGzip(request.Header.Get("Accept-Encoding")) {
+				next.ServeHTTP(writer, request)
+				return
+			}
+
+			compressor := &gzipWriter{ResponseWriter: writer, status: http.StatusOK}
+			defer compressor.close()
+			next.ServeHTTP(compressor, request)
+		})
+	}
+}
+
+func acceptsGzip(acceptEncoding string) bool {
+	for _, coding := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(coding), ";")
+		if strings.EqualFold(strings.TrimSpace(name), "gzip") {
+			return strings.ReplaceAll(params, " ", "") != "q=0"
+		}
+	}
+	return false
+}
+
+// gzipWriter buffers the start of the response until it knows whether it is large enough to
+// compress, then commits the status and headers.
+type gzipWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	committed   bool
+	buffer      []byte
+	compressor  *gzip.Writer
+}
+
+func (writer *gzipWriter) WriteHeader(status int) {
+	if writer.wroteHeader {
+		return
+	}
+	writer.wroteHeader = true
+	writer.status = status
+	if !bodyAllowed(status) {
+		writer.commit(false)
+	}
+}
+
+func (writer *gzipWriter) Write(data []byte) (int, error) {
+	writer.wroteHeader = true
+	if !writer.committed {
+		writer.buffer = append(writer.buffer, data...)
+		if len(writer.buffer) < minCompressSize {
+			return len(data), nil
+		}
+		if err := writer.commit(true); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	}
+	if writer.compressor != nil {
+		return writer.compressor.Write(data)
+	}
+	return writer.ResponseWriter.Write(data)
+}
+
+// commit sends the status and headers, compressing if compress is set and the response
+// allows it, followed by whatever has been buffered.
+func (writer *gzipWriter) commit(compress bool) error {
+	writer.committed = true
+	header := writer.Header()
+	if compress && bodyAllowed(writer.status) && header.Get("Content-Encoding") == "" {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		writer.compressor = gzipWriters.Get().(*gzip.Writer)
+		writer.compressor.Reset(writer.ResponseWriter)
+	}
+	writer.ResponseWriter.WriteHeader(writer.status)
+
+	buffered := writer.buffer
+	writer.buffer = nil
+	if len(buffered) == 0 {
+		return nil
+	}
+	if writer.compressor != nil {
+		_, err := writer.compressor.Write(buffered)
+		return err
+	}
+	_, err := writer.ResponseWriter.Write(buffered)
+	return err
+}
+
+func (writer *gzipWriter) close() {
+	if !writer.committed {
+		if !writer.wroteHeader {
+			return
+		}
+		writer.commit(false)
+	}
+	if writer.compressor != nil {
+		writer.compressor.Close()
+		gzipWriters.Put(writer.compressor)
+		writer.compressor = nil
+	}
+}
+
+// Flush sends what has been written so far, compressing it if it is large enough.
+func (writer *gzipWriter) Flush() {
+	if !writer.committed {
+		writer.wroteHeader = true
+		writer.commit(len(writer.buffer) >= minCompressSize)
+	}
+	if writer.compressor != nil {
+		writer.compressor.Flush()
+	}
+	if flusher, ok := writer.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (writer *gzipWriter) Unwrap() http.ResponseWriter {
+	return writer.ResponseWriter
+}
+
+func bodyAllowed(status int) bool {
+	return status >= 200 && status != http.StatusNoContent && status != http.StatusNotModified
+}
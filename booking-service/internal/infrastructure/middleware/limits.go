@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// MaxBodySize fails reads of request bodies larger than limit bytes with
+// *http.MaxBytesError.
+func MaxBodySize(limit int64) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			request.Body = http.MaxBytesReader(writer, request.Body, limit)
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// Timeout gives the request context a deadline, which the command handlers and the
+// database pass on. It is a no-op for a zero timeout.
+func Timeout(timeout time.Duration) Middleware {
+	return func(next http.Handler) http.Handler {
+		if timeout <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			ctx, cancel := context.WithTimeout(request.Context(), timeout)
+			defer cancel()
+			next.ServeHTTP(writer, request.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Package middleware provides the HTTP middleware shared by every route: panic recovery,
+// request IDs, body size limits, CORS, response compression and timeouts.
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+)
+
+// Middleware wraps a handler with extra behaviour.
+type Middleware func(next http.Handler) http.Handler
+
+// Chain wraps handler with middlewares so that the first one runs first.
+func Chain(handler http.Handler, middlewares ...Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+func writeError(writer http.ResponseWriter, status int, code, message string) {
+	if recorder, ok := writer.(handlers.ErrorCodeRecorder); ok {
+		recorder.RecordErrorCode(code)
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(dtos.Response{
+		Success: false,
+		Error:   dtos.NewErrorDTO(code, message),
+	})
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+)
+
+// Recover turns a panic in next into a 500 INTERNAL_ERROR response, if nothing has been
+// written yet, and logs it with its stack. http.ErrAbortHandler is re-panicked so that the
+// server still aborts the response as requested.
+func Recover(logger *slog.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			tracker := &writeTracker{ResponseWriter: writer}
+			defer func() {
+				recovered := recover()
+				if recovered == nil {
+					return
+				}
+				if recovered == http.ErrAbortHandler {
+					panic(recovered)
+				}
+				logger.ErrorContext(request.Context(), "panic while serving request",
+					slog.Any("panic", recovered),
+					slog.String("stack", string(debug.Stack())),
+				)
+				if !tracker.wroteHeader {
+					writeError(tracker, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error")
+				}
+			}()
+			next.ServeHTTP(tracker, request)
+		})
+	}
+}
+
+// writeTracker records whether the response has started.
+type writeTracker struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (tracker *writeTracker) WriteHeader(status int) {
+	tracker.wroteHeader = true
+	tracker.ResponseWriter.WriteHeader(status)
+}
+
+func (tracker *writeTracker) Write(data []byte) (int, error) {
+	tracker.wroteHeader = true
+	return tracker.ResponseWriter.Write(data)
+}
+
+func (tracker *writeTracker) RecordErrorCode(code string) {
+	if recorder, ok := tracker.ResponseWriter.(handlers.ErrorCodeRecorder); ok {
+		recorder.RecordErrorCode(code)
+	}
+}
+
+func (tracker *writeTracker) Unwrap() http.ResponseWriter {
+	return tracker.ResponseWriter
+}
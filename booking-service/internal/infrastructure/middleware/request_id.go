@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds caller-supplied request IDs, which end up in every log record.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// RequestID attaches the caller's X-Request-ID, or a new UUID if it has none or an unusable
+// one, to the request context and echoes it in the response.
+func RequestID() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			requestID := request.Header.Get(RequestIDHeader)
+			if !validRequestID(requestID) {
+				requestID = uuid.New().String()
+			}
+			writer.Header().Set(RequestIDHeader, requestID)
+			ctx := context.WithValue(request.Context(), requestIDKey{}, requestID)
+			next.ServeHTTP(writer, request.WithContext(ctx))
+		})
+	}
+}
+
+// RequestIDFromContext returns the ID attached by RequestID, or "" outside a request.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// validRequestID accepts printable ASCII only, so that IDs cannot forge log lines.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
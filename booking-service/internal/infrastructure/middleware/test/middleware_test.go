@@ -0,0 +1,218 @@
+package test
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/middleware"
+)
+
+var logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+	record := func(name string) middleware.Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+	handler := middleware.Chain(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		calls = append(calls, "handler")
+	}), record("first"), record("second"))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, []string{"first", "second", "handler"}, calls)
+}
+
+func TestRecover(t *testing.T) {
+	handler := middleware.Recover(logger)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+	var body dtos.Response
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+	assert.Equal(t, "INTERNAL_ERROR", body.Error.Code)
+}
+
+func TestRecoverKeepsStartedResponse(t *testing.T) {
+	handler := middleware.Recover(logger)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		panic("boom")
+	}))
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusAccepted, response.Code)
+	assert.Empty(t, response.Body.String())
+}
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	handler := middleware.RequestID()(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		seen = middleware.RequestIDFromContext(r.Context())
+	}))
+
+	t.Run("propagates the caller's ID", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("X-Request-ID", "abc-123")
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, "abc-123", seen)
+		assert.Equal(t, "abc-123", response.Header().Get("X-Request-ID"))
+	})
+
+	t.Run("replaces missing and unsafe IDs", func(t *testing.T) {
+		for _, requestID := range []string{"", "bad\nid", strings.Repeat("x", 200)} {
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set("X-Request-ID", requestID)
+			response := httptest.NewRecorder()
+			handler.ServeHTTP(response, request)
+
+			assert.Len(t, seen, 36)
+			assert.Equal(t, seen, response.Header().Get("X-Request-ID"))
+		}
+	})
+}
+
+func TestMaxBodySize(t *testing.T) {
+	var readErr error
+	handler := middleware.MaxBodySize(8)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		_, readErr = io.ReadAll(r.Body)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader("12345678")))
+	assert.NoError(t, readErr)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader("123456789")))
+	var tooLarge *http.MaxBytesError
+	assert.ErrorAs(t, readErr, &tooLarge)
+}
+
+func TestTimeout(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		deadline, hasDeadline = r.Context().Deadline()
+	})
+
+	middleware.Timeout(time.Second)(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+
+	middleware.Timeout(0)(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.False(t, hasDeadline)
+}
+
+func TestCORS(t *testing.T) {
+	called := false
+	handler := middleware.CORS(middleware.CORSOptions{
+		AllowedOrigins: []string{"https://app.example.com"},
+		MaxAge:         10 * time.Minute,
+	})(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		called = true
+	}))
+
+	t.Run("answers preflight requests", func(t *testing.T) {
+		called = false
+		request := httptest.NewRequest(http.MethodOptions, "/bookings", nil)
+		request.Header.Set("Origin", "https://app.example.com")
+		request.Header.Set("Access-Control-Request-Method", "POST")
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusNoContent, response.Code)
+		assert.Equal(t, "https://app.example.com", response.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, response.Header().Get("Access-Control-Allow-Methods"), "POST")
+		assert.Contains(t, response.Header().Get("Access-Control-Allow-Headers"), "Authorization")
+		assert.Equal(t, "600", response.Header().Get("Access-Control-Max-Age"))
+	})
+
+	t.Run("decorates allowed origins", func(t *testing.T) {
+		called = false
+		request := httptest.NewRequest(http.MethodGet, "/bookings", nil)
+		request.Header.Set("Origin", "https://app.example.com")
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.True(t, called)
+		assert.Equal(t, "https://app.example.com", response.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, response.Header().Get("Access-Control-Expose-Headers"), "X-Request-ID")
+	})
+
+	t.Run("ignores other origins", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/bookings", nil)
+		request.Header.Set("Origin", "https://evil.example.com")
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Empty(t, response.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
+
+func TestGzip(t *testing.T) {
+	large := strings.Repeat(`{"id":"booking"}`, 200)
+	handler := middleware.Gzip()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, r.URL.Query().Get("prefix"))
+		if r.URL.Query().Get("size") == "large" {
+			io.WriteString(w, large)
+		}
+	}))
+
+	t.Run("compresses large responses", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/?size=large", nil)
+		request.Header.Set("Accept-Encoding", "br, gzip")
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.Equal(t, "gzip", response.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", response.Header().Get("Vary"))
+		reader, err := gzip.NewReader(response.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, large, string(body))
+	})
+
+	t.Run("leaves small responses alone", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/?prefix=ok", nil)
+		request.Header.Set("Accept-Encoding", "gzip")
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.Empty(t, response.Header().Get("Content-Encoding"))
+		assert.Equal(t, "ok", response.Body.String())
+	})
+
+	t.Run("respects clients without gzip", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/?size=large", nil)
+		request.Header.Set("Accept-Encoding", "gzip;q=0")
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		assert.Empty(t, response.Header().Get("Content-Encoding"))
+		assert.Equal(t, large, response.Body.String())
+	})
+}
@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// Limit is a token bucket: clients may make Burst requests at once and regain Rate
+// requests per second after that.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute returns a limit of requests per minute with the given burst.
+func PerMinute(requests, burst int) Limit {
+	return Limit{Rate: float64(requests) / 60, Burst: burst}
+}
+
+// Decision is the outcome of taking a token from a bucket.
+type Decision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long a rejected client must wait for the next token.
+	RetryAfter time.Duration
+	// ResetAfter is how long until the bucket is full again.
+	ResetAfter time.Duration
+}
+
+// Store keeps token buckets. Take removes one token from the bucket at key, creating a full
+// bucket if none exists.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Decision, error)
+}
+
+// decide builds the decision for a bucket left with tokens after a take.
+func decide(allowed bool, tokens float64, limit Limit) Decision {
+	decision := Decision{
+		Allowed:    allowed,
+		Limit:      limit.Burst,
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: secondsToDuration((float64(limit.Burst) - tokens) / limit.Rate),
+	}
+	if !allowed {
+		decision.RetryAfter = secondsToDuration((1 - tokens) / limit.Rate)
+	}
+	return decision
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
+
+// FallbackStore uses primary and, whenever it fails, fallback. A Redis outage therefore
+// degrades limits to per-instance ones instead of failing or opening every request.
+type FallbackStore struct {
+	primary  Store
+	fallback Store
+	logger   *slog.Logger
+}
+
+func NewFallbackStore(primary, fallback Store, logger *slog.Logger) *FallbackStore {
+	return &FallbackStore{
+		primary:  primary,
+		fallback: fallback,
+		logger:   logger,
+	}
+}
+
+func (store *FallbackStore) Take(ctx context.Context, key string, limit Limit) (Decision, error) {
+	decision, err := store.primary.Take(ctx, key, limit)
+	if err == nil {
+		return decision, nil
+	}
+	store.logger.WarnContext(ctx, "rate limit store unavailable, using in-memory limits", slog.Any("error", err))
+	return store.fallback.Take(ctx, key, limit)
+}
+
+// Limiter applies separate read and write limits to each client.
+type Limiter struct {
+	store  Store
+	read   Limit
+	write  Limit
+	logger *slog.Logger
+}
+
+func NewLimiter(store Store, read, write Limit, logger *slog.Logger) *Limiter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Limiter{
+		store:  store,
+		read:   read,
+		write:  write,
+		logger: logger,
+	}
+}
+
+// Allow takes a token from the client's read or write bucket. If the store fails the request
+// is allowed, so that rate limiting can never take the service down.
+func (limiter *Limiter) Allow(ctx context.Context, client string, write bool) Decision {
+	limit, class := limiter.read, "read"
+	if write {
+		limit, class = limiter.write, "write"
+	}
+	decision, err := limiter.store.Take(ctx, class+":"+client, limit)
+	if err != nil {
+		limiter.logger.ErrorContext(ctx, "rate limit check failed, allowing request", slog.Any("error", err))
+		return Decision{Allowed: true, Limit: limit.Burst, Remaining: limit.Burst}
+	}
+	return decision
+}
@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps buckets in process memory. Buckets that have refilled completely are
+// dropped by the periodic sweep, since a missing bucket is equivalent to a full one.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time
+}
+
+const sweepInterval = time.Minute
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// NewMemoryStoreWithClock returns a MemoryStore that reads the time from now, for tests.
+func NewMemoryStoreWithClock(now func() time.Time) *MemoryStore {
+	store := NewMemoryStore()
+	store.now = now
+	return store
+}
+
+func (store *MemoryStore) Take(ctx context.Context, key string, limit Limit) (Decision, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := store.now()
+	store.sweep(now)
+
+	current, exists := store.buckets[key]
+	if !exists {
+		current = &bucket{tokens: float64(limit.Burst), updated: now}
+		store.buckets[key] = current
+	}
+	current.tokens = min(float64(limit.Burst), current.tokens+now.Sub(current.updated).Seconds()*limit.Rate)
+	current.updated = now
+
+	allowed := current.tokens >= 1
+	if allowed {
+		current.tokens--
+	}
+	decision := decide(allowed, current.tokens, limit)
+	current.full = now.Add(decision.ResetAfter)
+	return decision, nil
+}
+
+func (store *MemoryStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < sweepInterval {
+		return
+	}
+	store.lastSweep = now
+	for key, current := range store.buckets {
+		if !now.Before(current.full) {
+			delete(store.buckets, key)
+		}
+	}
+}
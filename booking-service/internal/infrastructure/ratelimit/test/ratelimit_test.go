@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/ratelimit"
+)
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	store := ratelimit.NewMemoryStoreWithClock(func() time.Time { return now })
+	limit := ratelimit.PerMinute(60, 2)
+	ctx := context.Background()
+
+	first, err := store.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.True(t, first.Allowed)
+	assert.Equal(t, 2, first.Limit)
+	assert.Equal(t, 1, first.Remaining)
+	assert.Equal(t, time.Second, first.ResetAfter)
+
+	second, _ := store.Take(ctx, "client", limit)
+	assert.True(t, second.Allowed)
+	assert.Equal(t, 0, second.Remaining)
+
+	rejected, _ := store.Take(ctx, "client", limit)
+	assert.False(t, rejected.Allowed)
+	assert.Equal(t, time.Second, rejected.RetryAfter)
+
+	other, _ := store.Take(ctx, "other", limit)
+	assert.True(t, other.Allowed, "buckets are per key")
+
+	now = now.Add(1500 * time.Millisecond)
+	refilled, _ := store.Take(ctx, "client", limit)
+	assert.True(t, refilled.Allowed)
+	assert.Equal(t, 0, refilled.Remaining)
+}
+
+func TestRedisStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	store := ratelimit.NewRedisStore(client, "test:")
+	limit := ratelimit.PerMinute(1, 2)
+	ctx := context.Background()
+
+	for remaining := 1; remaining >= 0; remaining-- {
+		decision, err := store.Take(ctx, "client", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, remaining, decision.Remaining)
+	}
+
+	rejected, err := store.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.False(t, rejected.Allowed)
+	assert.Greater(t, rejected.RetryAfter, 50*time.Second)
+	assert.True(t, server.Exists("test:client"))
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, ratelimit.Limit) (ratelimit.Decision, error) {
+	return ratelimit.Decision{}, errors.New("connection refused")
+}
+
+func TestFallbackStore(t *testing.T) {
+	store := ratelimit.NewFallbackStore(failingStore{}, ratelimit.NewMemoryStore(), mocks.NewLogger())
+	limit := ratelimit.PerMinute(60, 1)
+
+	first, err := store.Take(context.Background(), "client", limit)
+	require.NoError(t, err)
+	assert.True(t, first.Allowed)
+
+	second, err := store.Take(context.Background(), "client", limit)
+	require.NoError(t, err)
+	assert.False(t, second.Allowed, "the fallback store still enforces the limit")
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := ratelimit.NewLimiter(
+		ratelimit.NewMemoryStore(),
+		ratelimit.PerMinute(60, 2),
+		ratelimit.PerMinute(60, 1),
+		mocks.NewLogger(),
+	)
+	ctx := context.Background()
+
+	assert.True(t, limiter.Allow(ctx, "user:1", true).Allowed)
+	assert.False(t, limiter.Allow(ctx, "user:1", true).Allowed)
+	assert.True(t, limiter.Allow(ctx, "user:1", false).Allowed, "reads have their own bucket")
+	assert.True(t, limiter.Allow(ctx, "user:2", true).Allowed, "clients have their own buckets")
+
+	failing := ratelimit.NewLimiter(failingStore{}, ratelimit.PerMinute(60, 1), ratelimit.PerMinute(60, 1), mocks.NewLogger())
+	assert.True(t, failing.Allow(ctx, "user:1", true).Allowed, "store errors do not reject requests")
+}
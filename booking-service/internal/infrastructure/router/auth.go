@@ -0,0 +1,55 @@
+package router
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/logging"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+)
+
+// Authenticator verifies a bearer token and returns the caller it identifies.
+type Authenticator interface {
+	Authenticate(token string) (*auth.Principal, error)
+}
+
+// withAuthentication requires a valid bearer token and attaches its principal to the
+// request context. It is a no-op when the router has no authenticator.
+func (router *Router) withAuthentication(next http.HandlerFunc) http.HandlerFunc {
+	if router.authenticator == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, req *http.Request) {
+		token, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !found || strings.TrimSpace(token) == "" {
+			writeUnauthenticated(w, "Missing bearer token")
+			return
+		}
+
+		principal, err := router.authenticator.Authenticate(strings.TrimSpace(token))
+		if err != nil {
+			writeUnauthenticated(w, "Invalid bearer token")
+			return
+		}
+
+		logging.AddAttrs(req.Context(), slog.String("user_id", principal.UserID))
+		next(w, req.WithContext(auth.WithPrincipal(req.Context(), principal)))
+	}
+}
+
+func writeUnauthenticated(w http.ResponseWriter, message string) {
+	if recorder, ok := w.(handlers.ErrorCodeRecorder); ok {
+		recorder.RecordErrorCode("UNAUTHENTICATED")
+	}
+	w.Header().Set("WWW-Authenticate", `Bearer realm="booking-service"`)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(dtos.Response{
+		Success: false,
+		Error:   dtos.NewErrorDTO("UNAUTHENTICATED", message),
+	})
+}
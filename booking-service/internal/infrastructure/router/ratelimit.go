@@ -0,0 +1,94 @@
+package router
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/ratelimit"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+)
+
+// RateLimiter limits every endpoint but the public ones per client, before authentication.
+// Clients are identified by their X-API-Key header, else by their IP address.
+type RateLimiter struct {
+	limiter           *ratelimit.Limiter
+	trustProxyHeaders bool
+}
+
+// NewRateLimiter returns a RateLimiter backed by limiter. When trustProxyHeaders is set the
+// client IP is taken from X-Forwarded-For rather than from the connection.
+func NewRateLimiter(limiter *ratelimit.Limiter, trustProxyHeaders bool) *RateLimiter {
+	return &RateLimiter{
+		limiter:           limiter,
+		trustProxyHeaders: trustProxyHeaders,
+	}
+}
+
+// withRateLimit takes a token for the client before calling next and reports the client's
+// bucket in X-RateLimit-* headers. It is a no-op when the router has no rate limiter.
+func (router *Router) withRateLimit(next http.HandlerFunc) http.HandlerFunc {
+	if router.rateLimiter == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, req *http.Request) {
+		write := req.Method != http.MethodGet && req.Method != http.MethodHead
+		decision := router.rateLimiter.limiter.Allow(req.Context(), router.rateLimiter.clientKey(req), write)
+
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(decision.Limit))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		w.Header().Set("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.ResetAfter)))
+		if !decision.Allowed {
+			writeRateLimited(w, decision.RetryAfter)
+			return
+		}
+		next(w, req)
+	}
+}
+
+func (rateLimiter *RateLimiter) clientKey(req *http.Request) string {
+	if apiKey := req.Header.Get("X-API-Key"); apiKey != "" {
+		// Keys are hashed so that they never reach the store in clear text.
+		sum := sha256.Sum256([]byte(apiKey))
+		return "key:" + hex.EncodeToString(sum[:16])
+	}
+	return "ip:" + rateLimiter.clientIP(req)
+}
+
+func (rateLimiter *RateLimiter) clientIP(req *http.Request) string {
+	if rateLimiter.trustProxyHeaders {
+		forwarded, _, _ := strings.Cut(req.Header.Get("X-Forwarded-For"), ",")
+		if ip := strings.TrimSpace(forwarded); ip != "" {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+func writeRateLimited(w http.ResponseWriter, retryAfter time.Duration) {
+	if recorder, ok := w.(handlers.ErrorCodeRecorder); ok {
+		recorder.RecordErrorCode("RATE_LIMITED")
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(max(1, ceilSeconds(retryAfter))))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(dtos.Response{
+		Success: false,
+		Error:   dtos.NewErrorDTO("RATE_LIMITED", "Too many requests, retry later"),
+	})
+}
+
+func ceilSeconds(duration time.Duration) int {
+	return int(math.Ceil(duration.Seconds()))
+}
@@ -1,62 +1,326 @@
 package router
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/logging"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/metrics"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/middleware"
 	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/yourusername/fitbook/booking-service/internal/infrastructure/router")
+
 type Response struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
 	Error   string      `json:"error,omitempty"`
 }
 
+// DefaultMaxBodyBytes is the request body limit used when Options.MaxBodyBytes is zero.
+const DefaultMaxBodyBytes = 1 << 20
+
+// Handlers are the HTTP handlers the router routes to.
+type Handlers struct {
+	Booking   *handlers.BookingHandler
+	Policy    *handlers.GymPolicyHandler
+	User      *handlers.UserHandler
+	Session   *handlers.ClassSessionHandler
+	Timetable *handlers.TimetableHandler
+	Trainer   *handlers.TrainerHandler
+	Resource  *handlers.ResourceHandler
+	Closure   *handlers.ClosureHandler
+	Health    *handlers.HealthHandler
+	OpenAPI   *handlers.OpenAPIHandler
+}
+
+// Options configures the middleware the router wraps around its routes.
+type Options struct {
+	// Authenticator authenticates requests to every route but the public ones. Nil leaves
+	// them unauthenticated.
+	Authenticator Authenticator
+	// RateLimiter limits requests to every route but the public ones. Nil disables rate
+	// limiting.
+	RateLimiter *RateLimiter
+	// Logger logs every request. Nil means slog.Default().
+	Logger *slog.Logger
+	// Metrics records every request and serves GET /metrics. Nil disables both.
+	Metrics *metrics.Metrics
+	// MaxBodyBytes limits request bodies. Zero means DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+	// RequestTimeout is the deadline of routes without an entry in RouteTimeouts. Zero
+	// means no deadline.
+	RequestTimeout time.Duration
+	// RouteTimeouts overrides RequestTimeout by route pattern, e.g. "POST /bookings:batch".
+	RouteTimeouts map[string]time.Duration
+	CORS          middleware.CORSOptions
+	// Compression gzips responses for clients that accept it.
+	Compression bool
+	// Middlewares run on every request after the router's own and before routing, such as
+	// OpenAPI request validation.
+	Middlewares []middleware.Middleware
+}
+
 type Router struct {
-	mux            *http.ServeMux
-	bookingHandler *handlers.BookingHandler
-	healthHandler  *handlers.HealthHandler
+	mux           *http.ServeMux
+	handler       http.Handler
+	options       Options
+	routes        []string
+	handlers      Handlers
+	authenticator Authenticator
+	logger        *slog.Logger
+	metrics       *metrics.Metrics
+	rateLimiter   *RateLimiter
 }
 
-func NewRouter(
-	bookingHandler *handlers.BookingHandler,
-	healthHandler *handlers.HealthHandler,
-) *Router {
+func NewRouter(handlers Handlers, options Options) *Router {
+	if options.Logger == nil {
+		options.Logger = slog.Default()
+	}
+	if options.MaxBodyBytes == 0 {
+		options.MaxBodyBytes = DefaultMaxBodyBytes
+	}
 	router := &Router{
-		mux:            http.NewServeMux(),
-		bookingHandler: bookingHandler,
-		healthHandler:  healthHandler,
+		mux:           http.NewServeMux(),
+		options:       options,
+		handlers:      handlers,
+		authenticator: options.Authenticator,
+		logger:        options.Logger,
+		metrics:       options.Metrics,
+		rateLimiter:   options.RateLimiter,
 	}
 	router.setupRoutes()
+	router.handler = router.withGlobalMiddleware(router.mux)
 	return router
 }
 
 func (router *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	router.mux.ServeHTTP(w, req)
+	router.handler.ServeHTTP(w, req)
+}
+
+// withGlobalMiddleware wraps the middleware that applies to every request, routed or not,
+// around next. It is the only place panics are recovered; routes see them pass through
+// their instrumentation first, so that they are counted and logged with the route.
+func (router *Router) withGlobalMiddleware(next http.Handler) http.Handler {
+	global := []middleware.Middleware{
+		middleware.RequestID(),
+		middleware.Recover(router.logger),
+		middleware.CORS(router.options.CORS),
+	}
+	if router.options.Compression {
+		global = append(global, middleware.Gzip())
+	}
+	global = append(global, middleware.MaxBodySize(router.options.MaxBodyBytes))
+	global = append(global, router.options.Middlewares...)
+	return middleware.Chain(next, global...)
+}
+
+func (router *Router) timeout(pattern string) time.Duration {
+	if timeout, ok := router.options.RouteTimeouts[pattern]; ok {
+		return timeout
+	}
+	return router.options.RequestTimeout
+}
+
+// Routes returns the method and path pattern of every registered endpoint, e.g. "GET /bookings/{id}".
+func (router *Router) Routes() []string {
+	return append([]string(nil), router.routes...)
 }
 
 func (router *Router) setupRoutes() {
 	router.mux.Handle("/v1/", http.StripPrefix("/v1", router.mux))
 
-	// Health check endpoint
-	router.mux.HandleFunc("GET /health", router.withLogging(router.healthHandler.Check))
+	// Health check endpoints. /health is kept as an alias of /health/live.
+	router.handlePublic("GET /health", router.handlers.Health.Live)
+	router.handlePublic("GET /health/live", router.handlers.Health.Live)
+	router.handlePublic("GET /health/ready", router.handlers.Health.Ready)
+
+	// API description
+	router.handlePublic("GET /openapi.json", router.handlers.OpenAPI.Spec)
+
+	// Prometheus metrics
+	if router.metrics != nil {
+		router.handlePublic("GET /metrics", router.metrics.Handler().ServeHTTP)
+	}
 
 	// Booking endpoints
-	router.mux.HandleFunc("POST /bookings", router.withLogging(router.bookingHandler.CreateBooking))
-	router.mux.HandleFunc("GET /bookings", router.withLogging(router.bookingHandler.ListBookings))
-	router.mux.HandleFunc("GET /bookings/{id}", router.withLogging(router.bookingHandler.GetBooking))
-	router.mux.HandleFunc("DELETE /bookings/{id}", router.withLogging(router.bookingHandler.CancelBooking))
-	router.mux.HandleFunc("PATCH /bookings/{id}/confirm", router.withLogging(router.bookingHandler.ConfirmBooking))
-	router.mux.HandleFunc("PATCH /bookings/{id}/complete", router.withLogging(router.bookingHandler.CompleteBooking))
+	router.handle("POST /bookings", router.handlers.Booking.CreateBooking)
+	router.handle("POST /bookings:batch", router.handlers.Booking.CreateBookingsBatch)
+	router.handle("GET /bookings", router.handlers.Booking.ListBookings)
+	router.handle("GET /bookings/{id}", router.handlers.Booking.GetBooking)
+	router.handle("DELETE /bookings/{id}", router.handlers.Booking.CancelBooking)
+	router.handle("PATCH /bookings/{id}/confirm", router.handlers.Booking.ConfirmBooking)
+	router.handle("PATCH /bookings/{id}/complete", router.handlers.Booking.CompleteBooking)
+	router.handle("PATCH /bookings/{id}/no-show", router.handlers.Booking.MarkNoShow)
+	router.handle("GET /bookings/{id}/history", router.handlers.Booking.GetBookingHistory)
+	router.handle("PUT /bookings/{id}/status", router.handlers.Booking.ForceBookingStatus)
+	router.handle("PATCH /bookings/{id}/reschedule", router.handlers.Booking.RescheduleBooking)
+	router.handle("POST /bookings:preview", router.handlers.Policy.PreviewBookingPolicy)
+
+	// Gym booking policies
+	router.handle("GET /gyms/{id}/policy", router.handlers.Policy.GetGymPolicy)
+	router.handle("PUT /gyms/{id}/policy", router.handlers.Policy.SetGymPolicy)
+
+	// Class sessions
+	router.handle("POST /sessions", router.handlers.Session.CreateSession)
+	router.handle("GET /sessions", router.handlers.Session.ListSessions)
+	router.handle("GET /sessions/{id}", router.handlers.Session.GetSession)
+	router.handle("PUT /sessions/{id}", router.handlers.Session.UpdateSession)
+	router.handle("DELETE /sessions/{id}", router.handlers.Session.CancelSession)
+	router.handle("POST /sessions/{id}/enrollments", router.handlers.Session.Enroll)
+
+	// Timetables
+	router.handle("POST /gyms/{id}/timetable", router.handlers.Timetable.CreateTemplate)
+	router.handle("GET /gyms/{id}/timetable", router.handlers.Timetable.ListTemplates)
+	router.handle("GET /timetable/{id}", router.handlers.Timetable.GetTemplate)
+	router.handle("PUT /timetable/{id}", router.handlers.Timetable.UpdateTemplate)
+	router.handle("DELETE /timetable/{id}", router.handlers.Timetable.EndTemplate)
+
+	// Personal trainers
+	router.handle("POST /trainers", router.handlers.Trainer.CreateTrainer)
+	router.handle("GET /trainers/{id}", router.handlers.Trainer.GetTrainer)
+	router.handle("PUT /trainers/{id}", router.handlers.Trainer.UpdateTrainer)
+	router.handle("POST /trainers/{id}/time-off", router.handlers.Trainer.AddTimeOff)
+	router.handle("DELETE /trainers/{id}/time-off/{timeOffId}", router.handlers.Trainer.DeleteTimeOff)
+	router.handle("GET /trainers/{id}/availability", router.handlers.Trainer.GetAvailability)
+
+	// Resources inside gyms
+	router.handle("POST /gyms/{id}/resources", router.handlers.Resource.CreateResource)
+	router.handle("GET /gyms/{id}/resources", router.handlers.Resource.ListResources)
+	router.handle("GET /gyms/{id}/resources/availability", router.handlers.Resource.GetAvailability)
+	router.handle("GET /resources/{id}", router.handlers.Resource.GetResource)
+	router.handle("PUT /resources/{id}", router.handlers.Resource.UpdateResource)
+	router.handle("POST /resources/{id}/out-of-service", router.handlers.Resource.TakeOutOfService)
+	router.handle("POST /resources/{id}/in-service", router.handlers.Resource.ReturnToService)
+
+	// Gym closures
+	router.handle("POST /gyms/{id}/closures", router.handlers.Closure.CreateClosure)
+	router.handle("POST /gyms/{id}/closures:import", router.handlers.Closure.ImportClosures)
+	router.handle("GET /gyms/{id}/closures", router.handlers.Closure.ListClosures)
+	router.handle("DELETE /closures/{id}", router.handlers.Closure.DeleteClosure)
+
+	// Members
+	router.handle("GET /users/{id}/quota", router.handlers.User.GetUserQuota)
+	router.handle("GET /users/{id}/strikes", router.handlers.User.GetUserStrikes)
+	router.handle("DELETE /users/{id}/strikes", router.handlers.User.ClearUserStrikes)
+}
+
+// handle registers an endpoint that is rate limited and requires authentication. Limiting
+// comes first so that requests with bad tokens count against their client too.
+func (router *Router) handle(pattern string, handler http.HandlerFunc) {
+	router.handlePublic(pattern, router.withRateLimit(router.withAuthentication(handler)))
 }
 
-func (router *Router) withLogging(next http.HandlerFunc) http.HandlerFunc {
+func (router *Router) handlePublic(pattern string, handler http.HandlerFunc) {
+	router.routes = append(router.routes, pattern)
+	routed := middleware.Chain(handler, middleware.Timeout(router.timeout(pattern)))
+	router.mux.HandleFunc(pattern, router.withInstrumentation(pattern, routed.ServeHTTP))
+}
+
+// withInstrumentation traces and times every request, records it in the request duration
+// histogram and writes one log record for it. The span continues the caller's W3C
+// traceparent, if any, and the response carries the span's own traceparent. It also opens
+// the request's logging scope, so that everything logged while serving it carries the
+// request ID set by middleware.RequestID and, once authenticated, the user ID.
+func (router *Router) withInstrumentation(pattern string, next http.HandlerFunc) http.HandlerFunc {
+	_, route, _ := strings.Cut(pattern, " ")
 	return func(w http.ResponseWriter, req *http.Request) {
 		start := time.Now()
-		next(w, req)
-		duration := time.Since(start)
-		// TODO: Replace with proper logging
-		println(req.Method, req.URL.Path, duration)
+
+		requestID := middleware.RequestIDFromContext(req.Context())
+
+		propagator := otel.GetTextMapPropagator()
+		ctx := propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+		ctx, span := tracer.Start(ctx, pattern,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", req.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", req.URL.Path),
+			),
+		)
+		defer span.End()
+		propagator.Inject(ctx, propagation.HeaderCarrier(w.Header()))
+
+		ctx = logging.WithScope(ctx)
+		logging.AddAttrs(ctx, slog.String("request_id", requestID))
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			recovered := recover()
+			if recovered != nil && recovered != http.ErrAbortHandler && !recorder.wroteHeader {
+				// middleware.Recover answers it with INTERNAL_ERROR further out.
+				recorder.status = http.StatusInternalServerError
+				recorder.errorCode = "INTERNAL_ERROR"
+			}
+			router.observe(ctx, span, req.Method, route, recorder, time.Since(start))
+			if recovered != nil {
+				panic(recovered)
+			}
+		}()
+		next(recorder, req.WithContext(ctx))
+	}
+}
+
+// observe records a finished request in its span, the metrics and the log.
+func (router *Router) observe(ctx context.Context, span trace.Span, method, route string, recorder *statusRecorder, duration time.Duration) {
+	span.SetAttributes(attribute.Int("http.response.status_code", recorder.status))
+	if recorder.status >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(recorder.status))
+	}
+	router.metrics.ObserveHTTPRequest(method, route, recorder.status, duration)
+	if recorder.errorCode != "" {
+		router.metrics.RecordError(recorder.errorCode, "http")
 	}
+
+	level := slog.LevelInfo
+	switch {
+	case recorder.status >= http.StatusInternalServerError:
+		level = slog.LevelError
+	case recorder.status >= http.StatusBadRequest:
+		level = slog.LevelWarn
+	}
+	router.logger.LogAttrs(ctx, level, "http request",
+		slog.String("method", method),
+		slog.String("route", route),
+		slog.Int("status", recorder.status),
+		slog.Duration("duration", duration),
+	)
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	errorCode   string
+}
+
+func (recorder *statusRecorder) RecordErrorCode(code string) {
+	recorder.errorCode = code
+}
+
+func (recorder *statusRecorder) WriteHeader(status int) {
+	if !recorder.wroteHeader {
+		recorder.status = status
+		recorder.wroteHeader = true
+	}
+	recorder.ResponseWriter.WriteHeader(status)
+}
+
+func (recorder *statusRecorder) Write(data []byte) (int, error) {
+	recorder.wroteHeader = true
+	return recorder.ResponseWriter.Write(data)
+}
+
+func (recorder *statusRecorder) Unwrap() http.ResponseWriter {
+	return recorder.ResponseWriter
 }
@@ -0,0 +1,147 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/api"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/metrics"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/ratelimit"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/router"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/openapi"
+)
+
+func TestEveryRouteIsDocumented(t *testing.T) {
+	document, err := openapi.Load(api.OpenAPISpec)
+	require.NoError(t, err)
+
+	newRouter := router.NewRouter(router.Handlers{}, router.Options{Metrics: metrics.New()})
+	routes := newRouter.Routes()
+	require.NotEmpty(t, routes)
+
+	for _, route := range routes {
+		method, path, found := strings.Cut(route, " ")
+		require.True(t, found, "route %q has no method", route)
+
+		assert.NotNil(t, document.Operation(method, path), "route %q is missing from api/openapi.json", route)
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	newRouter := router.NewRouter(router.Handlers{Health: handlers.NewHealthHandler(nil)}, router.Options{Metrics: metrics.New()})
+
+	health := httptest.NewRecorder()
+	newRouter.ServeHTTP(health, httptest.NewRequest(http.MethodGet, "/v1/health", nil))
+	require.Equal(t, http.StatusOK, health.Code)
+
+	scrape := httptest.NewRecorder()
+	newRouter.ServeHTTP(scrape, httptest.NewRequest(http.MethodGet, "/v1/metrics", nil))
+	require.Equal(t, http.StatusOK, scrape.Code)
+	assert.Contains(t, scrape.Body.String(), `booking_http_request_duration_seconds_count{method="GET",route="/health",status="200"} 1`)
+}
+
+func TestRateLimit(t *testing.T) {
+	bookingHandler := handlers.NewBookingHandler(handlers.BookingHandlers{Get: queries.NewGetBookingHandler(mocks.NewMockRepository())})
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.PerMinute(60, 1), ratelimit.PerMinute(6, 1), mocks.NewLogger())
+	newRouter := router.NewRouter(router.Handlers{Booking: bookingHandler}, router.Options{Metrics: metrics.New(), RateLimiter: router.NewRateLimiter(limiter, true)})
+
+	get := func(forwardedFor string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "/v1/bookings/missing", nil)
+		request.Header.Set("X-Forwarded-For", forwardedFor)
+		response := httptest.NewRecorder()
+		newRouter.ServeHTTP(response, request)
+		return response
+	}
+
+	allowed := get("203.0.113.7")
+	assert.Equal(t, http.StatusNotFound, allowed.Code)
+	assert.Equal(t, "1", allowed.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "0", allowed.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "1", allowed.Header().Get("X-RateLimit-Reset"))
+
+	limited := get("203.0.113.7, 10.0.0.1")
+	require.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "1", limited.Header().Get("Retry-After"))
+	assert.Equal(t, "0", limited.Header().Get("X-RateLimit-Remaining"))
+
+	var body dtos.Response
+	require.NoError(t, json.NewDecoder(limited.Body).Decode(&body))
+	assert.False(t, body.Success)
+	require.NotNil(t, body.Error)
+	assert.Equal(t, "RATE_LIMITED", body.Error.Code)
+
+	assert.Equal(t, http.StatusNotFound, get("198.51.100.2").Code, "other clients are not limited")
+}
+
+// rejectingAuthenticator refuses every token.
+type rejectingAuthenticator struct{}
+
+func (rejectingAuthenticator) Authenticate(string) (*auth.Principal, error) {
+	return nil, auth.ErrUnauthenticated
+}
+
+func TestRateLimitBeforeAuthentication(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.PerMinute(60, 1), ratelimit.PerMinute(6, 1), mocks.NewLogger())
+	newRouter := router.NewRouter(router.Handlers{Booking: handlers.NewBookingHandler(handlers.BookingHandlers{})}, router.Options{
+		Authenticator: rejectingAuthenticator{},
+		RateLimiter:   router.NewRateLimiter(limiter, false),
+		Metrics:       metrics.New(),
+	})
+
+	get := func() int {
+		request := httptest.NewRequest(http.MethodGet, "/v1/bookings/booking-1", nil)
+		request.Header.Set("Authorization", "Bearer guessed")
+		response := httptest.NewRecorder()
+		newRouter.ServeHTTP(response, request)
+		return response.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, get())
+	assert.Equal(t, http.StatusTooManyRequests, get(), "requests with bad tokens are limited by their IP")
+}
+
+func TestMiddleware(t *testing.T) {
+	// Without query and command handlers, any request that reaches them panics.
+	bookingHandler := handlers.NewBookingHandler(handlers.BookingHandlers{})
+	newRouter := router.NewRouter(router.Handlers{Booking: bookingHandler}, router.Options{Metrics: metrics.New(), MaxBodyBytes: 256})
+
+	post := func(body string) (*httptest.ResponseRecorder, dtos.Response) {
+		request := httptest.NewRequest(http.MethodPost, "/v1/bookings", strings.NewReader(body))
+		request.Header.Set("X-Request-ID", "req-1")
+		response := httptest.NewRecorder()
+		newRouter.ServeHTTP(response, request)
+
+		var decoded dtos.Response
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&decoded))
+		require.NotNil(t, decoded.Error)
+		return response, decoded
+	}
+
+	panicked := httptest.NewRecorder()
+	newRouter.ServeHTTP(panicked, httptest.NewRequest(http.MethodGet, "/v1/bookings/booking-1", nil))
+	assert.Equal(t, http.StatusInternalServerError, panicked.Code)
+	assert.Contains(t, panicked.Body.String(), "INTERNAL_ERROR")
+	assert.NotEmpty(t, panicked.Header().Get("X-Request-ID"))
+	scrape := httptest.NewRecorder()
+	newRouter.ServeHTTP(scrape, httptest.NewRequest(http.MethodGet, "/v1/metrics", nil))
+	assert.Contains(t, scrape.Body.String(), `booking_http_request_duration_seconds_count{method="GET",route="/bookings/{id}",status="500"} 1`, "panics are counted with their route")
+
+	response, body := post(`{"user_id":"user-1","unknown":true}`)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.Equal(t, "INVALID_REQUEST", body.Error.Code)
+	assert.Equal(t, "req-1", response.Header().Get("X-Request-ID"))
+
+	response, body = post(`{"user_id":"` + strings.Repeat("x", 300) + `"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+	assert.Equal(t, "REQUEST_TOO_LARGE", body.Error.Code)
+}
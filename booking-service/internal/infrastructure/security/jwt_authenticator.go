@@ -0,0 +1,170 @@
+package security
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+)
+
+type JWTOptions struct {
+	// HMACSecret enables HS256 tokens when set.
+	HMACSecret []byte
+	// JWKSFile is a JSON Web Key Set file whose RSA keys enable RS256 tokens.
+	JWKSFile string
+	// Issuer and Audience are checked against the iss and aud claims when set.
+	Issuer   string
+	Audience string
+}
+
+// JWTAuthenticator turns bearer tokens into principals. The subject claim is the user ID,
+// "role" is one of member, staff or admin, and "gym_ids" lists the gyms of a staff member.
+type JWTAuthenticator struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+	parser     *jwt.Parser
+}
+
+type bookingClaims struct {
+	jwt.RegisteredClaims
+	Role   string   `json:"role"`
+	GymIDs []string `json:"gym_ids"`
+}
+
+func NewJWTAuthenticator(options JWTOptions) (*JWTAuthenticator, error) {
+	authenticator := &JWTAuthenticator{
+		hmacSecret: options.HMACSecret,
+	}
+
+	var methods []string
+	if len(options.HMACSecret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if options.JWKSFile != "" {
+		keys, err := loadJWKS(options.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticator.rsaKeys = keys
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	if len(methods) == 0 {
+		return nil, errors.New("jwt authenticator needs an HMAC secret or a JWKS file")
+	}
+
+	parserOptions := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if options.Issuer != "" {
+		parserOptions = append(parserOptions, jwt.WithIssuer(options.Issuer))
+	}
+	if options.Audience != "" {
+		parserOptions = append(parserOptions, jwt.WithAudience(options.Audience))
+	}
+	authenticator.parser = jwt.NewParser(parserOptions...)
+
+	return authenticator, nil
+}
+
+func (authenticator *JWTAuthenticator) Authenticate(token string) (*auth.Principal, error) {
+	var claims bookingClaims
+	if _, err := authenticator.parser.ParseWithClaims(token, &claims, authenticator.key); err != nil {
+		return nil, fmt.Errorf("%w: %v", auth.ErrUnauthenticated, err)
+	}
+
+	role := auth.Role(claims.Role)
+	if claims.Subject == "" || !role.IsValid() {
+		return nil, fmt.Errorf("%w: token is missing a subject or a valid role", auth.ErrUnauthenticated)
+	}
+
+	return &auth.Principal{
+		UserID: claims.Subject,
+		Role:   role,
+		GymIDs: claims.GymIDs,
+	}, nil
+}
+
+func (authenticator *JWTAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return authenticator.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, exists := authenticator.rsaKeys[kid]; exists {
+			return key, nil
+		}
+		if kid == "" && len(authenticator.rsaKeys) == 1 {
+			for _, key := range authenticator.rsaKeys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+// loadJWKS reads the RSA signing keys of a JWKS file, indexed by key ID.
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	var keySet jsonWebKeySet
+	if err := json.Unmarshal(data, &keySet); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, key := range keySet.Keys {
+		if key.KeyType != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+		publicKey, err := key.rsaPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q in JWKS file: %w", key.KeyID, err)
+		}
+		keys[key.KeyID] = publicKey
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS file contains no RSA signing keys")
+	}
+	return keys, nil
+}
+
+func (key jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(key.Modulus)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	exponent, err := base64.RawURLEncoding.DecodeString(key.Exponent)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(new(big.Int).SetBytes(exponent).Int64()),
+	}, nil
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/security"
+)
+
+func TestJWTAuthenticator(t *testing.T) {
+	secret := []byte("test-secret")
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(jwksFile, jwks, 0o600))
+
+	authenticator, err := security.NewJWTAuthenticator(security.JWTOptions{
+		HMACSecret: secret,
+		JWKSFile:   jwksFile,
+		Issuer:     "fitbook",
+	})
+	require.NoError(t, err)
+
+	claims := func(role string, expiresIn time.Duration) jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":     "user1",
+			"iss":     "fitbook",
+			"exp":     time.Now().Add(expiresIn).Unix(),
+			"role":    role,
+			"gym_ids": []string{"gym1"},
+		}
+	}
+	signHS256 := func(claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+		require.NoError(t, err)
+		return token
+	}
+	signRS256 := func(claims jwt.MapClaims, kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+		return signed
+	}
+
+	t.Run("HS256 token", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(signHS256(claims("staff", time.Hour)))
+		require.NoError(t, err)
+		assert.Equal(t, &auth.Principal{UserID: "user1", Role: auth.RoleStaff, GymIDs: []string{"gym1"}}, principal)
+	})
+
+	t.Run("RS256 token", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(signRS256(claims("member", time.Hour), "key-1"))
+		require.NoError(t, err)
+		assert.Equal(t, auth.RoleMember, principal.Role)
+	})
+
+	invalid := map[string]string{
+		"expired":        signHS256(claims("member", -time.Hour)),
+		"unknown role":   signHS256(claims("owner", time.Hour)),
+		"unknown key id": signRS256(claims("member", time.Hour), "key-2"),
+		"wrong issuer":   signHS256(jwt.MapClaims{"sub": "user1", "iss": "other", "exp": time.Now().Add(time.Hour).Unix(), "role": "member"}),
+		"wrong secret": func() string {
+			s, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims("admin", time.Hour)).SignedString([]byte("nope"))
+			return s
+		}(),
+		"not a jwt": "garbage",
+		"unsigned (none)": func() string {
+			s, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims("admin", time.Hour)).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			return s
+		}(),
+	}
+	for name, token := range invalid {
+		t.Run(name, func(t *testing.T) {
+			principal, err := authenticator.Authenticate(token)
+			assert.Nil(t, principal)
+			assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+		})
+	}
+}
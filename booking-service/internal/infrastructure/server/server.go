@@ -3,8 +3,10 @@ package server
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -12,46 +14,336 @@ import (
 	"time"
 
 	_ "github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
+	"github.com/yourusername/fitbook/booking-service/api"
+	bookingv1 "github.com/yourusername/fitbook/booking-service/api/gen/booking/v1"
+	"github.com/yourusername/fitbook/booking-service/internal/application/closures"
 	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/memberships"
+	"github.com/yourusername/fitbook/booking-service/internal/application/policies"
 	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/application/quotas"
+	"github.com/yourusername/fitbook/booking-service/internal/application/resources"
+	"github.com/yourusername/fitbook/booking-service/internal/application/sessions"
+	"github.com/yourusername/fitbook/booking-service/internal/application/strikes"
+	"github.com/yourusername/fitbook/booking-service/internal/application/timetables"
+	"github.com/yourusername/fitbook/booking-service/internal/application/trainers"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/quota"
 	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/config"
 	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/database"
 	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/events"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/health"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/lifecycle"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/logging"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/membershipclient"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/metrics"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/middleware"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/ratelimit"
 	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/router"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/security"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/tracing"
+	grpchandlers "github.com/yourusername/fitbook/booking-service/internal/interfaces/grpc/handlers"
 	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/openapi"
+	"github.com/yourusername/fitbook/booking-service/migrations"
+	"google.golang.org/grpc"
 )
 
+// Start runs the HTTP and gRPC servers until the process receives SIGINT or SIGTERM, or a
+// component fails. Shutdown drains in-flight requests and then releases the event
+// subscribers, the database and the tracer, all within cfg.Server.ShutdownTimeout.
 func Start(cfg *config.Config) error {
-	db, err := sql.Open("postgres", cfg.Database.GetDSN())
+	logger, err := logging.New(os.Stdout, cfg.Logging.Level, cfg.Logging.Format)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return err
 	}
-	defer db.Close()
+	logger = logger.With(
+		slog.String("service", cfg.App.ServiceName),
+		slog.String("env", cfg.App.Env),
+	)
+	slog.SetDefault(logger)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	manager := lifecycle.NewManager(cfg.Server.ShutdownTimeout, logger)
 
-	bookingRepo := database.NewBookingRepository(db)
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+		ServiceName:  cfg.App.ServiceName,
+		Environment:  cfg.App.Env,
+		Exporter:     cfg.Tracing.Exporter,
+		OTLPEndpoint: cfg.Tracing.OTLPEndpoint,
+		SampleRatio:  cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure tracing: %w", err)
+	}
+	manager.Append(lifecycle.Hook{Name: "tracing", Stop: shutdownTracing})
 
-	eventPublisher := events.NewEventPublisher()
+	db, err := OpenDatabase(ctx, cfg, logger)
+	if err != nil {
+		return manager.Abort(fmt.Errorf("failed to connect to database: %w", err))
+	}
+	manager.Append(lifecycle.Hook{
+		Name: "postgres",
+		Start: func(ctx context.Context) error {
+			if !cfg.Database.MigrateOnStart {
+				return nil
+			}
+			loaded, err := database.LoadMigrations(migrations.FS)
+			if err != nil {
+				return err
+			}
+			_, err = database.NewMigrator(db, loaded, logger).Up(ctx)
+			return err
+		},
+		Stop: func(context.Context) error { return db.Close() },
+	})
+
+	serviceMetrics := metrics.New()
+	serviceMetrics.RegisterDB(db, "bookings")
+
+	bookingRepo := database.NewBookingRepository(db, logger)
+	policyRepo := database.NewPolicyRepository(db, logger)
+	policyChecker := policies.NewChecker(policyRepo, bookingRepo)
+	strikeTracker := strikes.NewTracker(policyChecker, database.NewStrikeRepository(db, logger))
+	sessionRepo := database.NewSessionRepository(db, logger)
+	enrollmentCheck := sessions.NewEnrollmentCheck(sessionRepo, bookingRepo)
+	timetableRepo := database.NewTimetableRepository(db, logger)
+	trainerRepo := database.NewTrainerRepository(db, logger)
+	appointments := trainers.NewAppointments(trainerRepo, bookingRepo)
+	resourceRepo := database.NewResourceRepository(db, logger)
+	resourceCapacity := resources.NewCapacity(resourceRepo, bookingRepo)
+	closureRepo := database.NewClosureRepository(db, logger)
+	closureCheck := closures.NewChecker(closureRepo, bookingRepo, policyChecker)
+	var bookingChecks []commands.BookingCheck
+	var tierSource membership.TierSource
+	if cfg.Membership.URL != "" {
+		membershipChecker, err := membershipclient.NewHTTPChecker(membershipclient.HTTPOptions{
+			BaseURL:          cfg.Membership.URL,
+			Token:            cfg.Membership.Token,
+			Timeout:          cfg.Membership.Timeout,
+			CacheTTL:         cfg.Membership.CacheTTL,
+			FailureThreshold: cfg.Membership.BreakerFailures,
+			Cooldown:         cfg.Membership.BreakerCooldown,
+		}, logger)
+		if err != nil {
+			return manager.Abort(err)
+		}
+		bookingChecks = append(bookingChecks, memberships.NewEntitlementCheck(membershipChecker))
+		tierSource = membershipChecker
+	} else {
+		logger.Warn("no membership service configured; every user may book every gym on the default quota tier")
+	}
+	quotaChecker := quotas.NewChecker(bookingRepo, tierSource, quotaLimits(cfg.Quota), quota.Tier(cfg.Quota.DefaultTier))
+	bookingChecks = append(bookingChecks, closureCheck, enrollmentCheck, appointments, resourceCapacity, strikeTracker, policyChecker)
+	if cfg.Quota.Enabled {
+		bookingChecks = append(bookingChecks, quotaChecker)
+	}
+
+	eventPublisher := events.NewBroadcaster(
+		events.NewInstrumentedPublisher(events.NewEventPublisher(logger), serviceMetrics),
+	)
 
-	createBookingHandler := commands.NewCreateBookingHandler(bookingRepo, eventPublisher)
-	cancelBookingHandler := commands.NewCancelBookingHandler(bookingRepo, eventPublisher)
-	confirmBookingHandler := commands.NewConfirmBookingHandler(bookingRepo, eventPublisher)
-	completeBookingHandler := commands.NewCompleteBookingHandler(bookingRepo, eventPublisher)
+	generator := timetables.NewGenerator(timetableRepo, sessionRepo, enrollmentCheck, policyChecker, eventPublisher, cfg.Timetable.WeeksAhead, logger)
+
+	createBookingHandler := commands.NewCreateBookingHandler(bookingRepo, eventPublisher, logger, bookingChecks...)
+	createBookingsBatchHandler := commands.NewCreateBookingsBatchHandler(bookingRepo, eventPublisher, logger, bookingChecks...)
+	rescheduleBookingHandler := commands.NewRescheduleBookingHandler(bookingRepo, eventPublisher, logger, bookingChecks...)
+	cancelBookingHandler := commands.NewCancelBookingHandler(bookingRepo, eventPublisher, logger, strikeTracker)
+	confirmBookingHandler := commands.NewConfirmBookingHandler(bookingRepo, eventPublisher, logger)
+	completeBookingHandler := commands.NewCompleteBookingHandler(bookingRepo, eventPublisher, logger)
+	markNoShowHandler := commands.NewMarkNoShowHandler(bookingRepo, eventPublisher, logger, strikeTracker)
+	clearStrikesHandler := commands.NewClearStrikesHandler(strikeTracker, logger)
+	createClassSessionHandler := commands.NewCreateClassSessionHandler(sessionRepo, logger)
+	updateClassSessionHandler := commands.NewUpdateClassSessionHandler(sessionRepo, enrollmentCheck, logger)
+	cancelClassSessionHandler := commands.NewCancelClassSessionHandler(sessionRepo, bookingRepo, enrollmentCheck, eventPublisher, logger)
+	enrollHandler := commands.NewEnrollHandler(sessionRepo, createBookingHandler)
+	createTimetableTemplateHandler := commands.NewCreateTimetableTemplateHandler(timetableRepo, generator, logger)
+	updateTimetableTemplateHandler := commands.NewUpdateTimetableTemplateHandler(timetableRepo, generator, logger)
+	endTimetableTemplateHandler := commands.NewEndTimetableTemplateHandler(timetableRepo, generator, logger)
+	createTrainerHandler := commands.NewCreateTrainerHandler(trainerRepo, logger)
+	updateTrainerHandler := commands.NewUpdateTrainerHandler(trainerRepo, logger)
+	addTrainerTimeOffHandler := commands.NewAddTrainerTimeOffHandler(trainerRepo, bookingRepo, logger)
+	deleteTrainerTimeOffHandler := commands.NewDeleteTrainerTimeOffHandler(trainerRepo, logger)
+	createResourceHandler := commands.NewCreateResourceHandler(resourceRepo, logger)
+	updateResourceHandler := commands.NewUpdateResourceHandler(resourceRepo, logger)
+	takeResourceOutOfServiceHandler := commands.NewTakeResourceOutOfServiceHandler(resourceRepo, bookingRepo, eventPublisher, logger)
+	returnResourceToServiceHandler := commands.NewReturnResourceToServiceHandler(resourceRepo, bookingRepo, logger)
+	createClosureHandler := commands.NewCreateClosureHandler(closureRepo, closureCheck, bookingRepo, eventPublisher, logger)
+	importClosuresHandler := commands.NewImportClosuresHandler(closureCheck, createClosureHandler)
+	deleteClosureHandler := commands.NewDeleteClosureHandler(closureRepo, logger)
+	forceBookingStatusHandler := commands.NewForceBookingStatusHandler(bookingRepo, eventPublisher, logger)
+	setGymPolicyHandler := commands.NewSetGymPolicyHandler(policyRepo, logger)
 
 	getBookingHandler := queries.NewGetBookingHandler(bookingRepo)
 	listBookingsHandler := queries.NewListBookingsHandler(bookingRepo)
+	getBookingHistoryHandler := queries.NewGetBookingHistoryHandler(bookingRepo)
+	getGymPolicyHandler := queries.NewGetGymPolicyHandler(policyChecker)
+	previewBookingPolicyHandler := queries.NewPreviewBookingPolicyHandler(policyChecker)
+	getUserQuotaHandler := queries.NewGetUserQuotaHandler(quotaChecker)
+	getUserStrikesHandler := queries.NewGetUserStrikesHandler(strikeTracker)
+	getClassSessionHandler := queries.NewGetClassSessionHandler(sessionRepo, enrollmentCheck)
+	listClassSessionsHandler := queries.NewListClassSessionsHandler(sessionRepo, enrollmentCheck)
+	getTimetableTemplateHandler := queries.NewGetTimetableTemplateHandler(timetableRepo)
+	listTimetableTemplatesHandler := queries.NewListTimetableTemplatesHandler(timetableRepo)
+	getTrainerHandler := queries.NewGetTrainerHandler(trainerRepo)
+	getTrainerAvailabilityHandler := queries.NewGetTrainerAvailabilityHandler(trainerRepo, appointments)
+	getResourceHandler := queries.NewGetResourceHandler(resourceRepo)
+	listResourcesHandler := queries.NewListResourcesHandler(resourceRepo)
+	getResourceAvailabilityHandler := queries.NewGetResourceAvailabilityHandler(resourceRepo, resourceCapacity)
+	listClosuresHandler := queries.NewListClosuresHandler(closureRepo)
 
-	bookingHandler := handlers.NewBookingHandler(
+	bookingHandler := handlers.NewBookingHandler(handlers.BookingHandlers{
+		Create:     createBookingHandler,
+		Batch:      createBookingsBatchHandler,
+		Get:        getBookingHandler,
+		List:       listBookingsHandler,
+		Cancel:     cancelBookingHandler,
+		Confirm:    confirmBookingHandler,
+		Complete:   completeBookingHandler,
+		NoShow:     markNoShowHandler,
+		History:    getBookingHistoryHandler,
+		Force:      forceBookingStatusHandler,
+		Reschedule: rescheduleBookingHandler,
+	})
+	policyHandler := handlers.NewGymPolicyHandler(getGymPolicyHandler, setGymPolicyHandler, previewBookingPolicyHandler)
+	userHandler := handlers.NewUserHandler(getUserQuotaHandler, getUserStrikesHandler, clearStrikesHandler)
+	sessionHandler := handlers.NewClassSessionHandler(
+		createClassSessionHandler,
+		updateClassSessionHandler,
+		cancelClassSessionHandler,
+		enrollHandler,
+		getClassSessionHandler,
+		listClassSessionsHandler,
+	)
+	timetableHandler := handlers.NewTimetableHandler(
+		createTimetableTemplateHandler,
+		updateTimetableTemplateHandler,
+		endTimetableTemplateHandler,
+		getTimetableTemplateHandler,
+		listTimetableTemplatesHandler,
+	)
+	trainerHandler := handlers.NewTrainerHandler(
+		createTrainerHandler,
+		updateTrainerHandler,
+		addTrainerTimeOffHandler,
+		deleteTrainerTimeOffHandler,
+		getTrainerHandler,
+		getTrainerAvailabilityHandler,
+	)
+	resourceHandler := handlers.NewResourceHandler(
+		createResourceHandler,
+		updateResourceHandler,
+		takeResourceOutOfServiceHandler,
+		returnResourceToServiceHandler,
+		getResourceHandler,
+		listResourcesHandler,
+		getResourceAvailabilityHandler,
+	)
+	closureHandler := handlers.NewClosureHandler(
+		createClosureHandler,
+		importClosuresHandler,
+		deleteClosureHandler,
+		listClosuresHandler,
+	)
+	bookingServer := grpchandlers.NewBookingServer(
 		createBookingHandler,
 		getBookingHandler,
 		listBookingsHandler,
 		cancelBookingHandler,
 		confirmBookingHandler,
 		completeBookingHandler,
+		eventPublisher,
 	)
-	healthHandler := handlers.NewHealthHandler()
+	var authenticator *security.JWTAuthenticator
+	if cfg.Auth.Enabled() {
+		authenticator, err = security.NewJWTAuthenticator(security.JWTOptions{
+			HMACSecret: []byte(cfg.Auth.HS256Secret),
+			JWKSFile:   cfg.Auth.JWKSFile,
+			Issuer:     cfg.Auth.Issuer,
+			Audience:   cfg.Auth.Audience,
+		})
+		if err != nil {
+			return manager.Abort(fmt.Errorf("failed to configure authentication: %w", err))
+		}
+		logger.Info("bearer token authentication enabled")
+	} else {
+		logger.Warn("authentication disabled: no BOOKING_AUTH_HS256_SECRET or BOOKING_AUTH_JWKS_FILE configured")
+	}
+
+	readiness, err := newReadinessChecker(cfg, db, eventPublisher)
+	if err != nil {
+		return manager.Abort(err)
+	}
+	healthHandler := handlers.NewHealthHandler(readiness)
+	openAPIHandler := handlers.NewOpenAPIHandler(api.OpenAPISpec)
+
+	var routerAuthenticator router.Authenticator
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpchandlers.UnaryMetricsInterceptor(serviceMetrics),
+		grpchandlers.UnaryLoggingInterceptor(logger),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpchandlers.StreamMetricsInterceptor(serviceMetrics),
+		grpchandlers.StreamLoggingInterceptor(logger),
+	}
+	if authenticator != nil {
+		routerAuthenticator = authenticator
+		unaryInterceptors = append(unaryInterceptors, grpchandlers.UnaryAuthInterceptor(authenticator))
+		streamInterceptors = append(streamInterceptors, grpchandlers.StreamAuthInterceptor(authenticator))
+	}
+
+	var rateLimiter *router.RateLimiter
+	if cfg.RateLimit.Enabled {
+		limiter, err := newRateLimiter(cfg, manager, logger)
+		if err != nil {
+			return manager.Abort(err)
+		}
+		rateLimiter = router.NewRateLimiter(limiter, cfg.RateLimit.TrustProxyHeaders)
+		logger.Info("rate limiting enabled", slog.String("backend", cfg.RateLimit.Backend))
+	}
+
+	routerOptions := router.Options{
+		Authenticator:  routerAuthenticator,
+		RateLimiter:    rateLimiter,
+		Logger:         logger,
+		Metrics:        serviceMetrics,
+		MaxBodyBytes:   int64(cfg.Server.MaxBodyBytes),
+		RequestTimeout: cfg.Server.RequestTimeout,
+		RouteTimeouts: map[string]time.Duration{
+			"POST /bookings:batch": cfg.Server.BatchRequestTimeout,
+		},
+		CORS: middleware.CORSOptions{
+			AllowedOrigins:   cfg.CORS.AllowedOrigins,
+			AllowCredentials: cfg.CORS.AllowCredentials,
+			MaxAge:           cfg.CORS.MaxAge,
+		},
+		Compression: cfg.Server.Compression,
+	}
+	if cfg.Server.ValidateRequests {
+		document, err := openapi.Load(api.OpenAPISpec)
+		if err != nil {
+			return manager.Abort(err)
+		}
+		routerOptions.Middlewares = append(routerOptions.Middlewares, openapi.NewValidator(document).Middleware)
+		logger.Info("OpenAPI request validation enabled")
+	}
 
-	newRouter := router.NewRouter(bookingHandler, healthHandler)
-	log.Println("Router initialized")
+	newRouter := router.NewRouter(router.Handlers{
+		Booking:   bookingHandler,
+		Policy:    policyHandler,
+		User:      userHandler,
+		Session:   sessionHandler,
+		Timetable: timetableHandler,
+		Trainer:   trainerHandler,
+		Resource:  resourceHandler,
+		Closure:   closureHandler,
+		Health:    healthHandler,
+		OpenAPI:   openAPIHandler,
+	}, routerOptions)
+	logger.Debug("router initialized")
 
 	srv := &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port),
@@ -59,27 +351,163 @@ func Start(cfg *config.Config) error {
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 	}
-	log.Printf("Server configured to listen on %s", srv.Addr)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	bookingv1.RegisterBookingServiceServer(grpcServer, bookingServer)
 
-	go func() {
-		log.Printf("Starting server on %s...", srv.Addr)
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Server error: %v", err)
-		}
-	}()
+	if cfg.Timetable.Enabled {
+		manager.Append(manager.Worker("timetable_generator", func(ctx context.Context) error {
+			return generator.Run(ctx, cfg.Timetable.Interval)
+		}))
+	}
+	manager.Append(httpServerHook(srv, manager, logger))
+	manager.Append(grpcServerHook(grpcServer, fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.GRPC.Port), manager, logger))
+	// Appended after the servers so that it stops before them: WatchBookings streams only
+	// end when their subscription is closed, and graceful gRPC shutdown waits for them.
+	manager.Append(lifecycle.Hook{
+		Name: "event_subscribers",
+		Stop: func(context.Context) error {
+			eventPublisher.Close()
+			return nil
+		},
+	})
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-	log.Println("Shutting down server...")
+	return manager.Run(ctx)
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// httpServerHook listens when started, so that a busy port fails startup, and drains
+// in-flight requests when stopped.
+func httpServerHook(srv *http.Server, manager *lifecycle.Manager, logger *slog.Logger) lifecycle.Hook {
+	return lifecycle.Hook{
+		Name: "http_server",
+		Start: func(context.Context) error {
+			listener, err := net.Listen("tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+			logger.Info("starting HTTP server", slog.String("addr", listener.Addr().String()))
+			go func() {
+				if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					manager.Fail(fmt.Errorf("HTTP server: %w", err))
+				}
+			}()
+			return nil
+		},
+		Stop: srv.Shutdown,
+	}
+}
 
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Printf("Server forced to shutdown: %v", err)
-		return err
+// grpcServerHook listens on addr when started and stops gracefully, forcing the remaining
+// calls closed if the shutdown deadline passes first.
+func grpcServerHook(grpcServer *grpc.Server, addr string, manager *lifecycle.Manager, logger *slog.Logger) lifecycle.Hook {
+	return lifecycle.Hook{
+		Name: "grpc_server",
+		Start: func(context.Context) error {
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+			logger.Info("starting gRPC server", slog.String("addr", listener.Addr().String()))
+			go func() {
+				if err := grpcServer.Serve(listener); err != nil {
+					manager.Fail(fmt.Errorf("gRPC server: %w", err))
+				}
+			}()
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				grpcServer.Stop()
+				return ctx.Err()
+			}
+		},
 	}
+}
+
+// newReadinessChecker checks the database and event publisher, which every booking
+// operation needs, and Redis when it is configured. Rate limits fall back to in-memory
+// buckets without Redis, so it is reported but does not make the service unready.
+func newReadinessChecker(cfg *config.Config, db *sql.DB, publisher events.Pinger) (*health.Checker, error) {
+	components := []health.Component{
+		{Name: "postgres", Required: true, Check: db.PingContext},
+		{Name: "event_publisher", Required: true, Check: publisher.Ping},
+	}
+	if cfg.Redis.URL != "" {
+		pingRedis, err := health.PingRedis(cfg.Redis.URL)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, health.Component{Name: "redis", Check: pingRedis})
+	}
+	return health.NewChecker(cfg.Health.CheckTimeout, components...), nil
+}
+
+// newRateLimiter keeps buckets in memory, or in Redis with an in-memory fallback when the
+// redis backend is configured, in which case the Redis client is closed on shutdown.
+func newRateLimiter(cfg *config.Config, manager *lifecycle.Manager, logger *slog.Logger) (*ratelimit.Limiter, error) {
+	var store ratelimit.Store = ratelimit.NewMemoryStore()
+	if cfg.RateLimit.Backend == "redis" {
+		options, err := redis.ParseURL(cfg.Redis.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redis url: %w", err)
+		}
+		client := redis.NewClient(options)
+		manager.Append(lifecycle.Hook{
+			Name: "redis",
+			Stop: func(context.Context) error { return client.Close() },
+		})
+		store = ratelimit.NewFallbackStore(
+			ratelimit.NewRedisStore(client, "booking:ratelimit:"),
+			store,
+			logger,
+		)
+	}
+	return ratelimit.NewLimiter(
+		store,
+		ratelimit.PerMinute(cfg.RateLimit.ReadPerMinute, cfg.RateLimit.ReadBurst),
+		ratelimit.PerMinute(cfg.RateLimit.WritePerMinute, cfg.RateLimit.WriteBurst),
+		logger,
+	), nil
+}
+
+// quotaLimits returns the limits of each membership tier, or none when quotas are disabled so
+// that every tier is reported as unlimited.
+func quotaLimits(config config.QuotaConfig) map[quota.Tier]quota.Limits {
+	if !config.Enabled {
+		return nil
+	}
+	return map[quota.Tier]quota.Limits{
+		quota.TierBasic: {
+			MaxActiveBookings: config.BasicMaxActiveBookings,
+			MaxWeeklyDuration: config.BasicMaxWeeklyDuration,
+			MaxAdvance:        config.BasicMaxAdvance,
+		},
+		quota.TierPremium: {
+			MaxActiveBookings: config.PremiumMaxActiveBookings,
+			MaxWeeklyDuration: config.PremiumMaxWeeklyDuration,
+			MaxAdvance:        config.PremiumMaxAdvance,
+		},
+	}
+}
 
-	return nil
+// OpenDatabase connects to the configured Postgres database, waiting for it to become
+// reachable.
+func OpenDatabase(ctx context.Context, cfg *config.Config, logger *slog.Logger) (*sql.DB, error) {
+	return database.Connect(ctx, "postgres", cfg.Database.GetDSN(), database.PoolOptions{
+		ConnectTimeout:  cfg.Database.ConnectTimeout,
+		MaxOpenConns:    cfg.Database.MaxOpenConns,
+		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
+		ConnMaxIdleTime: cfg.Database.ConnMaxIdleTime,
+	}, logger)
 }
@@ -0,0 +1,161 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/events"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/router"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/tracing"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/handlers"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const parentTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+// envelopePublisher keeps the envelope of every event, as a broker publisher would send it.
+type envelopePublisher struct {
+	mu        sync.Mutex
+	envelopes []*events.Envelope
+}
+
+func (publisher *envelopePublisher) Publish(ctx context.Context, event booking.Event) error {
+	envelope, err := events.NewEnvelope(ctx, event)
+	if err != nil {
+		return err
+	}
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+	publisher.envelopes = append(publisher.envelopes, envelope)
+	return nil
+}
+
+// spanRecorder receives the spans of the service's package-level tracers. The global
+// provider only delegates to the first provider installed, so it is set once for all tests.
+var spanRecorder = tracetest.NewSpanRecorder()
+
+func TestMain(m *testing.M) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	os.Exit(m.Run())
+}
+
+func endedSpans() map[string]sdktrace.ReadOnlySpan {
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range spanRecorder.Ended() {
+		spans[span.Name()] = span
+	}
+	return spans
+}
+
+func TestCreateBookingTraceReachesPublishedEvent(t *testing.T) {
+	publisher := &envelopePublisher{}
+	handler := commands.NewCreateBookingHandler(
+		mocks.NewMockRepository(),
+		events.NewInstrumentedPublisher(publisher, nil),
+		mocks.NewLogger(),
+	)
+
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(),
+		propagation.MapCarrier{"traceparent": parentTraceparent})
+	now := time.Now()
+	_, err := handler.Handle(ctx, commands.CreateBookingCommand{DTO: &dtos.CreateBookingDTO{
+		UserID:    "user1",
+		GymID:     "gym1",
+		StartTime: now.Add(time.Hour).Format(time.RFC3339),
+		EndTime:   now.Add(2 * time.Hour).Format(time.RFC3339),
+	}})
+	require.NoError(t, err)
+
+	spans := endedSpans()
+	require.Contains(t, spans, "CreateBookingHandler.Handle")
+	require.Contains(t, spans, "EventPublisher.Publish")
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans["CreateBookingHandler.Handle"].SpanContext().TraceID().String())
+	assert.Equal(t, spans["CreateBookingHandler.Handle"].SpanContext().SpanID(), spans["EventPublisher.Publish"].Parent().SpanID())
+
+	require.Len(t, publisher.envelopes, 1)
+	traceparent := publisher.envelopes[0].Headers["traceparent"]
+	assert.Contains(t, traceparent, "4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.Contains(t, traceparent, spans["EventPublisher.Publish"].SpanContext().SpanID().String())
+}
+
+func TestRouterContinuesIncomingTrace(t *testing.T) {
+	newRouter := router.NewRouter(router.Handlers{Health: handlers.NewHealthHandler(nil)}, router.Options{})
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+	request.Header.Set("traceparent", parentTraceparent)
+	response := httptest.NewRecorder()
+	newRouter.ServeHTTP(response, request)
+
+	span, exists := endedSpans()["GET /health"]
+	require.True(t, exists)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Contains(t, response.Header().Get("traceparent"), span.SpanContext().SpanID().String())
+}
+
+func TestOTLPExporter(t *testing.T) {
+	received := make(chan string, 10)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		received <- req.Method + " " + req.URL.Path
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{
+		ServiceName:  "booking-service",
+		Exporter:     "otlp",
+		OTLPEndpoint: collector.URL,
+		SampleRatio:  1,
+	})
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "test span")
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+
+	select {
+	case request := <-received:
+		assert.Equal(t, "POST /v1/traces", request)
+	case <-time.After(5 * time.Second):
+		t.Fatal("collector received no spans")
+	}
+}
+
+func TestStdoutExporter(t *testing.T) {
+	var output strings.Builder
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{
+		ServiceName: "booking-service",
+		Exporter:    "stdout",
+		SampleRatio: 1,
+		Output:      &output,
+	})
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "stdout span")
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+
+	assert.Contains(t, output.String(), `"Name":"stdout span"`)
+}
+
+func TestUnknownExporter(t *testing.T) {
+	_, err := tracing.Setup(context.Background(), tracing.Options{Exporter: "zipkin"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Options configures the tracer provider installed by Setup.
+type Options struct {
+	ServiceName string
+	Environment string
+	// Exporter is "none", "stdout" or "otlp".
+	Exporter string
+	// OTLPEndpoint is the base URL of an OTLP/HTTP collector, e.g. http://localhost:4318.
+	OTLPEndpoint string
+	SampleRatio  float64
+	// Output receives spans when Exporter is "stdout". It defaults to os.Stdout.
+	Output io.Writer
+}
+
+// Setup installs the global tracer provider and the W3C trace context propagator. The
+// returned function flushes buffered spans and must be called on shutdown. With the "none"
+// exporter spans are still created, so trace context is propagated, but never exported.
+func Setup(ctx context.Context, options Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	providerOptions := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(options.ServiceName),
+			semconv.DeploymentEnvironment(options.Environment),
+		)),
+	}
+
+	switch options.Exporter {
+	case "", "none":
+	case "stdout":
+		output := options.Output
+		if output == nil {
+			output = os.Stdout
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(output))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout trace exporter: %w", err)
+		}
+		providerOptions = append(providerOptions, sdktrace.WithBatcher(exporter))
+	case "otlp":
+		endpoint, err := url.Parse(options.OTLPEndpoint)
+		if err != nil || endpoint.Host == "" {
+			return nil, fmt.Errorf("invalid OTLP endpoint %q", options.OTLPEndpoint)
+		}
+		exporterOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint.Host)}
+		if endpoint.Scheme == "http" {
+			exporterOptions = append(exporterOptions, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(ctx, exporterOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		providerOptions = append(providerOptions, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q: must be none, stdout or otlp", options.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(providerOptions...)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Authenticator verifies a bearer token and returns the caller it identifies.
+type Authenticator interface {
+	Authenticate(token string) (*auth.Principal, error)
+}
+
+// UnaryAuthInterceptor requires a valid "authorization: Bearer <token>" metadata entry and
+// attaches its principal to the call context.
+func UnaryAuthInterceptor(authenticator Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		authenticated, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(authenticated, request)
+	}
+}
+
+func StreamAuthInterceptor(authenticator Authenticator) grpc.StreamServerInterceptor {
+	return func(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		authenticated, err := authenticate(stream.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		return handler(server, &authenticatedStream{ServerStream: stream, ctx: authenticated})
+	}
+}
+
+func authenticate(ctx context.Context, authenticator Authenticator) (context.Context, error) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		return nil, toStatusError(auth.ErrUnauthenticated)
+	}
+	token, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found {
+		return nil, toStatusError(auth.ErrUnauthenticated)
+	}
+
+	principal, err := authenticator.Authenticate(strings.TrimSpace(token))
+	if err != nil {
+		return nil, toStatusError(auth.ErrUnauthenticated)
+	}
+	logging.AddAttrs(ctx, slog.String("user_id", principal.UserID))
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authenticatedStream) Context() context.Context {
+	return stream.ctx
+}
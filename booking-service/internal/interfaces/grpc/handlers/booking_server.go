@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+
+	bookingv1 "github.com/yourusername/fitbook/booking-service/api/gen/booking/v1"
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+)
+
+const watchBufferSize = 64
+
+// EventSubscriber is the source of booking events streamed to WatchBookings clients.
+type EventSubscriber interface {
+	Subscribe(bufferSize int) (<-chan booking.Event, func())
+}
+
+type BookingServer struct {
+	bookingv1.UnimplementedBookingServiceServer
+
+	createHandler   *commands.CreateBookingHandler
+	getHandler      *queries.GetBookingHandler
+	listHandler     *queries.ListBookingsHandler
+	cancelHandler   *commands.CancelBookingHandler
+	confirmHandler  *commands.ConfirmBookingHandler
+	completeHandler *commands.CompleteBookingHandler
+	subscriber      EventSubscriber
+}
+
+func NewBookingServer(
+	createHandler *commands.CreateBookingHandler,
+	getHandler *queries.GetBookingHandler,
+	listHandler *queries.ListBookingsHandler,
+	cancelHandler *commands.CancelBookingHandler,
+	confirmHandler *commands.ConfirmBookingHandler,
+	completeHandler *commands.CompleteBookingHandler,
+	subscriber EventSubscriber,
+) *BookingServer {
+	return &BookingServer{
+		createHandler:   createHandler,
+		getHandler:      getHandler,
+		listHandler:     listHandler,
+		cancelHandler:   cancelHandler,
+		confirmHandler:  confirmHandler,
+		completeHandler: completeHandler,
+		subscriber:      subscriber,
+	}
+}
+
+func (server *BookingServer) CreateBooking(ctx context.Context, request *bookingv1.CreateBookingRequest) (*bookingv1.CreateBookingResponse, error) {
+	result, err := server.createHandler.Handle(ctx, commands.CreateBookingCommand{DTO: toCreateBookingDTO(request)})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	bookingMessage, err := toBookingMessage(result.Booking)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &bookingv1.CreateBookingResponse{Booking: bookingMessage}, nil
+}
+
+func (server *BookingServer) GetBooking(ctx context.Context, request *bookingv1.GetBookingRequest) (*bookingv1.GetBookingResponse, error) {
+	result, err := server.getHandler.Handle(ctx, queries.GetBookingQuery{BookingID: request.GetBookingId()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	bookingMessage, err := toBookingMessage(result.Booking)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &bookingv1.GetBookingResponse{Booking: bookingMessage}, nil
+}
+
+func (server *BookingServer) ListBookings(ctx context.Context, request *bookingv1.ListBookingsRequest) (*bookingv1.ListBookingsResponse, error) {
+	result, err := server.listHandler.Handle(ctx, queries.ListBookingsQuery{
+		UserID:    request.GetUserId(),
+		GymID:     request.GetGymId(),
+		StartTime: toTime(request.GetStartTime()),
+		EndTime:   toTime(request.GetEndTime()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &bookingv1.ListBookingsResponse{
+		Bookings: make([]*bookingv1.Booking, len(result.Bookings)),
+	}
+	for i, dto := range result.Bookings {
+		bookingMessage, err := toBookingMessage(dto)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		response.Bookings[i] = bookingMessage
+	}
+	return response, nil
+}
+
+func (server *BookingServer) CancelBooking(ctx context.Context, request *bookingv1.CancelBookingRequest) (*bookingv1.CancelBookingResponse, error) {
+	if err := server.cancelHandler.Handle(ctx, commands.CancelBookingCommand{BookingID: request.GetBookingId()}); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &bookingv1.CancelBookingResponse{}, nil
+}
+
+func (server *BookingServer) ConfirmBooking(ctx context.Context, request *bookingv1.ConfirmBookingRequest) (*bookingv1.ConfirmBookingResponse, error) {
+	if err := server.confirmHandler.Handle(ctx, commands.ConfirmBookingCommand{BookingID: request.GetBookingId()}); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &bookingv1.ConfirmBookingResponse{}, nil
+}
+
+func (server *BookingServer) CompleteBooking(ctx context.Context, request *bookingv1.CompleteBookingRequest) (*bookingv1.CompleteBookingResponse, error) {
+	if err := server.completeHandler.Handle(ctx, commands.CompleteBookingCommand{BookingID: request.GetBookingId()}); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &bookingv1.CompleteBookingResponse{}, nil
+}
+
+func (server *BookingServer) WatchBookings(request *bookingv1.WatchBookingsRequest, stream bookingv1.BookingService_WatchBookingsServer) error {
+	if err := auth.AuthorizeWatch(stream.Context(), request.GetUserId(), request.GetGymId()); err != nil {
+		return toStatusError(err)
+	}
+
+	events, unsubscribe := server.subscriber.Subscribe(watchBufferSize)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			eventMessage := toBookingEventMessage(event)
+			if eventMessage == nil || !matchesWatchFilter(request, eventMessage) {
+				continue
+			}
+			if err := stream.Send(&bookingv1.WatchBookingsResponse{Event: eventMessage}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func matchesWatchFilter(request *bookingv1.WatchBookingsRequest, event *bookingv1.BookingEvent) bool {
+	if request.GetUserId() != "" && request.GetUserId() != event.GetUserId() {
+		return false
+	}
+	if request.GetGymId() != "" && request.GetGymId() != event.GetGymId() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/quota"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errorDomain = "booking.fitbook"
+
+// toStatusError maps domain errors onto gRPC status codes. The attached ErrorInfo reason
+// carries the same code the HTTP API returns, so clients can branch on it uniformly.
+func toStatusError(err error) error {
+	var validationErr *validator.ValidationError
+	var violationErr *policy.ViolationError
+	var exceededErr *quota.ExceededError
+	var suspendedErr *strike.SuspendedError
+	switch {
+	case errors.As(err, &validationErr):
+		return newValidationStatusError(validationErr)
+	case errors.As(err, &violationErr):
+		return newPolicyStatusError(violationErr)
+	case errors.As(err, &exceededErr):
+		return newQuotaStatusError(exceededErr)
+	case errors.As(err, &suspendedErr):
+		return newSuspendedStatusError(suspendedErr)
+	case errors.Is(err, membership.ErrNoActiveMembership):
+		return newStatusError(codes.FailedPrecondition, "NO_ACTIVE_MEMBERSHIP", err)
+	case errors.Is(err, policy.ErrInvalidPolicy):
+		return newStatusError(codes.InvalidArgument, "INVALID_POLICY", err)
+	case errors.Is(err, membership.ErrUnavailable):
+		// The cause names internal addresses.
+		return newStatusError(codes.Unavailable, "MEMBERSHIP_UNAVAILABLE", membership.ErrUnavailable)
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return newStatusError(codes.Unauthenticated, "UNAUTHENTICATED", err)
+	case errors.Is(err, auth.ErrForbidden):
+		return newStatusError(codes.PermissionDenied, "FORBIDDEN", err)
+	case errors.Is(err, booking.ErrInvalidTimeRange):
+		return newStatusError(codes.InvalidArgument, "INVALID_TIME_RANGE", err)
+	case errors.Is(err, booking.ErrPastBooking):
+		return newStatusError(codes.InvalidArgument, "PAST_BOOKING", err)
+	case errors.Is(err, booking.ErrOverlappingBooking):
+		return newStatusError(codes.AlreadyExists, "OVERLAPPING_BOOKING", err)
+	case errors.Is(err, booking.ErrBookingNotFound):
+		return newStatusError(codes.NotFound, "BOOKING_NOT_FOUND", err)
+	case errors.Is(err, booking.ErrBookingAlreadyCancelled):
+		return newStatusError(codes.FailedPrecondition, "BOOKING_ALREADY_CANCELLED", err)
+	case errors.Is(err, booking.ErrInvalidStatusTransition):
+		return newStatusError(codes.FailedPrecondition, "INVALID_STATUS_TRANSITION", err)
+	case errors.Is(err, booking.ErrBookingNotStarted):
+		return newStatusError(codes.FailedPrecondition, "BOOKING_NOT_STARTED", err)
+	case errors.Is(err, session.ErrSessionNotFound):
+		return newStatusError(codes.NotFound, "SESSION_NOT_FOUND", err)
+	case errors.Is(err, session.ErrInvalidSession),
+		errors.Is(err, session.ErrSessionMismatch):
+		return newStatusError(codes.InvalidArgument, "INVALID_SESSION", err)
+	case errors.Is(err, session.ErrSessionCancelled):
+		return newStatusError(codes.FailedPrecondition, "SESSION_CANCELLED", err)
+	case errors.Is(err, session.ErrSessionFull):
+		return newStatusError(codes.ResourceExhausted, "SESSION_FULL", err)
+	case errors.Is(err, session.ErrAlreadyEnrolled):
+		return newStatusError(codes.AlreadyExists, "ALREADY_ENROLLED", err)
+	case errors.Is(err, session.ErrCapacityBelowEnrollment),
+		errors.Is(err, session.ErrSessionHasEnrollments):
+		return newStatusError(codes.FailedPrecondition, "SESSION_HAS_ENROLLMENTS", err)
+	case errors.Is(err, timetable.ErrTemplateNotFound):
+		return newStatusError(codes.NotFound, "TEMPLATE_NOT_FOUND", err)
+	case errors.Is(err, timetable.ErrInvalidTemplate):
+		return newStatusError(codes.InvalidArgument, "INVALID_TEMPLATE", err)
+	case errors.Is(err, trainer.ErrTrainerNotFound):
+		return newStatusError(codes.NotFound, "TRAINER_NOT_FOUND", err)
+	case errors.Is(err, trainer.ErrTimeOffNotFound):
+		return newStatusError(codes.NotFound, "TIME_OFF_NOT_FOUND", err)
+	case errors.Is(err, trainer.ErrInvalidTrainer),
+		errors.Is(err, trainer.ErrInvalidTimeOff):
+		return newStatusError(codes.InvalidArgument, "INVALID_TRAINER", err)
+	case errors.Is(err, trainer.ErrTrainerUnavailable):
+		return newStatusError(codes.FailedPrecondition, "TRAINER_UNAVAILABLE", err)
+	case errors.Is(err, trainer.ErrTrainerBooked):
+		return newStatusError(codes.AlreadyExists, "TRAINER_BOOKED", err)
+	case errors.Is(err, resource.ErrResourceNotFound):
+		return newStatusError(codes.NotFound, "RESOURCE_NOT_FOUND", err)
+	case errors.Is(err, resource.ErrInvalidResource),
+		errors.Is(err, resource.ErrResourceMismatch):
+		return newStatusError(codes.InvalidArgument, "INVALID_RESOURCE", err)
+	case errors.Is(err, resource.ErrResourceOutOfService):
+		return newStatusError(codes.FailedPrecondition, "RESOURCE_OUT_OF_SERVICE", err)
+	case errors.Is(err, resource.ErrResourceFull):
+		return newStatusError(codes.ResourceExhausted, "RESOURCE_FULL", err)
+	case errors.Is(err, closure.ErrGymClosed):
+		return newStatusError(codes.FailedPrecondition, "GYM_CLOSED", err)
+	case errors.Is(err, closure.ErrClosureNotFound):
+		return newStatusError(codes.NotFound, "CLOSURE_NOT_FOUND", err)
+	case errors.Is(err, closure.ErrInvalidClosure):
+		return newStatusError(codes.InvalidArgument, "INVALID_CLOSURE", err)
+	case errors.Is(err, closure.ErrClosureHasBookings):
+		return newStatusError(codes.FailedPrecondition, "CLOSURE_HAS_BOOKINGS", err)
+	case errors.Is(err, booking.ErrInvalidInput),
+		errors.Is(err, booking.ErrInvalidStatus),
+		errors.Is(err, booking.ErrStatusReasonRequired):
+		return newStatusError(codes.InvalidArgument, "INVALID_INPUT", err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return newStatusError(codes.DeadlineExceeded, "REQUEST_TIMEOUT", err)
+	case errors.Is(err, context.Canceled):
+		return newStatusError(codes.Canceled, "REQUEST_CANCELLED", err)
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// newValidationStatusError reports each failing field as a google.rpc.BadRequest violation.
+func newValidationStatusError(validationErr *validator.ValidationError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(validationErr.Fields))
+	for i, field := range validationErr.Fields {
+		violations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       field.Field,
+			Description: field.Message,
+		}
+	}
+
+	st := status.New(codes.InvalidArgument, validationErr.Error())
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "VALIDATION_FAILED", Domain: errorDomain},
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// newPolicyStatusError reports each broken rule as a google.rpc.PreconditionFailure
+// violation whose type is the rule's code.
+func newPolicyStatusError(violationErr *policy.ViolationError) error {
+	violations := make([]*errdetails.PreconditionFailure_Violation, len(violationErr.Violations))
+	for i, violation := range violationErr.Violations {
+		violations[i] = &errdetails.PreconditionFailure_Violation{
+			Type:        string(violation.Rule),
+			Subject:     violation.Field,
+			Description: violation.Message,
+		}
+	}
+
+	st := status.New(codes.FailedPrecondition, violationErr.Error())
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "POLICY_VIOLATION", Domain: errorDomain},
+		&errdetails.PreconditionFailure{Violations: violations},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// newQuotaStatusError reports the exceeded quota as a google.rpc.QuotaFailure violation
+// whose subject is the membership tier.
+func newQuotaStatusError(exceededErr *quota.ExceededError) error {
+	st := status.New(codes.FailedPrecondition, exceededErr.Error())
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   "QUOTA_EXCEEDED",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"limit": string(exceededErr.Limit)},
+		},
+		&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     "tier:" + string(exceededErr.Tier),
+			Description: exceededErr.Message,
+		}}},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// newSuspendedStatusError carries the end of the suspension in the ErrorInfo metadata as
+// suspended_until, in RFC 3339.
+func newSuspendedStatusError(suspendedErr *strike.SuspendedError) error {
+	st := status.New(codes.FailedPrecondition, suspendedErr.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "BOOKING_SUSPENDED",
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"gym_id":          suspendedErr.GymID,
+			"suspended_until": suspendedErr.Until.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func newStatusError(code codes.Code, reason string, err error) error {
+	st := status.New(code, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
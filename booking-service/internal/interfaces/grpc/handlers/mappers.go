@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	bookingv1 "github.com/yourusername/fitbook/booking-service/api/gen/booking/v1"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toCreateBookingDTO(request *bookingv1.CreateBookingRequest) *dtos.CreateBookingDTO {
+	return &dtos.CreateBookingDTO{
+		UserID:     request.GetUserId(),
+		GymID:      request.GetGymId(),
+		StartTime:  formatTimestamp(request.GetStartTime()),
+		EndTime:    formatTimestamp(request.GetEndTime()),
+		SessionID:  request.GetSessionId(),
+		TrainerID:  request.GetTrainerId(),
+		ResourceID: request.GetResourceId(),
+	}
+}
+
+func toBookingMessage(dto *dtos.BookingDTO) (*bookingv1.Booking, error) {
+	startTime, err := parseTimestamp(dto.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := parseTimestamp(dto.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := parseTimestamp(dto.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := parseTimestamp(dto.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingv1.Booking{
+		Id:            dto.ID,
+		UserId:        dto.UserID,
+		GymId:         dto.GymID,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Status:        toStatusMessage(booking.BookingStatus(dto.Status)),
+		Duration:      int32(dto.Duration),
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+		CancelledLate: dto.CancelledLate,
+		SessionId:     dto.SessionID,
+		TrainerId:     dto.TrainerID,
+		ResourceId:    dto.ResourceID,
+		FlagReason:    dto.FlagReason,
+	}, nil
+}
+
+func toBookingEventMessage(event booking.Event) *bookingv1.BookingEvent {
+	var base booking.BaseBookingEvent
+	switch typed := event.(type) {
+	case booking.BookingCreatedEvent:
+		base = typed.BaseBookingEvent
+	case booking.BookingCancelledEvent:
+		base = typed.BaseBookingEvent
+	case booking.BookingConfirmedEvent:
+		base = typed.BaseBookingEvent
+	case booking.BookingCompletedEvent:
+		base = typed.BaseBookingEvent
+	case booking.BookingNoShowEvent:
+		base = typed.BaseBookingEvent
+	case booking.BookingRescheduledEvent:
+		base = typed.BaseBookingEvent
+	case booking.BookingStatusChangedEvent:
+		base = typed.BaseBookingEvent
+	default:
+		return nil
+	}
+
+	return &bookingv1.BookingEvent{
+		Name:       event.EventName(),
+		OccurredAt: timestamppb.New(event.OccurredAt()),
+		BookingId:  base.BookingID,
+		UserId:     base.UserID,
+		GymId:      base.GymID,
+		StartTime:  timestamppb.New(base.StartTime),
+		EndTime:    timestamppb.New(base.EndTime),
+		Status:     toStatusMessage(base.Status),
+	}
+}
+
+func toStatusMessage(status booking.BookingStatus) bookingv1.BookingStatus {
+	switch status {
+	case booking.StatusPending:
+		return bookingv1.BookingStatus_BOOKING_STATUS_PENDING
+	case booking.StatusConfirmed:
+		return bookingv1.BookingStatus_BOOKING_STATUS_CONFIRMED
+	case booking.StatusCancelled:
+		return bookingv1.BookingStatus_BOOKING_STATUS_CANCELLED
+	case booking.StatusCompleted:
+		return bookingv1.BookingStatus_BOOKING_STATUS_COMPLETED
+	case booking.StatusNoShow:
+		return bookingv1.BookingStatus_BOOKING_STATUS_NO_SHOW
+	default:
+		return bookingv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED
+	}
+}
+
+// formatTimestamp renders a timestamp the way the HTTP API receives it, leaving it empty when
+// unset so the command handlers report the missing field exactly as they do for JSON requests.
+func formatTimestamp(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	return timestamp.AsTime().Format(time.RFC3339)
+}
+
+func parseTimestamp(value string) (*timestamppb.Timestamp, error) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp %q: %w", value, err)
+	}
+	return timestamppb.New(parsed), nil
+}
+
+func toTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsRecorder receives the outcome of every call.
+type MetricsRecorder interface {
+	ObserveGRPCRequest(method, code string, duration time.Duration)
+	RecordError(code, transport string)
+}
+
+// UnaryMetricsInterceptor records the duration and status code of every call, and the API
+// error code carried in the ErrorInfo detail of failed ones.
+func UnaryMetricsInterceptor(recorder MetricsRecorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		response, err := handler(ctx, request)
+		observeCall(recorder, info.FullMethod, start, err)
+		return response, err
+	}
+}
+
+func StreamMetricsInterceptor(recorder MetricsRecorder) grpc.StreamServerInterceptor {
+	return func(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(server, stream)
+		observeCall(recorder, info.FullMethod, start, err)
+		return err
+	}
+}
+
+func observeCall(recorder MetricsRecorder, method string, start time.Time, err error) {
+	st := status.Convert(err)
+	recorder.ObserveGRPCRequest(method, st.Code().String(), time.Since(start))
+	if err == nil {
+		return
+	}
+
+	code := "INTERNAL_ERROR"
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain {
+			code = info.Reason
+			break
+		}
+	}
+	recorder.RecordError(code, "grpc")
+}
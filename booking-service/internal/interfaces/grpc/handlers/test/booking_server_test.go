@@ -0,0 +1,181 @@
+package test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bookingv1 "github.com/yourusername/fitbook/booking-service/api/gen/booking/v1"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking/test/mocks"
+	"github.com/yourusername/fitbook/booking-service/internal/infrastructure/events"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/grpc/handlers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestClient(t *testing.T) bookingv1.BookingServiceClient {
+	repo := mocks.NewMockRepository()
+	broadcaster := events.NewBroadcaster(mocks.NewMockEventPublisher())
+
+	server := handlers.NewBookingServer(
+		commands.NewCreateBookingHandler(repo, broadcaster, mocks.NewLogger()),
+		queries.NewGetBookingHandler(repo),
+		queries.NewListBookingsHandler(repo),
+		commands.NewCancelBookingHandler(repo, broadcaster, mocks.NewLogger(), nil),
+		commands.NewConfirmBookingHandler(repo, broadcaster, mocks.NewLogger()),
+		commands.NewCompleteBookingHandler(repo, broadcaster, mocks.NewLogger()),
+		broadcaster,
+	)
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	bookingv1.RegisterBookingServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return bookingv1.NewBookingServiceClient(conn)
+}
+
+func TestBookingServer(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	created, err := client.CreateBooking(ctx, &bookingv1.CreateBookingRequest{
+		UserId:    "user1",
+		GymId:     "gym1",
+		StartTime: timestamppb.New(now.Add(time.Hour)),
+		EndTime:   timestamppb.New(now.Add(2 * time.Hour)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, bookingv1.BookingStatus_BOOKING_STATUS_PENDING, created.GetBooking().GetStatus())
+	assert.Equal(t, int32(60), created.GetBooking().GetDuration())
+
+	fetched, err := client.GetBooking(ctx, &bookingv1.GetBookingRequest{BookingId: created.GetBooking().GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, created.GetBooking().GetId(), fetched.GetBooking().GetId())
+
+	_, err = client.ConfirmBooking(ctx, &bookingv1.ConfirmBookingRequest{BookingId: created.GetBooking().GetId()})
+	require.NoError(t, err)
+
+	t.Run("domain errors map to status codes", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			call       func() error
+			wantCode   codes.Code
+			wantReason string
+		}{
+			{
+				name: "overlapping booking",
+				call: func() error {
+					_, err := client.CreateBooking(ctx, &bookingv1.CreateBookingRequest{
+						UserId:    "user2",
+						GymId:     "gym1",
+						StartTime: timestamppb.New(now.Add(90 * time.Minute)),
+						EndTime:   timestamppb.New(now.Add(3 * time.Hour)),
+					})
+					return err
+				},
+				wantCode:   codes.AlreadyExists,
+				wantReason: "OVERLAPPING_BOOKING",
+			},
+			{
+				name: "missing start time",
+				call: func() error {
+					_, err := client.CreateBooking(ctx, &bookingv1.CreateBookingRequest{UserId: "user1", GymId: "gym1"})
+					return err
+				},
+				wantCode:   codes.InvalidArgument,
+				wantReason: "VALIDATION_FAILED",
+			},
+			{
+				name: "booking not found",
+				call: func() error {
+					_, err := client.GetBooking(ctx, &bookingv1.GetBookingRequest{BookingId: "missing"})
+					return err
+				},
+				wantCode:   codes.NotFound,
+				wantReason: "BOOKING_NOT_FOUND",
+			},
+			{
+				name: "invalid transition",
+				call: func() error {
+					_, err := client.ConfirmBooking(ctx, &bookingv1.ConfirmBookingRequest{BookingId: created.GetBooking().GetId()})
+					return err
+				},
+				wantCode:   codes.FailedPrecondition,
+				wantReason: "INVALID_STATUS_TRANSITION",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				st, ok := status.FromError(test.call())
+				require.True(t, ok)
+				assert.Equal(t, test.wantCode, st.Code())
+				require.NotEmpty(t, st.Details())
+				info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				require.True(t, ok)
+				assert.Equal(t, test.wantReason, info.GetReason())
+			})
+		}
+	})
+}
+
+func TestWatchBookings(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchBookings(ctx, &bookingv1.WatchBookingsRequest{GymId: "gym2"})
+	require.NoError(t, err)
+
+	// The subscription is registered asynchronously, so keep creating bookings until one arrives.
+	received := make(chan *bookingv1.BookingEvent, 1)
+	go func() {
+		response, err := stream.Recv()
+		if err == nil {
+			received <- response.GetEvent()
+		}
+	}()
+
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+	for i := 0; ; i++ {
+		_, err := client.CreateBooking(ctx, &bookingv1.CreateBookingRequest{
+			UserId:    "user1",
+			GymId:     "gym2",
+			StartTime: timestamppb.New(start.Add(time.Duration(i) * time.Hour)),
+			EndTime:   timestamppb.New(start.Add(time.Duration(i)*time.Hour + 30*time.Minute)),
+		})
+		require.NoError(t, err)
+
+		select {
+		case event := <-received:
+			assert.Equal(t, "booking.created", event.GetName())
+			assert.Equal(t, "gym2", event.GetGymId())
+			return
+		case <-time.After(50 * time.Millisecond):
+		case <-ctx.Done():
+			t.Fatal("no event received")
+		}
+	}
+}
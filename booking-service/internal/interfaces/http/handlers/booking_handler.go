@@ -1,58 +1,96 @@
 package handlers
 
 import (
-	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
 	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
 	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
 )
 
 type BookingHandler struct {
 	createHandler   *commands.CreateBookingHandler
+	batchHandler    *commands.CreateBookingsBatchHandler
 	getHandler      *queries.GetBookingHandler
 	listHandler     *queries.ListBookingsHandler
 	cancelHandler   *commands.CancelBookingHandler
 	confirmHandler  *commands.ConfirmBookingHandler
 	completeHandler *commands.CompleteBookingHandler
+	noShowHandler   *commands.MarkNoShowHandler
+	historyHandler  *queries.GetBookingHistoryHandler
+	forceHandler    *commands.ForceBookingStatusHandler
+	moveHandler     *commands.RescheduleBookingHandler
 }
 
-func NewBookingHandler(
-	createHandler *commands.CreateBookingHandler,
-	getHandler *queries.GetBookingHandler,
-	listHandler *queries.ListBookingsHandler,
-	cancelHandler *commands.CancelBookingHandler,
-	confirmHandler *commands.ConfirmBookingHandler,
-	completeHandler *commands.CompleteBookingHandler,
-) *BookingHandler {
+// BookingHandlers are the commands and queries BookingHandler serves.
+type BookingHandlers struct {
+	Create     *commands.CreateBookingHandler
+	Batch      *commands.CreateBookingsBatchHandler
+	Get        *queries.GetBookingHandler
+	List       *queries.ListBookingsHandler
+	Cancel     *commands.CancelBookingHandler
+	Confirm    *commands.ConfirmBookingHandler
+	Complete   *commands.CompleteBookingHandler
+	NoShow     *commands.MarkNoShowHandler
+	History    *queries.GetBookingHistoryHandler
+	Force      *commands.ForceBookingStatusHandler
+	Reschedule *commands.RescheduleBookingHandler
+}
+
+func NewBookingHandler(bookingHandlers BookingHandlers) *BookingHandler {
 	return &BookingHandler{
-		createHandler:   createHandler,
-		getHandler:      getHandler,
-		listHandler:     listHandler,
-		cancelHandler:   cancelHandler,
-		confirmHandler:  confirmHandler,
-		completeHandler: completeHandler,
+		createHandler:   bookingHandlers.Create,
+		batchHandler:    bookingHandlers.Batch,
+		getHandler:      bookingHandlers.Get,
+		listHandler:     bookingHandlers.List,
+		cancelHandler:   bookingHandlers.Cancel,
+		confirmHandler:  bookingHandlers.Confirm,
+		completeHandler: bookingHandlers.Complete,
+		noShowHandler:   bookingHandlers.NoShow,
+		historyHandler:  bookingHandlers.History,
+		forceHandler:    bookingHandlers.Force,
+		moveHandler:     bookingHandlers.Reschedule,
 	}
 }
 
 func (handler *BookingHandler) CreateBooking(writer http.ResponseWriter, request *http.Request) {
 	var dto dtos.CreateBookingDTO
-	if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
-		writeBadRequest(writer, "Invalid request body", err.Error())
+	if !decodeJSON(writer, request, &dto) {
 		return
 	}
 
 	result, err := handler.createHandler.Handle(request.Context(), commands.CreateBookingCommand{DTO: &dto})
 	if err != nil {
-		handleBookingError(writer, err)
+		handleBookingError(writer, request, err)
 		return
 	}
 
 	writeJSON(writer, http.StatusCreated, result.Booking)
 }
 
+func (handler *BookingHandler) CreateBookingsBatch(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.BatchCreateBookingsDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.batchHandler.Handle(request.Context(), commands.CreateBookingsBatchCommand{DTO: &dto})
+	var rejected *commands.BatchRejectedError
+	if errors.As(err, &rejected) {
+		writeBatchRejected(writer, rejected)
+		return
+	}
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusCreated, result.Bookings)
+}
+
 func (handler *BookingHandler) GetBooking(writer http.ResponseWriter, request *http.Request) {
 	bookingID := request.PathValue("id")
 	if bookingID == "" {
@@ -62,7 +100,66 @@ func (handler *BookingHandler) GetBooking(writer http.ResponseWriter, request *h
 
 	result, err := handler.getHandler.Handle(request.Context(), queries.GetBookingQuery{BookingID: bookingID})
 	if err != nil {
-		handleBookingError(writer, err)
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Booking)
+}
+
+func (handler *BookingHandler) GetBookingHistory(writer http.ResponseWriter, request *http.Request) {
+	bookingID := request.PathValue("id")
+	if bookingID == "" {
+		writeBadRequest(writer, "Booking ID is required")
+		return
+	}
+
+	result, err := handler.historyHandler.Handle(request.Context(), queries.GetBookingHistoryQuery{BookingID: bookingID})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.History)
+}
+
+func (handler *BookingHandler) ForceBookingStatus(writer http.ResponseWriter, request *http.Request) {
+	bookingID := request.PathValue("id")
+	if bookingID == "" {
+		writeBadRequest(writer, "Booking ID is required")
+		return
+	}
+
+	var dto dtos.ForceStatusDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	err := handler.forceHandler.Handle(request.Context(), commands.ForceBookingStatusCommand{
+		BookingID: bookingID,
+		Status:    booking.BookingStatus(dto.Status),
+		Reason:    dto.Reason,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, nil)
+}
+
+func (handler *BookingHandler) RescheduleBooking(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.RescheduleBookingDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.moveHandler.Handle(request.Context(), commands.RescheduleBookingCommand{
+		BookingID: request.PathValue("id"),
+		DTO:       &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
 		return
 	}
 
@@ -78,7 +175,7 @@ func (handler *BookingHandler) ConfirmBooking(writer http.ResponseWriter, reques
 
 	err := handler.confirmHandler.Handle(request.Context(), commands.ConfirmBookingCommand{BookingID: bookingID})
 	if err != nil {
-		handleBookingError(writer, err)
+		handleBookingError(writer, request, err)
 		return
 	}
 
@@ -94,7 +191,23 @@ func (handler *BookingHandler) CompleteBooking(writer http.ResponseWriter, reque
 
 	err := handler.completeHandler.Handle(request.Context(), commands.CompleteBookingCommand{BookingID: bookingID})
 	if err != nil {
-		handleBookingError(writer, err)
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, nil)
+}
+
+func (handler *BookingHandler) MarkNoShow(writer http.ResponseWriter, request *http.Request) {
+	bookingID := request.PathValue("id")
+	if bookingID == "" {
+		writeBadRequest(writer, "Booking ID is required")
+		return
+	}
+
+	err := handler.noShowHandler.Handle(request.Context(), commands.MarkNoShowCommand{BookingID: bookingID})
+	if err != nil {
+		handleBookingError(writer, request, err)
 		return
 	}
 
@@ -103,8 +216,7 @@ func (handler *BookingHandler) CompleteBooking(writer http.ResponseWriter, reque
 
 func (handler *BookingHandler) ListBookings(writer http.ResponseWriter, request *http.Request) {
 	var dto dtos.CreateBookingDTO
-	if err := json.NewDecoder(request.Body).Decode(&dto); err != nil {
-		writeBadRequest(writer, "Invalid request body")
+	if !decodeJSON(writer, request, &dto) {
 		return
 	}
 
@@ -133,7 +245,7 @@ func (handler *BookingHandler) ListBookings(writer http.ResponseWriter, request
 	})
 
 	if err != nil {
-		writeInternalError(writer)
+		handleBookingError(writer, request, err)
 		return
 	}
 
@@ -147,9 +259,12 @@ func (handler *BookingHandler) CancelBooking(writer http.ResponseWriter, request
 		return
 	}
 
-	err := handler.cancelHandler.Handle(request.Context(), commands.CancelBookingCommand{BookingID: bookingID})
+	err := handler.cancelHandler.Handle(request.Context(), commands.CancelBookingCommand{
+		BookingID: bookingID,
+		Reason:    request.URL.Query().Get("reason"),
+	})
 	if err != nil {
-		handleBookingError(writer, err)
+		handleBookingError(writer, request, err)
 		return
 	}
 
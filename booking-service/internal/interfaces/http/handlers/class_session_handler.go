@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+)
+
+// ClassSessionHandler serves instructor-led class sessions and enrollment in them.
+type ClassSessionHandler struct {
+	createHandler *commands.CreateClassSessionHandler
+	updateHandler *commands.UpdateClassSessionHandler
+	cancelHandler *commands.CancelClassSessionHandler
+	enrollHandler *commands.EnrollHandler
+	getHandler    *queries.GetClassSessionHandler
+	listHandler   *queries.ListClassSessionsHandler
+}
+
+func NewClassSessionHandler(
+	createHandler *commands.CreateClassSessionHandler,
+	updateHandler *commands.UpdateClassSessionHandler,
+	cancelHandler *commands.CancelClassSessionHandler,
+	enrollHandler *commands.EnrollHandler,
+	getHandler *queries.GetClassSessionHandler,
+	listHandler *queries.ListClassSessionsHandler,
+) *ClassSessionHandler {
+	return &ClassSessionHandler{
+		createHandler: createHandler,
+		updateHandler: updateHandler,
+		cancelHandler: cancelHandler,
+		enrollHandler: enrollHandler,
+		getHandler:    getHandler,
+		listHandler:   listHandler,
+	}
+}
+
+func (handler *ClassSessionHandler) CreateSession(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.CreateClassSessionDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.createHandler.Handle(request.Context(), commands.CreateClassSessionCommand{DTO: &dto})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusCreated, result.Session)
+}
+
+func (handler *ClassSessionHandler) ListSessions(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	result, err := handler.listHandler.Handle(request.Context(), queries.ListClassSessionsQuery{DTO: &dtos.ListClassSessionsDTO{
+		GymID: query.Get("gym_id"),
+		From:  query.Get("from"),
+		To:    query.Get("to"),
+	}})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Sessions)
+}
+
+func (handler *ClassSessionHandler) GetSession(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.getHandler.Handle(request.Context(), queries.GetClassSessionQuery{SessionID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Session)
+}
+
+func (handler *ClassSessionHandler) UpdateSession(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.UpdateClassSessionDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.updateHandler.Handle(request.Context(), commands.UpdateClassSessionCommand{
+		SessionID: request.PathValue("id"),
+		DTO:       &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Session)
+}
+
+func (handler *ClassSessionHandler) CancelSession(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.cancelHandler.Handle(request.Context(), commands.CancelClassSessionCommand{
+		SessionID: request.PathValue("id"),
+		Reason:    request.URL.Query().Get("reason"),
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Cancelled)
+}
+
+func (handler *ClassSessionHandler) Enroll(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.EnrollDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.enrollHandler.Handle(request.Context(), commands.EnrollCommand{
+		SessionID: request.PathValue("id"),
+		DTO:       &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusCreated, result.Booking)
+}
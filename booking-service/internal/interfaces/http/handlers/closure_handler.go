@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+)
+
+// ClosureHandler serves the periods gyms are closed, entered one at a time or imported from
+// an iCalendar file.
+type ClosureHandler struct {
+	createHandler *commands.CreateClosureHandler
+	importHandler *commands.ImportClosuresHandler
+	deleteHandler *commands.DeleteClosureHandler
+	listHandler   *queries.ListClosuresHandler
+}
+
+func NewClosureHandler(
+	createHandler *commands.CreateClosureHandler,
+	importHandler *commands.ImportClosuresHandler,
+	deleteHandler *commands.DeleteClosureHandler,
+	listHandler *queries.ListClosuresHandler,
+) *ClosureHandler {
+	return &ClosureHandler{
+		createHandler: createHandler,
+		importHandler: importHandler,
+		deleteHandler: deleteHandler,
+		listHandler:   listHandler,
+	}
+}
+
+func (handler *ClosureHandler) CreateClosure(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.CreateClosureDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.createHandler.Handle(request.Context(), commands.CreateClosureCommand{
+		GymID: request.PathValue("id"),
+		DTO:   &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeClosuresResult(writer, result.Result)
+}
+
+func (handler *ClosureHandler) ImportClosures(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.ImportClosuresDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.importHandler.Handle(request.Context(), commands.ImportClosuresCommand{
+		GymID: request.PathValue("id"),
+		DTO:   &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeClosuresResult(writer, result.Result)
+}
+
+func (handler *ClosureHandler) ListClosures(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.listHandler.Handle(request.Context(), queries.ListClosuresQuery{GymID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Closures)
+}
+
+func (handler *ClosureHandler) DeleteClosure(writer http.ResponseWriter, request *http.Request) {
+	err := handler.deleteHandler.Handle(request.Context(), commands.DeleteClosureCommand{ClosureID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, nil)
+}
+
+// writeClosuresResult answers 201 for created closures and 200 for a dry run, which
+// creates nothing.
+func writeClosuresResult(writer http.ResponseWriter, result *dtos.ClosuresResultDTO) {
+	if result.DryRun {
+		writeJSON(writer, http.StatusOK, result)
+		return
+	}
+	writeJSON(writer, http.StatusCreated, result)
+}
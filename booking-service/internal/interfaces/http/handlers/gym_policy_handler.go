@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+)
+
+// GymPolicyHandler serves the booking rules of gyms and previews of how they apply to a
+// proposed booking.
+type GymPolicyHandler struct {
+	getHandler     *queries.GetGymPolicyHandler
+	setHandler     *commands.SetGymPolicyHandler
+	previewHandler *queries.PreviewBookingPolicyHandler
+}
+
+func NewGymPolicyHandler(
+	getHandler *queries.GetGymPolicyHandler,
+	setHandler *commands.SetGymPolicyHandler,
+	previewHandler *queries.PreviewBookingPolicyHandler,
+) *GymPolicyHandler {
+	return &GymPolicyHandler{
+		getHandler:     getHandler,
+		setHandler:     setHandler,
+		previewHandler: previewHandler,
+	}
+}
+
+func (handler *GymPolicyHandler) GetGymPolicy(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.getHandler.Handle(request.Context(), queries.GetGymPolicyQuery{GymID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Policy)
+}
+
+func (handler *GymPolicyHandler) SetGymPolicy(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.SetGymPolicyDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.setHandler.Handle(request.Context(), commands.SetGymPolicyCommand{
+		GymID: request.PathValue("id"),
+		DTO:   &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Policy)
+}
+
+func (handler *GymPolicyHandler) PreviewBookingPolicy(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.CreateBookingDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.previewHandler.Handle(request.Context(), queries.PreviewBookingPolicyQuery{DTO: &dto})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Preview)
+}
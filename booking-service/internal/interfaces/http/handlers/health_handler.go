@@ -1,19 +1,52 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
 )
 
-type HealthHandler struct{}
+// ReadinessChecker reports the status of the service's dependencies.
+type ReadinessChecker interface {
+	Check(ctx context.Context) *dtos.HealthDTO
+}
 
-func NewHealthHandler() *HealthHandler {
-	return &HealthHandler{}
+type HealthHandler struct {
+	readiness ReadinessChecker
 }
 
-func (handler *HealthHandler) Check(writer http.ResponseWriter, request *http.Request) {
-	response := map[string]string{
-		"status": "ok",
+func NewHealthHandler(readiness ReadinessChecker) *HealthHandler {
+	return &HealthHandler{
+		readiness: readiness,
 	}
-	json.NewEncoder(writer).Encode(response)
+}
+
+// Live reports that the process is up and serving requests. It checks no dependencies, so
+// an orchestrator never restarts the service because a database is down.
+func (handler *HealthHandler) Live(writer http.ResponseWriter, request *http.Request) {
+	writeHealth(writer, http.StatusOK, &dtos.HealthDTO{Status: dtos.HealthStatusOK})
+}
+
+// Ready reports the status of each dependency and returns 503 when a required one is down.
+func (handler *HealthHandler) Ready(writer http.ResponseWriter, request *http.Request) {
+	if handler.readiness == nil {
+		writeHealth(writer, http.StatusOK, &dtos.HealthDTO{Status: dtos.HealthStatusOK})
+		return
+	}
+
+	report := handler.readiness.Check(request.Context())
+	status := http.StatusOK
+	if report.Status == dtos.HealthStatusDown {
+		status = http.StatusServiceUnavailable
+	}
+	writeHealth(writer, status, report)
+}
+
+func writeHealth(writer http.ResponseWriter, status int, report *dtos.HealthDTO) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(report)
 }
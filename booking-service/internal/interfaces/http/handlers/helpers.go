@@ -1,13 +1,40 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 
+	"github.com/yourusername/fitbook/booking-service/internal/application/auth"
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
 	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/validator"
 	"github.com/yourusername/fitbook/booking-service/internal/domain/booking"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/closure"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/membership"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/policy"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/quota"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/resource"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/session"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/strike"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/timetable"
+	"github.com/yourusername/fitbook/booking-service/internal/domain/trainer"
 )
 
+// ErrorCodeRecorder is implemented by response writers that want to know which API error
+// code a response carried, such as the router's instrumentation.
+type ErrorCodeRecorder interface {
+	RecordErrorCode(code string)
+}
+
+func recordErrorCode(writer http.ResponseWriter, code string) {
+	if recorder, ok := writer.(ErrorCodeRecorder); ok {
+		recorder.RecordErrorCode(code)
+	}
+}
+
 func writeJSON(writer http.ResponseWriter, status int, data interface{}) {
 	writer.Header().Set("Content-Type", "application/json")
 	writer.WriteHeader(status)
@@ -15,6 +42,7 @@ func writeJSON(writer http.ResponseWriter, status int, data interface{}) {
 }
 
 func writeError(writer http.ResponseWriter, status int, code, message string, details ...string) {
+	recordErrorCode(writer, code)
 	writer.Header().Set("Content-Type", "application/json")
 	writer.WriteHeader(status)
 	json.NewEncoder(writer).Encode(dtos.Response{
@@ -27,27 +55,213 @@ func writeBadRequest(writer http.ResponseWriter, message string, details ...stri
 	writeError(writer, http.StatusBadRequest, "INVALID_REQUEST", message, details...)
 }
 
+// decodeJSON decodes the request body into dto, rejecting unknown fields and bodies over the
+// router's size limit. It writes the error response and returns false if the body is unusable.
+func decodeJSON(writer http.ResponseWriter, request *http.Request, dto interface{}) bool {
+	decoder := json.NewDecoder(request.Body)
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(dto)
+	if err == nil {
+		return true
+	}
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeError(writer, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE",
+			fmt.Sprintf("Request body exceeds %d bytes", tooLarge.Limit))
+		return false
+	}
+	writeBadRequest(writer, "Invalid request body", err.Error())
+	return false
+}
+
 func writeInternalError(writer http.ResponseWriter) {
 	writeError(writer, http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error")
 }
 
-func handleBookingError(writer http.ResponseWriter, err error) {
-	switch err {
-	case booking.ErrInvalidTimeRange:
-		writeError(writer, http.StatusBadRequest, "INVALID_TIME_RANGE", err.Error())
-	case booking.ErrPastBooking:
-		writeError(writer, http.StatusBadRequest, "PAST_BOOKING", err.Error())
-	case booking.ErrOverlappingBooking:
-		writeError(writer, http.StatusConflict, "OVERLAPPING_BOOKING", err.Error())
-	case booking.ErrBookingNotFound:
-		writeError(writer, http.StatusNotFound, "BOOKING_NOT_FOUND", err.Error())
-	case booking.ErrBookingAlreadyCancelled:
-		writeError(writer, http.StatusBadRequest, "BOOKING_ALREADY_CANCELLED", err.Error())
-	case booking.ErrInvalidStatusTransition:
-		writeError(writer, http.StatusBadRequest, "INVALID_STATUS_TRANSITION", err.Error())
-	case booking.ErrInvalidInput:
-		writeError(writer, http.StatusBadRequest, "INVALID_INPUT", err.Error())
-	default:
+// writeBatchRejected reports every rejected item of a batch. The items are returned in the
+// data field so clients can match them to their request by index.
+func writeBatchRejected(writer http.ResponseWriter, rejected *commands.BatchRejectedError) {
+	items := make([]dtos.BatchItemErrorDTO, len(rejected.Items))
+	for i, item := range rejected.Items {
+		_, code, known := bookingErrorCode(item.Err)
+		message := item.Err.Error()
+		if !known {
+			message = "Internal server error"
+		}
+		items[i] = dtos.BatchItemErrorDTO{Index: item.Index, Code: code, Message: message}
+
+		var validationErr *validator.ValidationError
+		var violationErr *policy.ViolationError
+		var exceededErr *quota.ExceededError
+		switch {
+		case errors.As(item.Err, &validationErr):
+			items[i].Fields = validationErr.Fields
+		case errors.As(item.Err, &violationErr):
+			items[i].Fields = dtos.FromViolations(violationErr.Violations)
+		case errors.As(item.Err, &exceededErr):
+			items[i].Fields = dtos.FromQuotaExceeded(exceededErr)
+		}
+	}
+
+	recordErrorCode(writer, "BATCH_REJECTED")
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusUnprocessableEntity)
+	json.NewEncoder(writer).Encode(dtos.Response{
+		Success: false,
+		Data:    items,
+		Error:   dtos.NewErrorDTO("BATCH_REJECTED", rejected.Error()),
+	})
+}
+
+func writeValidationError(writer http.ResponseWriter, validationErr *validator.ValidationError) {
+	writeFieldErrors(writer, "VALIDATION_FAILED", "Request validation failed", validationErr.Fields)
+}
+
+// writePolicyViolation lists every rule of the gym's policy the booking breaks, in the
+// shape of field errors with the rule as the code.
+func writePolicyViolation(writer http.ResponseWriter, violationErr *policy.ViolationError) {
+	writeFieldErrors(writer, "POLICY_VIOLATION", "Booking violates the gym's booking policy", dtos.FromViolations(violationErr.Violations))
+}
+
+func writeFieldErrors(writer http.ResponseWriter, code, message string, fields []*dtos.ValidationErrorDTO) {
+	errorDTO := dtos.NewErrorDTO(code, message)
+	errorDTO.Fields = fields
+
+	recordErrorCode(writer, code)
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusUnprocessableEntity)
+	json.NewEncoder(writer).Encode(dtos.Response{
+		Success: false,
+		Error:   errorDTO,
+	})
+}
+
+// handleBookingError writes the response for an error returned by a command or query. Errors
+// it does not know are reported as a timeout if the request ran out of time, since the
+// database drivers do not always return context.DeadlineExceeded itself.
+func handleBookingError(writer http.ResponseWriter, request *http.Request, err error) {
+	var validationErr *validator.ValidationError
+	if errors.As(err, &validationErr) {
+		writeValidationError(writer, validationErr)
+		return
+	}
+	var violationErr *policy.ViolationError
+	if errors.As(err, &violationErr) {
+		writePolicyViolation(writer, violationErr)
+		return
+	}
+	var exceededErr *quota.ExceededError
+	if errors.As(err, &exceededErr) {
+		writeFieldErrors(writer, "QUOTA_EXCEEDED", "Booking exceeds the member's quota", dtos.FromQuotaExceeded(exceededErr))
+		return
+	}
+
+	status, code, known := bookingErrorCode(err)
+	switch {
+	case code == "REQUEST_TIMEOUT",
+		!known && errors.Is(request.Context().Err(), context.DeadlineExceeded):
+		writeError(writer, http.StatusServiceUnavailable, "REQUEST_TIMEOUT", "The request did not complete in time")
+	case code == "MEMBERSHIP_UNAVAILABLE":
+		// The cause names internal addresses.
+		writeError(writer, status, code, "Memberships cannot be checked right now")
+	case !known:
 		writeInternalError(writer)
+	default:
+		writeError(writer, status, code, err.Error())
+	}
+}
+
+// bookingErrorCode maps a domain error to its HTTP status and API error code.
+func bookingErrorCode(err error) (int, string, bool) {
+	var validationErr *validator.ValidationError
+	var violationErr *policy.ViolationError
+	switch {
+	case errors.As(err, &validationErr):
+		return http.StatusUnprocessableEntity, "VALIDATION_FAILED", true
+	case errors.As(err, &violationErr):
+		return http.StatusUnprocessableEntity, "POLICY_VIOLATION", true
+	case errors.Is(err, quota.ErrQuotaExceeded):
+		return http.StatusUnprocessableEntity, "QUOTA_EXCEEDED", true
+	case errors.Is(err, membership.ErrNoActiveMembership):
+		return http.StatusUnprocessableEntity, "NO_ACTIVE_MEMBERSHIP", true
+	case errors.Is(err, strike.ErrBookingSuspended):
+		return http.StatusUnprocessableEntity, "BOOKING_SUSPENDED", true
+	case errors.Is(err, membership.ErrUnavailable):
+		return http.StatusServiceUnavailable, "MEMBERSHIP_UNAVAILABLE", true
+	case errors.Is(err, policy.ErrInvalidPolicy):
+		return http.StatusBadRequest, "INVALID_POLICY", true
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return http.StatusUnauthorized, "UNAUTHENTICATED", true
+	case errors.Is(err, auth.ErrForbidden):
+		return http.StatusForbidden, "FORBIDDEN", true
+	case errors.Is(err, booking.ErrInvalidTimeRange):
+		return http.StatusBadRequest, "INVALID_TIME_RANGE", true
+	case errors.Is(err, booking.ErrPastBooking):
+		return http.StatusBadRequest, "PAST_BOOKING", true
+	case errors.Is(err, booking.ErrOverlappingBooking):
+		return http.StatusConflict, "OVERLAPPING_BOOKING", true
+	case errors.Is(err, booking.ErrBookingNotFound):
+		return http.StatusNotFound, "BOOKING_NOT_FOUND", true
+	case errors.Is(err, booking.ErrBookingAlreadyCancelled):
+		return http.StatusBadRequest, "BOOKING_ALREADY_CANCELLED", true
+	case errors.Is(err, booking.ErrInvalidStatusTransition):
+		return http.StatusBadRequest, "INVALID_STATUS_TRANSITION", true
+	case errors.Is(err, booking.ErrBookingNotStarted):
+		return http.StatusBadRequest, "BOOKING_NOT_STARTED", true
+	case errors.Is(err, session.ErrSessionNotFound):
+		return http.StatusNotFound, "SESSION_NOT_FOUND", true
+	case errors.Is(err, session.ErrInvalidSession),
+		errors.Is(err, session.ErrSessionMismatch):
+		return http.StatusBadRequest, "INVALID_SESSION", true
+	case errors.Is(err, session.ErrSessionCancelled):
+		return http.StatusBadRequest, "SESSION_CANCELLED", true
+	case errors.Is(err, session.ErrSessionFull):
+		return http.StatusConflict, "SESSION_FULL", true
+	case errors.Is(err, session.ErrAlreadyEnrolled):
+		return http.StatusConflict, "ALREADY_ENROLLED", true
+	case errors.Is(err, session.ErrCapacityBelowEnrollment),
+		errors.Is(err, session.ErrSessionHasEnrollments):
+		return http.StatusConflict, "SESSION_HAS_ENROLLMENTS", true
+	case errors.Is(err, timetable.ErrTemplateNotFound):
+		return http.StatusNotFound, "TEMPLATE_NOT_FOUND", true
+	case errors.Is(err, timetable.ErrInvalidTemplate):
+		return http.StatusBadRequest, "INVALID_TEMPLATE", true
+	case errors.Is(err, trainer.ErrTrainerNotFound):
+		return http.StatusNotFound, "TRAINER_NOT_FOUND", true
+	case errors.Is(err, trainer.ErrTimeOffNotFound):
+		return http.StatusNotFound, "TIME_OFF_NOT_FOUND", true
+	case errors.Is(err, trainer.ErrInvalidTrainer),
+		errors.Is(err, trainer.ErrInvalidTimeOff):
+		return http.StatusBadRequest, "INVALID_TRAINER", true
+	case errors.Is(err, trainer.ErrTrainerUnavailable):
+		return http.StatusConflict, "TRAINER_UNAVAILABLE", true
+	case errors.Is(err, trainer.ErrTrainerBooked):
+		return http.StatusConflict, "TRAINER_BOOKED", true
+	case errors.Is(err, resource.ErrResourceNotFound):
+		return http.StatusNotFound, "RESOURCE_NOT_FOUND", true
+	case errors.Is(err, resource.ErrInvalidResource),
+		errors.Is(err, resource.ErrResourceMismatch):
+		return http.StatusBadRequest, "INVALID_RESOURCE", true
+	case errors.Is(err, resource.ErrResourceOutOfService):
+		return http.StatusConflict, "RESOURCE_OUT_OF_SERVICE", true
+	case errors.Is(err, resource.ErrResourceFull):
+		return http.StatusConflict, "RESOURCE_FULL", true
+	case errors.Is(err, closure.ErrGymClosed):
+		return http.StatusConflict, "GYM_CLOSED", true
+	case errors.Is(err, closure.ErrClosureNotFound):
+		return http.StatusNotFound, "CLOSURE_NOT_FOUND", true
+	case errors.Is(err, closure.ErrInvalidClosure):
+		return http.StatusBadRequest, "INVALID_CLOSURE", true
+	case errors.Is(err, closure.ErrClosureHasBookings):
+		return http.StatusConflict, "CLOSURE_HAS_BOOKINGS", true
+	case errors.Is(err, booking.ErrInvalidInput),
+		errors.Is(err, booking.ErrInvalidStatus),
+		errors.Is(err, booking.ErrStatusReasonRequired):
+		return http.StatusBadRequest, "INVALID_INPUT", true
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusServiceUnavailable, "REQUEST_TIMEOUT", true
+	default:
+		return http.StatusInternalServerError, "INTERNAL_ERROR", false
 	}
 }
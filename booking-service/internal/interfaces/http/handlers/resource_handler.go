@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+)
+
+// ResourceHandler serves the bookable resources inside gyms and how many places they have
+// free. Resources themselves are booked through BookingHandler with a resource_id.
+type ResourceHandler struct {
+	createHandler       *commands.CreateResourceHandler
+	updateHandler       *commands.UpdateResourceHandler
+	outOfServiceHandler *commands.TakeResourceOutOfServiceHandler
+	inServiceHandler    *commands.ReturnResourceToServiceHandler
+	getHandler          *queries.GetResourceHandler
+	listHandler         *queries.ListResourcesHandler
+	availabilityHandler *queries.GetResourceAvailabilityHandler
+}
+
+func NewResourceHandler(
+	createHandler *commands.CreateResourceHandler,
+	updateHandler *commands.UpdateResourceHandler,
+	outOfServiceHandler *commands.TakeResourceOutOfServiceHandler,
+	inServiceHandler *commands.ReturnResourceToServiceHandler,
+	getHandler *queries.GetResourceHandler,
+	listHandler *queries.ListResourcesHandler,
+	availabilityHandler *queries.GetResourceAvailabilityHandler,
+) *ResourceHandler {
+	return &ResourceHandler{
+		createHandler:       createHandler,
+		updateHandler:       updateHandler,
+		outOfServiceHandler: outOfServiceHandler,
+		inServiceHandler:    inServiceHandler,
+		getHandler:          getHandler,
+		listHandler:         listHandler,
+		availabilityHandler: availabilityHandler,
+	}
+}
+
+func (handler *ResourceHandler) CreateResource(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.SetResourceDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.createHandler.Handle(request.Context(), commands.CreateResourceCommand{
+		GymID: request.PathValue("id"),
+		DTO:   &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusCreated, result.Resource)
+}
+
+func (handler *ResourceHandler) ListResources(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.listHandler.Handle(request.Context(), queries.ListResourcesQuery{
+		GymID: request.PathValue("id"),
+		Type:  request.URL.Query().Get("type"),
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Resources)
+}
+
+func (handler *ResourceHandler) GetAvailability(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	result, err := handler.availabilityHandler.Handle(request.Context(), queries.GetResourceAvailabilityQuery{DTO: &dtos.ResourceAvailabilityQueryDTO{
+		GymID: request.PathValue("id"),
+		Type:  query.Get("type"),
+		From:  query.Get("from"),
+		To:    query.Get("to"),
+	}})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Availability)
+}
+
+func (handler *ResourceHandler) GetResource(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.getHandler.Handle(request.Context(), queries.GetResourceQuery{ResourceID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Resource)
+}
+
+func (handler *ResourceHandler) UpdateResource(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.SetResourceDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.updateHandler.Handle(request.Context(), commands.UpdateResourceCommand{
+		ResourceID: request.PathValue("id"),
+		DTO:        &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Resource)
+}
+
+func (handler *ResourceHandler) TakeOutOfService(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.OutOfServiceDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.outOfServiceHandler.Handle(request.Context(), commands.TakeResourceOutOfServiceCommand{
+		ResourceID: request.PathValue("id"),
+		DTO:        &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Result)
+}
+
+func (handler *ResourceHandler) ReturnToService(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.inServiceHandler.Handle(request.Context(), commands.ReturnResourceToServiceCommand{
+		ResourceID: request.PathValue("id"),
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Resource)
+}
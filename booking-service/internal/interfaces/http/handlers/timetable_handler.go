@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+)
+
+// TimetableHandler serves the weekly timetable templates class sessions are generated from.
+type TimetableHandler struct {
+	createHandler *commands.CreateTimetableTemplateHandler
+	updateHandler *commands.UpdateTimetableTemplateHandler
+	endHandler    *commands.EndTimetableTemplateHandler
+	getHandler    *queries.GetTimetableTemplateHandler
+	listHandler   *queries.ListTimetableTemplatesHandler
+}
+
+func NewTimetableHandler(
+	createHandler *commands.CreateTimetableTemplateHandler,
+	updateHandler *commands.UpdateTimetableTemplateHandler,
+	endHandler *commands.EndTimetableTemplateHandler,
+	getHandler *queries.GetTimetableTemplateHandler,
+	listHandler *queries.ListTimetableTemplatesHandler,
+) *TimetableHandler {
+	return &TimetableHandler{
+		createHandler: createHandler,
+		updateHandler: updateHandler,
+		endHandler:    endHandler,
+		getHandler:    getHandler,
+		listHandler:   listHandler,
+	}
+}
+
+func (handler *TimetableHandler) CreateTemplate(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.SetTimetableTemplateDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.createHandler.Handle(request.Context(), commands.CreateTimetableTemplateCommand{
+		GymID: request.PathValue("id"),
+		DTO:   &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusCreated, result.Template)
+}
+
+func (handler *TimetableHandler) ListTemplates(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.listHandler.Handle(request.Context(), queries.ListTimetableTemplatesQuery{GymID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Templates)
+}
+
+func (handler *TimetableHandler) GetTemplate(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.getHandler.Handle(request.Context(), queries.GetTimetableTemplateQuery{TemplateID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Template)
+}
+
+func (handler *TimetableHandler) UpdateTemplate(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.SetTimetableTemplateDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.updateHandler.Handle(request.Context(), commands.UpdateTimetableTemplateCommand{
+		TemplateID: request.PathValue("id"),
+		DTO:        &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Template)
+}
+
+func (handler *TimetableHandler) EndTemplate(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.endHandler.Handle(request.Context(), commands.EndTimetableTemplateCommand{TemplateID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Template)
+}
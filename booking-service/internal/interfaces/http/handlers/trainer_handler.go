@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+)
+
+// TrainerHandler serves personal trainers, their time off and when they can be booked.
+// Appointments themselves are booked through BookingHandler with a trainer_id.
+type TrainerHandler struct {
+	createHandler        *commands.CreateTrainerHandler
+	updateHandler        *commands.UpdateTrainerHandler
+	addTimeOffHandler    *commands.AddTrainerTimeOffHandler
+	deleteTimeOffHandler *commands.DeleteTrainerTimeOffHandler
+	getHandler           *queries.GetTrainerHandler
+	availabilityHandler  *queries.GetTrainerAvailabilityHandler
+}
+
+func NewTrainerHandler(
+	createHandler *commands.CreateTrainerHandler,
+	updateHandler *commands.UpdateTrainerHandler,
+	addTimeOffHandler *commands.AddTrainerTimeOffHandler,
+	deleteTimeOffHandler *commands.DeleteTrainerTimeOffHandler,
+	getHandler *queries.GetTrainerHandler,
+	availabilityHandler *queries.GetTrainerAvailabilityHandler,
+) *TrainerHandler {
+	return &TrainerHandler{
+		createHandler:        createHandler,
+		updateHandler:        updateHandler,
+		addTimeOffHandler:    addTimeOffHandler,
+		deleteTimeOffHandler: deleteTimeOffHandler,
+		getHandler:           getHandler,
+		availabilityHandler:  availabilityHandler,
+	}
+}
+
+func (handler *TrainerHandler) CreateTrainer(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.SetTrainerDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.createHandler.Handle(request.Context(), commands.CreateTrainerCommand{DTO: &dto})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusCreated, result.Trainer)
+}
+
+func (handler *TrainerHandler) GetTrainer(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.getHandler.Handle(request.Context(), queries.GetTrainerQuery{TrainerID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Trainer)
+}
+
+func (handler *TrainerHandler) UpdateTrainer(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.SetTrainerDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.updateHandler.Handle(request.Context(), commands.UpdateTrainerCommand{
+		TrainerID: request.PathValue("id"),
+		DTO:       &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Trainer)
+}
+
+func (handler *TrainerHandler) AddTimeOff(writer http.ResponseWriter, request *http.Request) {
+	var dto dtos.AddTimeOffDTO
+	if !decodeJSON(writer, request, &dto) {
+		return
+	}
+
+	result, err := handler.addTimeOffHandler.Handle(request.Context(), commands.AddTrainerTimeOffCommand{
+		TrainerID: request.PathValue("id"),
+		DTO:       &dto,
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusCreated, result.TimeOff)
+}
+
+func (handler *TrainerHandler) DeleteTimeOff(writer http.ResponseWriter, request *http.Request) {
+	err := handler.deleteTimeOffHandler.Handle(request.Context(), commands.DeleteTrainerTimeOffCommand{
+		TrainerID: request.PathValue("id"),
+		TimeOffID: request.PathValue("timeOffId"),
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, nil)
+}
+
+func (handler *TrainerHandler) GetAvailability(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	result, err := handler.availabilityHandler.Handle(request.Context(), queries.GetTrainerAvailabilityQuery{DTO: &dtos.TrainerAvailabilityQueryDTO{
+		TrainerID: request.PathValue("id"),
+		From:      query.Get("from"),
+		To:        query.Get("to"),
+	}})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Availability)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/commands"
+	"github.com/yourusername/fitbook/booking-service/internal/application/queries"
+)
+
+// UserHandler serves data about members, such as their booking quotas and strikes.
+type UserHandler struct {
+	quotaHandler        *queries.GetUserQuotaHandler
+	strikesHandler      *queries.GetUserStrikesHandler
+	clearStrikesHandler *commands.ClearStrikesHandler
+}
+
+func NewUserHandler(
+	quotaHandler *queries.GetUserQuotaHandler,
+	strikesHandler *queries.GetUserStrikesHandler,
+	clearStrikesHandler *commands.ClearStrikesHandler,
+) *UserHandler {
+	return &UserHandler{
+		quotaHandler:        quotaHandler,
+		strikesHandler:      strikesHandler,
+		clearStrikesHandler: clearStrikesHandler,
+	}
+}
+
+func (handler *UserHandler) GetUserQuota(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.quotaHandler.Handle(request.Context(), queries.GetUserQuotaQuery{UserID: request.PathValue("id")})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Quota)
+}
+
+func (handler *UserHandler) GetUserStrikes(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.strikesHandler.Handle(request.Context(), queries.GetUserStrikesQuery{
+		UserID: request.PathValue("id"),
+		GymID:  request.URL.Query().Get("gym_id"),
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Strikes)
+}
+
+func (handler *UserHandler) ClearUserStrikes(writer http.ResponseWriter, request *http.Request) {
+	result, err := handler.clearStrikesHandler.Handle(request.Context(), commands.ClearStrikesCommand{
+		UserID: request.PathValue("id"),
+		GymID:  request.URL.Query().Get("gym_id"),
+	})
+	if err != nil {
+		handleBookingError(writer, request, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, result.Cleared)
+}
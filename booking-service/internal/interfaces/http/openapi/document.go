@@ -0,0 +1,172 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Servers    []Server            `json:"servers"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters"`
+	Get        *Operation   `json:"get"`
+	Post       *Operation   `json:"post"`
+	Put        *Operation   `json:"put"`
+	Patch      *Operation   `json:"patch"`
+	Delete     *Operation   `json:"delete"`
+}
+
+type Operation struct {
+	OperationID string       `json:"operationId"`
+	Parameters  []*Parameter `json:"parameters"`
+	RequestBody *RequestBody `json:"requestBody"`
+}
+
+type Parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas    map[string]*Schema    `json:"schemas"`
+	Parameters map[string]*Parameter `json:"parameters"`
+}
+
+// Route is a resolved operation together with the parameters inherited from its path item.
+type Route struct {
+	Path       string
+	Method     string
+	Operation  *Operation
+	Parameters []*Parameter
+}
+
+func Load(data []byte) (*Document, error) {
+	var document Document
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse openapi document: %w", err)
+	}
+	return &document, nil
+}
+
+// Operation returns the operation declared for method on the exact path template, or nil.
+func (document *Document) Operation(method, path string) *Operation {
+	item, exists := document.Paths[path]
+	if !exists {
+		return nil
+	}
+	return item.operation(method)
+}
+
+// Match finds the route serving method and a concrete request path. The path may carry
+// any of the document's server prefixes.
+func (document *Document) Match(method, path string) (*Route, map[string]string) {
+	for _, server := range document.Servers {
+		prefix := strings.TrimSuffix(server.URL, "/")
+		if prefix != "" && strings.HasPrefix(path, prefix+"/") {
+			path = strings.TrimPrefix(path, prefix)
+			break
+		}
+	}
+
+	// Literal segments win over templated ones, so /bookings/search beats /bookings/{id}.
+	var best *Route
+	var bestValues map[string]string
+	for template, item := range document.Paths {
+		values, ok := matchPath(template, path)
+		if !ok {
+			continue
+		}
+		operation := item.operation(method)
+		if operation == nil {
+			continue
+		}
+		if best != nil && len(values) >= len(bestValues) {
+			continue
+		}
+		best = &Route{
+			Path:       template,
+			Method:     method,
+			Operation:  operation,
+			Parameters: document.resolveParameters(append(append([]*Parameter{}, item.Parameters...), operation.Parameters...)),
+		}
+		bestValues = values
+	}
+	return best, bestValues
+}
+
+func (document *Document) resolveParameters(parameters []*Parameter) []*Parameter {
+	resolved := make([]*Parameter, 0, len(parameters))
+	for _, parameter := range parameters {
+		if parameter.Ref != "" {
+			name := strings.TrimPrefix(parameter.Ref, "#/components/parameters/")
+			if target, exists := document.Components.Parameters[name]; exists {
+				parameter = target
+			}
+		}
+		resolved = append(resolved, parameter)
+	}
+	return resolved
+}
+
+func (item PathItem) operation(method string) *Operation {
+	switch method {
+	case http.MethodGet:
+		return item.Get
+	case http.MethodPost:
+		return item.Post
+	case http.MethodPut:
+		return item.Put
+	case http.MethodPatch:
+		return item.Patch
+	case http.MethodDelete:
+		return item.Delete
+	default:
+		return nil
+	}
+}
+
+func matchPath(template, path string) (map[string]string, bool) {
+	templateSegments := strings.Split(strings.Trim(template, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(templateSegments) != len(pathSegments) {
+		return nil, false
+	}
+
+	values := make(map[string]string)
+	for i, segment := range templateSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return nil, false
+			}
+			values[strings.Trim(segment, "{}")] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return values, true
+}
@@ -0,0 +1,225 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Schema is the subset of JSON Schema used by the booking service document.
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       SchemaType         `json:"type"`
+	Format     string             `json:"format"`
+	Enum       []interface{}      `json:"enum"`
+	Const      interface{}        `json:"const"`
+	Required   []string           `json:"required"`
+	Properties map[string]*Schema `json:"properties"`
+	Items      *Schema            `json:"items"`
+	AllOf      []*Schema          `json:"allOf"`
+	MinLength  *int               `json:"minLength"`
+	MaxLength  *int               `json:"maxLength"`
+	Minimum    *float64           `json:"minimum"`
+	Maximum    *float64           `json:"maximum"`
+	MinItems   *int               `json:"minItems"`
+	MaxItems   *int               `json:"maxItems"`
+}
+
+// SchemaType accepts both the single-string and the OpenAPI 3.1 array form of "type".
+type SchemaType []string
+
+func (schemaType *SchemaType) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*schemaType = SchemaType{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return fmt.Errorf("invalid schema type: %w", err)
+	}
+	*schemaType = many
+	return nil
+}
+
+// Violation describes a single place where a value does not conform to its schema.
+type Violation struct {
+	Field   string
+	Message string
+}
+
+func (violation Violation) String() string {
+	if violation.Field == "" {
+		return violation.Message
+	}
+	return violation.Field + ": " + violation.Message
+}
+
+// ValidateValue checks a decoded JSON value against schema and returns every violation found.
+func (document *Document) ValidateValue(schema *Schema, value interface{}) []Violation {
+	return document.validate(schema, value, "")
+}
+
+func (document *Document) validate(schema *Schema, value interface{}, field string) []Violation {
+	if schema == nil {
+		return nil
+	}
+	if schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		target, exists := document.Components.Schemas[name]
+		if !exists {
+			return []Violation{{Field: field, Message: fmt.Sprintf("unresolved schema reference %s", schema.Ref)}}
+		}
+		return document.validate(target, value, field)
+	}
+
+	var violations []Violation
+	for _, part := range schema.AllOf {
+		violations = append(violations, document.validate(part, value, field)...)
+	}
+
+	if len(schema.Type) > 0 && !schema.Type.allows(value) {
+		return append(violations, Violation{Field: field, Message: fmt.Sprintf("must be of type %s", strings.Join(schema.Type, " or "))})
+	}
+	if schema.Const != nil && !equalJSON(schema.Const, value) {
+		violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must equal %v", schema.Const)})
+	}
+	if len(schema.Enum) > 0 && !containsJSON(schema.Enum, value) {
+		violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must be one of %s", joinValues(schema.Enum))})
+	}
+
+	switch typed := value.(type) {
+	case string:
+		violations = append(violations, schema.validateString(typed, field)...)
+	case float64:
+		if schema.Minimum != nil && typed < *schema.Minimum {
+			violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must be at least %v", *schema.Minimum)})
+		}
+		if schema.Maximum != nil && typed > *schema.Maximum {
+			violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must be at most %v", *schema.Maximum)})
+		}
+	case []interface{}:
+		if schema.MinItems != nil && len(typed) < *schema.MinItems {
+			violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must contain at least %d items", *schema.MinItems)})
+		}
+		if schema.MaxItems != nil && len(typed) > *schema.MaxItems {
+			violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must contain at most %d items", *schema.MaxItems)})
+		}
+		for i, item := range typed {
+			violations = append(violations, document.validate(schema.Items, item, fmt.Sprintf("%s[%d]", field, i))...)
+		}
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, exists := typed[name]; !exists {
+				violations = append(violations, Violation{Field: joinField(field, name), Message: "is required"})
+			}
+		}
+		names := make([]string, 0, len(schema.Properties))
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if propertyValue, exists := typed[name]; exists {
+				violations = append(violations, document.validate(schema.Properties[name], propertyValue, joinField(field, name))...)
+			}
+		}
+	}
+
+	return violations
+}
+
+func (schema *Schema) validateString(value, field string) []Violation {
+	var violations []Violation
+	length := len([]rune(value))
+	if schema.MinLength != nil && length < *schema.MinLength {
+		if *schema.MinLength == 1 {
+			violations = append(violations, Violation{Field: field, Message: "must not be empty"})
+		} else {
+			violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must be at least %d characters", *schema.MinLength)})
+		}
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		violations = append(violations, Violation{Field: field, Message: fmt.Sprintf("must be at most %d characters", *schema.MaxLength)})
+	}
+	switch schema.Format {
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			violations = append(violations, Violation{Field: field, Message: "must be an RFC3339 date-time"})
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			violations = append(violations, Violation{Field: field, Message: "must be a date such as 2025-01-31"})
+		}
+	}
+	return violations
+}
+
+func (schemaType SchemaType) allows(value interface{}) bool {
+	for _, name := range schemaType {
+		switch name {
+		case "null":
+			if value == nil {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "number":
+			if _, ok := value.(float64); ok {
+				return true
+			}
+		case "integer":
+			if number, ok := value.(float64); ok && number == math.Trunc(number) {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]interface{}); ok {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]interface{}); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func equalJSON(expected, actual interface{}) bool {
+	expectedData, _ := json.Marshal(expected)
+	actualData, _ := json.Marshal(actual)
+	return string(expectedData) == string(actualData)
+}
+
+func containsJSON(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if equalJSON(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinValues(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = fmt.Sprint(value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yourusername/fitbook/booking-service/api"
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+	"github.com/yourusername/fitbook/booking-service/internal/interfaces/http/openapi"
+)
+
+func TestValidatorMiddleware(t *testing.T) {
+	document, err := openapi.Load(api.OpenAPISpec)
+	require.NoError(t, err)
+
+	reached := false
+	next := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		reached = true
+		writer.WriteHeader(http.StatusNoContent)
+	})
+	handler := openapi.NewValidator(document).Middleware(next)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		wantStatus  int
+		wantDetails []string
+	}{
+		{
+			name:       "valid create request",
+			method:     http.MethodPost,
+			path:       "/v1/bookings",
+			body:       `{"user_id":"user1","gym_id":"gym1","start_time":"2030-01-01T10:00:00Z","end_time":"2030-01-01T11:00:00Z"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:        "missing fields and bad time",
+			method:      http.MethodPost,
+			path:        "/bookings",
+			body:        `{"user_id":"","start_time":"tomorrow","end_time":"2030-01-01T11:00:00Z"}`,
+			wantStatus:  http.StatusBadRequest,
+			wantDetails: []string{"gym_id: is required", "start_time: must be an RFC3339 date-time", "user_id: must not be empty"},
+		},
+		{
+			name:        "bad date",
+			method:      http.MethodPost,
+			path:        "/v1/gyms/gym1/timetable",
+			body:        `{"weekday":"MONDAY","start_time":"18:00","duration_minutes":60,"title":"Spin","capacity":12,"valid_from":"2030-01-01T00:00:00Z"}`,
+			wantStatus:  http.StatusBadRequest,
+			wantDetails: []string{"valid_from: must be a date such as 2025-01-31"},
+		},
+		{
+			name:        "missing body",
+			method:      http.MethodPost,
+			path:        "/v1/bookings",
+			wantStatus:  http.StatusBadRequest,
+			wantDetails: []string{"body: is required"},
+		},
+		{
+			name:       "path parameter route",
+			method:     http.MethodPatch,
+			path:       "/v1/bookings/abc/confirm",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "unknown route passes through",
+			method:     http.MethodGet,
+			path:       "/v1/unknown",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reached = false
+			request := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, test.wantStatus, recorder.Code)
+			if test.wantStatus != http.StatusBadRequest {
+				assert.True(t, reached)
+				return
+			}
+
+			assert.False(t, reached)
+			var response dtos.Response
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+			require.NotNil(t, response.Error)
+			assert.Equal(t, "INVALID_REQUEST", response.Error.Code)
+			assert.ElementsMatch(t, test.wantDetails, response.Error.Details)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/yourusername/fitbook/booking-service/internal/application/dtos"
+)
+
+// Validator rejects requests that do not match the operation the document declares for them.
+// Requests for paths the document does not know are passed through untouched so the router
+// can answer with its own 404 or 405.
+type Validator struct {
+	document *Document
+}
+
+func NewValidator(document *Document) *Validator {
+	return &Validator{
+		document: document,
+	}
+}
+
+func (validator *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		route, pathValues := validator.document.Match(request.Method, request.URL.Path)
+		if route == nil {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		violations := validator.validateParameters(route, pathValues, request)
+
+		if route.Operation.RequestBody != nil {
+			body, err := io.ReadAll(request.Body)
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				writeError(writer, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE",
+					fmt.Sprintf("Request body exceeds %d bytes", tooLarge.Limit))
+				return
+			}
+			if err != nil {
+				writeViolations(writer, []Violation{{Field: "body", Message: "could not be read"}})
+				return
+			}
+			request.Body.Close()
+			request.Body = io.NopCloser(bytes.NewReader(body))
+			violations = append(violations, validator.validateBody(route.Operation.RequestBody, request.Header.Get("Content-Type"), body)...)
+		}
+
+		if len(violations) > 0 {
+			writeViolations(writer, violations)
+			return
+		}
+
+		next.ServeHTTP(writer, request)
+	})
+}
+
+func (validator *Validator) validateParameters(route *Route, pathValues map[string]string, request *http.Request) []Violation {
+	var violations []Violation
+	query := request.URL.Query()
+	for _, parameter := range route.Parameters {
+		var value string
+		var present bool
+		switch parameter.In {
+		case "path":
+			value, present = pathValues[parameter.Name]
+		case "query":
+			present = query.Has(parameter.Name)
+			value = query.Get(parameter.Name)
+		case "header":
+			value = request.Header.Get(parameter.Name)
+			present = value != ""
+		default:
+			continue
+		}
+
+		if !present {
+			if parameter.Required {
+				violations = append(violations, Violation{Field: parameter.Name, Message: "is required"})
+			}
+			continue
+		}
+		for _, violation := range validator.document.ValidateValue(parameter.Schema, coerceParameter(parameter.Schema, value)) {
+			violation.Field = joinField(parameter.Name, violation.Field)
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+func (validator *Validator) validateBody(requestBody *RequestBody, contentType string, body []byte) []Violation {
+	if len(bytes.TrimSpace(body)) == 0 {
+		if requestBody.Required {
+			return []Violation{{Field: "body", Message: "is required"}}
+		}
+		return nil
+	}
+
+	mediaType, exists := requestBody.Content["application/json"]
+	if !exists {
+		return nil
+	}
+	if contentType != "" && !strings.HasPrefix(contentType, "application/json") {
+		return []Violation{{Field: "body", Message: "must be application/json"}}
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return []Violation{{Field: "body", Message: "must be valid JSON"}}
+	}
+	return validator.document.ValidateValue(mediaType.Schema, value)
+}
+
+// coerceParameter turns a raw path or query string into the JSON type its schema expects.
+func coerceParameter(schema *Schema, raw string) interface{} {
+	if schema == nil || len(schema.Type) == 0 {
+		return raw
+	}
+	switch schema.Type[0] {
+	case "integer", "number", "boolean":
+		var value interface{}
+		if err := json.Unmarshal([]byte(raw), &value); err == nil {
+			return value
+		}
+	}
+	return raw
+}
+
+func writeViolations(writer http.ResponseWriter, violations []Violation) {
+	details := make([]string, len(violations))
+	for i, violation := range violations {
+		details[i] = violation.String()
+	}
+
+	writeError(writer, http.StatusBadRequest, "INVALID_REQUEST", "Request does not match the API specification", details...)
+}
+
+func writeError(writer http.ResponseWriter, status int, code, message string, details ...string) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(dtos.Response{
+		Success: false,
+		Error:   dtos.NewErrorDTO(code, message, details...),
+	})
+}
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_bookings_updated_at ON bookings;
+DROP FUNCTION IF EXISTS update_updated_at_column();
+DROP TABLE IF EXISTS bookings;
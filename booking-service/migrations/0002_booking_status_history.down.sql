@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS record_booking_status_change ON bookings;
+DROP FUNCTION IF EXISTS record_booking_status_change();
+DROP TABLE IF EXISTS booking_status_history;
+ALTER TABLE bookings DROP COLUMN IF EXISTS status_reason;
@@ -0,0 +1,39 @@
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS status_reason TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS booking_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    booking_id VARCHAR(36) NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_status_history_booking ON booking_status_history(booking_id, changed_at);
+
+-- Bookings that predate the history start with their current status.
+INSERT INTO booking_status_history (booking_id, from_status, to_status, reason, changed_at)
+SELECT id, NULL, status, 'recorded when status history was introduced', updated_at
+FROM bookings;
+
+-- Every status change is recorded by the database, so that changes made with plain SQL
+-- show up in the history too.
+CREATE OR REPLACE FUNCTION record_booking_status_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO booking_status_history (booking_id, from_status, to_status, reason)
+        VALUES (NEW.id, NULL, NEW.status, NEW.status_reason);
+    ELSIF NEW.status IS DISTINCT FROM OLD.status THEN
+        INSERT INTO booking_status_history (booking_id, from_status, to_status, reason)
+        VALUES (NEW.id, OLD.status, NEW.status, NEW.status_reason);
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+DROP TRIGGER IF EXISTS record_booking_status_change ON bookings;
+CREATE TRIGGER record_booking_status_change
+    AFTER INSERT OR UPDATE OF status ON bookings
+    FOR EACH ROW
+    EXECUTE FUNCTION record_booking_status_change();
@@ -0,0 +1,13 @@
+-- One row per gym that sets booking rules; gyms without a row allow every booking. A zero
+-- limit turns its rule off.
+CREATE TABLE IF NOT EXISTS gym_booking_policies (
+    gym_id VARCHAR(36) PRIMARY KEY,
+    min_lead_time_seconds BIGINT NOT NULL DEFAULT 0 CHECK (min_lead_time_seconds >= 0),
+    max_advance_seconds BIGINT NOT NULL DEFAULT 0 CHECK (max_advance_seconds >= 0),
+    min_duration_seconds BIGINT NOT NULL DEFAULT 0 CHECK (min_duration_seconds >= 0),
+    max_duration_seconds BIGINT NOT NULL DEFAULT 0 CHECK (max_duration_seconds >= 0),
+    slot_alignment_seconds BIGINT NOT NULL DEFAULT 0 CHECK (slot_alignment_seconds >= 0),
+    max_bookings_per_day INTEGER NOT NULL DEFAULT 0 CHECK (max_bookings_per_day >= 0),
+    time_zone TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS booking_strikes;
+
+ALTER TABLE gym_booking_policies
+    DROP COLUMN IF EXISTS cancellation_cutoff_seconds,
+    DROP COLUMN IF EXISTS max_strikes,
+    DROP COLUMN IF EXISTS strike_window_seconds,
+    DROP COLUMN IF EXISTS suspension_seconds;
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancelled_late;
+
+-- No-shows did not happen either, which the previous schema can only express as cancelled.
+UPDATE bookings SET status = 'CANCELLED', status_reason = 'no-show' WHERE status = 'NO_SHOW';
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS valid_status;
+ALTER TABLE bookings ADD CONSTRAINT valid_status
+    CHECK (status IN ('PENDING', 'CONFIRMED', 'CANCELLED', 'COMPLETED'));
@@ -0,0 +1,27 @@
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS valid_status;
+ALTER TABLE bookings ADD CONSTRAINT valid_status
+    CHECK (status IN ('PENDING', 'CONFIRMED', 'CANCELLED', 'COMPLETED', 'NO_SHOW'));
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancelled_late BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE gym_booking_policies
+    ADD COLUMN IF NOT EXISTS cancellation_cutoff_seconds BIGINT NOT NULL DEFAULT 0 CHECK (cancellation_cutoff_seconds >= 0),
+    ADD COLUMN IF NOT EXISTS max_strikes INTEGER NOT NULL DEFAULT 0 CHECK (max_strikes >= 0),
+    ADD COLUMN IF NOT EXISTS strike_window_seconds BIGINT NOT NULL DEFAULT 0 CHECK (strike_window_seconds >= 0),
+    ADD COLUMN IF NOT EXISTS suspension_seconds BIGINT NOT NULL DEFAULT 0 CHECK (suspension_seconds >= 0);
+
+-- A strike is a late cancellation or no-show held against a member at a gym. Cleared
+-- strikes are kept for the record but no longer count.
+CREATE TABLE IF NOT EXISTS booking_strikes (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    gym_id VARCHAR(36) NOT NULL,
+    booking_id VARCHAR(36) NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('LATE_CANCELLATION', 'NO_SHOW')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    cleared_at TIMESTAMP,
+    cleared_by VARCHAR(36) NOT NULL DEFAULT '',
+    UNIQUE (booking_id, reason)
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_strikes_user_gym ON booking_strikes(user_id, gym_id, created_at)
+    WHERE cleared_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_bookings_session;
+ALTER TABLE bookings DROP COLUMN IF EXISTS session_id;
+DROP TABLE IF EXISTS class_sessions;
@@ -0,0 +1,20 @@
+-- Instructor-led classes. Members enroll with a booking that references the session.
+CREATE TABLE IF NOT EXISTS class_sessions (
+    id VARCHAR(36) PRIMARY KEY,
+    gym_id VARCHAR(36) NOT NULL,
+    instructor_id VARCHAR(36) NOT NULL DEFAULT '',
+    title VARCHAR(200) NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('SCHEDULED', 'CANCELLED')),
+    cancel_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT valid_session_time_range CHECK (start_time < end_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_class_sessions_gym_start ON class_sessions(gym_id, start_time);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS session_id VARCHAR(36) REFERENCES class_sessions(id);
+CREATE INDEX IF NOT EXISTS idx_bookings_session ON bookings(session_id) WHERE session_id IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_class_sessions_occurrence;
+ALTER TABLE class_sessions DROP COLUMN IF EXISTS occurrence_date;
+ALTER TABLE class_sessions DROP COLUMN IF EXISTS template_id;
+DROP TABLE IF EXISTS timetable_templates;
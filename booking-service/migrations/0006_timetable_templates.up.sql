@@ -0,0 +1,23 @@
+-- Weekly timetable entries from which class sessions are generated ahead of time.
+CREATE TABLE IF NOT EXISTS timetable_templates (
+    id VARCHAR(36) PRIMARY KEY,
+    gym_id VARCHAR(36) NOT NULL,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_seconds INTEGER NOT NULL CHECK (start_seconds >= 0 AND start_seconds < 86400),
+    duration_seconds INTEGER NOT NULL CHECK (duration_seconds > 0),
+    instructor_id VARCHAR(36) NOT NULL DEFAULT '',
+    title VARCHAR(200) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    valid_from DATE NOT NULL,
+    valid_until DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_timetable_templates_gym ON timetable_templates(gym_id);
+
+ALTER TABLE class_sessions ADD COLUMN IF NOT EXISTS template_id VARCHAR(36) REFERENCES timetable_templates(id);
+ALTER TABLE class_sessions ADD COLUMN IF NOT EXISTS occurrence_date DATE;
+-- At most one session per template and date, so that generating again never duplicates one.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_class_sessions_occurrence
+    ON class_sessions(template_id, occurrence_date) WHERE template_id IS NOT NULL;
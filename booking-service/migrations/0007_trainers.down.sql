@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_bookings_trainer_start;
+ALTER TABLE bookings DROP COLUMN IF EXISTS trainer_id;
+DROP TABLE IF EXISTS trainer_time_off;
+DROP TABLE IF EXISTS trainer_availability;
+DROP TABLE IF EXISTS trainers;
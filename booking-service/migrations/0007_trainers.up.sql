@@ -0,0 +1,34 @@
+-- Personal trainers, who take 1:1 appointments. An appointment is a booking that references
+-- its trainer.
+CREATE TABLE IF NOT EXISTS trainers (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(200) NOT NULL,
+    time_zone VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Weekly windows a trainer takes appointments in, as seconds past midnight in their time zone.
+CREATE TABLE IF NOT EXISTS trainer_availability (
+    trainer_id VARCHAR(36) NOT NULL REFERENCES trainers(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_seconds INTEGER NOT NULL CHECK (start_seconds >= 0),
+    end_seconds INTEGER NOT NULL CHECK (end_seconds <= 86400),
+    PRIMARY KEY (trainer_id, weekday, start_seconds),
+    CONSTRAINT valid_availability_range CHECK (start_seconds < end_seconds)
+);
+
+CREATE TABLE IF NOT EXISTS trainer_time_off (
+    id VARCHAR(36) PRIMARY KEY,
+    trainer_id VARCHAR(36) NOT NULL REFERENCES trainers(id) ON DELETE CASCADE,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT valid_time_off_range CHECK (start_time < end_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_trainer_time_off_trainer_start ON trainer_time_off(trainer_id, start_time);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS trainer_id VARCHAR(36) REFERENCES trainers(id);
+CREATE INDEX IF NOT EXISTS idx_bookings_trainer_start ON bookings(trainer_id, start_time) WHERE trainer_id IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bookings_resource_start;
+ALTER TABLE bookings DROP COLUMN IF EXISTS flag_reason;
+ALTER TABLE bookings DROP COLUMN IF EXISTS resource_id;
+DROP TABLE IF EXISTS resources;
@@ -0,0 +1,19 @@
+-- Bookable resources inside a gym, such as courts, rooms and squat racks. A booking that
+-- references a resource competes only with the resource's other bookings, up to its capacity.
+CREATE TABLE IF NOT EXISTS resources (
+    id VARCHAR(36) PRIMARY KEY,
+    gym_id VARCHAR(36) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    out_of_service_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_resources_gym_type ON resources(gym_id, type);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS resource_id VARCHAR(36) REFERENCES resources(id);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS flag_reason TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_bookings_resource_start ON bookings(resource_id, start_time) WHERE resource_id IS NOT NULL;
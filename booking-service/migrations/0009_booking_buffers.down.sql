@@ -0,0 +1,5 @@
+ALTER TABLE resources DROP COLUMN IF EXISTS buffer_after_seconds;
+ALTER TABLE resources DROP COLUMN IF EXISTS buffer_before_seconds;
+
+ALTER TABLE gym_booking_policies DROP COLUMN IF EXISTS buffer_after_seconds;
+ALTER TABLE gym_booking_policies DROP COLUMN IF EXISTS buffer_before_seconds;
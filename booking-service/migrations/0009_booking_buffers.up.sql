@@ -0,0 +1,8 @@
+-- Changeover time kept free around bookings, for the open bookings of a gym and for the
+-- bookings of each resource. Bookings keep the times members booked; buffers only widen
+-- the time over which they conflict.
+ALTER TABLE gym_booking_policies ADD COLUMN IF NOT EXISTS buffer_before_seconds BIGINT NOT NULL DEFAULT 0 CHECK (buffer_before_seconds >= 0);
+ALTER TABLE gym_booking_policies ADD COLUMN IF NOT EXISTS buffer_after_seconds BIGINT NOT NULL DEFAULT 0 CHECK (buffer_after_seconds >= 0);
+
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS buffer_before_seconds BIGINT NOT NULL DEFAULT 0 CHECK (buffer_before_seconds >= 0);
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS buffer_after_seconds BIGINT NOT NULL DEFAULT 0 CHECK (buffer_after_seconds >= 0);
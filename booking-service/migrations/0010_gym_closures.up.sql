@@ -0,0 +1,14 @@
+-- Periods a gym is closed, such as public holidays and maintenance. Recurring closures
+-- repeat every year at the same local dates and times, in the time zone of the gym's policy.
+CREATE TABLE IF NOT EXISTS gym_closures (
+    id VARCHAR(36) PRIMARY KEY,
+    gym_id VARCHAR(36) NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    recurring BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT valid_closure_range CHECK (start_time < end_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_gym_closures_gym_start ON gym_closures(gym_id, start_time);
@@ -0,0 +1,8 @@
+// Package migrations embeds the database schema migrations. Each version N has a
+// NNNN_<name>.up.sql file and a matching NNNN_<name>.down.sql file that reverts it.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS